@@ -210,6 +210,7 @@ var properNouns = []string{
 	"Scala",
 	"Schema Registry",
 	"Spring Boot",
+	"Stream Catalog",
 	"Stream Designer",
 	"Tableflow",
 	"Unified Stream Manager",
@@ -0,0 +1,194 @@
+package connect
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
+
+	"github.com/confluentinc/cli/v4/pkg/auth"
+	"github.com/confluentinc/cli/v4/pkg/ccloudv2"
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	pconnect "github.com/confluentinc/cli/v4/pkg/connect"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/form"
+	"github.com/confluentinc/cli/v4/pkg/kafka"
+)
+
+// connectorApplyClient manages the connectors of either a Confluent Cloud Kafka cluster or a self-managed Connect
+// cluster, by name.
+type connectorApplyClient interface {
+	listConnectors() (map[string]connectorApplyState, error)
+	createConnector(name string, config map[string]string) error
+	updateConnector(name string, config map[string]string) error
+	pauseConnector(name string) error
+	resumeConnector(name string) error
+	deleteConnector(name string) error
+}
+
+type cloudConnectorApplyClient struct {
+	client         *ccloudv2.Client
+	environmentId  string
+	kafkaClusterId string
+}
+
+type selfManagedConnectorApplyClient struct {
+	client *pconnect.Client
+}
+
+func (c *applyCommand) getConnectorApplyClient(cmd *cobra.Command) (connectorApplyClient, error) {
+	if c.Config.IsCloudLogin() {
+		kafkaCluster, err := kafka.GetClusterForCommand(c.V2Client, c.Context)
+		if err != nil {
+			return nil, err
+		}
+
+		environmentId, err := c.Context.EnvironmentId()
+		if err != nil {
+			return nil, err
+		}
+
+		return &cloudConnectorApplyClient{
+			client:         c.V2Client,
+			environmentId:  environmentId,
+			kafkaClusterId: kafkaCluster.ID,
+		}, nil
+	}
+
+	url, err := cmd.Flags().GetString("url")
+	if err != nil {
+		return nil, err
+	}
+	if url == "" {
+		url = os.Getenv(auth.ConfluentPlatformConnectURL)
+	}
+	if url == "" {
+		return nil, errors.NewErrorWithSuggestions(
+			"url is required",
+			"Specify a URL with `--url` or set the variable \"CONFLUENT_CONNECT_URL\".",
+		)
+	}
+
+	unsafeTrace, err := cmd.Flags().GetBool("unsafe-trace")
+	if err != nil {
+		return nil, err
+	}
+
+	caCertPath, err := cmd.Flags().GetString("certificate-authority-path")
+	if err != nil {
+		return nil, err
+	}
+
+	clientCertPath, clientKeyPath, err := pcmd.GetClientCertAndKeyPaths(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := pcmd.CreateOnPremHTTPClient(c.Context, caCertPath, clientCertPath, clientKeyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	client := pconnect.NewClient(url, c.Version.UserAgent, httpClient, unsafeTrace)
+
+	prompt, err := cmd.Flags().GetBool("prompt")
+	if err != nil {
+		return nil, err
+	}
+
+	// Pass the MDS token as a bearer token, unless the user asks to be prompted for HTTP basic authentication
+	if prompt {
+		f := form.New(
+			form.Field{ID: "username", Prompt: "Username"},
+			form.Field{ID: "password", Prompt: "Password", IsHidden: true},
+		)
+		if err := f.Prompt(form.NewPrompt()); err != nil {
+			return nil, err
+		}
+		client.Username = f.Responses["username"].(string)
+		client.Password = f.Responses["password"].(string)
+	} else {
+		client.AuthToken = c.Context.GetAuthToken()
+	}
+
+	return &selfManagedConnectorApplyClient{client: client}, nil
+}
+
+func (c *cloudConnectorApplyClient) listConnectors() (map[string]connectorApplyState, error) {
+	connectors, err := c.client.ListConnectorsWithExpansions(c.environmentId, c.kafkaClusterId, "info,status")
+	if err != nil {
+		return nil, err
+	}
+
+	states := make(map[string]connectorApplyState, len(connectors))
+	for name, connector := range connectors {
+		states[name] = connectorApplyState{
+			config: connector.Info.GetConfig(),
+			paused: connector.Status.Connector.GetState() == connectorStatePaused,
+		}
+	}
+	return states, nil
+}
+
+func (c *cloudConnectorApplyClient) createConnector(name string, config map[string]string) error {
+	_, err := c.client.CreateConnector(c.environmentId, c.kafkaClusterId, connectv1.InlineObject{
+		Name:   connectv1.PtrString(name),
+		Config: &config,
+	})
+	return err
+}
+
+func (c *cloudConnectorApplyClient) updateConnector(name string, config map[string]string) error {
+	_, err := c.client.CreateOrUpdateConnectorConfig(name, c.environmentId, c.kafkaClusterId, config)
+	return err
+}
+
+func (c *cloudConnectorApplyClient) pauseConnector(name string) error {
+	return c.client.PauseConnector(name, c.environmentId, c.kafkaClusterId)
+}
+
+func (c *cloudConnectorApplyClient) resumeConnector(name string) error {
+	return c.client.ResumeConnector(name, c.environmentId, c.kafkaClusterId)
+}
+
+func (c *cloudConnectorApplyClient) deleteConnector(name string) error {
+	_, err := c.client.DeleteConnector(name, c.environmentId, c.kafkaClusterId)
+	return err
+}
+
+func (c *selfManagedConnectorApplyClient) listConnectors() (map[string]connectorApplyState, error) {
+	connectors, err := c.client.ListConnectors()
+	if err != nil {
+		return nil, err
+	}
+
+	states := make(map[string]connectorApplyState, len(connectors))
+	for name, connector := range connectors {
+		states[name] = connectorApplyState{
+			config: connector.Info.Config,
+			paused: connector.Status.Connector.State == connectorStatePaused,
+		}
+	}
+	return states, nil
+}
+
+func (c *selfManagedConnectorApplyClient) createConnector(name string, config map[string]string) error {
+	return c.client.CreateConnector(name, config)
+}
+
+func (c *selfManagedConnectorApplyClient) updateConnector(name string, config map[string]string) error {
+	return c.client.UpdateConnectorConfig(name, config)
+}
+
+func (c *selfManagedConnectorApplyClient) pauseConnector(name string) error {
+	return c.client.PauseConnector(name)
+}
+
+func (c *selfManagedConnectorApplyClient) resumeConnector(name string) error {
+	return c.client.ResumeConnector(name)
+}
+
+func (c *selfManagedConnectorApplyClient) deleteConnector(name string) error {
+	return c.client.DeleteConnector(name)
+}
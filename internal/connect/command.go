@@ -21,10 +21,12 @@ func New(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Command {
 		Short: "Manage Kafka Connect.",
 	}
 
+	cmd.AddCommand(newApplyCommand(cfg, prerunner))
 	cmd.AddCommand(newArtifactCommand(prerunner))
 	cmd.AddCommand(newClusterCommand(cfg, prerunner))
 	cmd.AddCommand(newCustomPluginCommand(prerunner))
 	cmd.AddCommand(newCustomRuntimeCommand(cfg, prerunner))
+	cmd.AddCommand(newDoctorCommand(prerunner))
 	cmd.AddCommand(newEventCommand(prerunner))
 	cmd.AddCommand(newLogsCommand(prerunner))
 	cmd.AddCommand(newOffsetCommand(prerunner))
@@ -0,0 +1,421 @@
+package connect
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/config"
+	"github.com/confluentinc/cli/v4/pkg/deletion"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/output"
+	"github.com/confluentinc/cli/v4/pkg/plural"
+	"github.com/confluentinc/cli/v4/pkg/resource"
+	"github.com/confluentinc/cli/v4/pkg/types"
+)
+
+const (
+	connectorApplyActionCreate = "create"
+	connectorApplyActionUpdate = "update"
+	connectorApplyActionPause  = "pause"
+	connectorApplyActionResume = "resume"
+	connectorApplyActionDelete = "delete"
+	connectorApplyActionNone   = "none"
+
+	connectorStateRunning = "RUNNING"
+	connectorStatePaused  = "PAUSED"
+	connectorStateFailed  = "FAILED"
+)
+
+var connectorDefinitionEnvVarRegex = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+type applyCommand struct {
+	*pcmd.AuthenticatedCLICommand
+}
+
+// connectorDefinition is the desired state of a connector. It extends the format of `--config-file` in
+// `confluent connect cluster create` with an optional "state".
+type connectorDefinition struct {
+	Name   string            `json:"name" yaml:"name"`
+	Config map[string]string `json:"config" yaml:"config"`
+	State  string            `json:"state" yaml:"state"`
+}
+
+type connectorApplyState struct {
+	config map[string]string
+	paused bool
+}
+
+type connectorApplyPlan struct {
+	name    string
+	config  map[string]string
+	actions []string
+	changes []string
+}
+
+type connectorApplyOut struct {
+	Name    string   `human:"Name" serialized:"name"`
+	Actions []string `human:"Actions" serialized:"actions"`
+	Changes []string `human:"Changes,omitempty" serialized:"changes,omitempty"`
+}
+
+func newApplyCommand(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Reconcile connectors with their definitions.",
+		Long: "Create, update, pause, and resume connectors so that they match the connector definitions in a file or directory, and print the plan.\n\n" +
+			`Each definition is a JSON or YAML file with a "name", a "config", and optionally a "state" of "RUNNING" or "PAUSED". ` +
+			`References to environment variables in configuration values, such as "${KAFKA_API_SECRET}", are replaced with their values. ` +
+			"Configurations which are not in a definition are not compared. " +
+			"Sensitive configurations which are masked by the API cannot be compared, so they are re-applied only along with other changes, or if --reapply-sensitive is set. " +
+			`A connector created with a "state" of "PAUSED" is paused once it is created, so it may briefly run first. ` +
+			"Connectors without a definition are deleted only if --prune is set.",
+		Args: cobra.NoArgs,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Preview the changes needed to reconcile the connectors in directory "connectors".`,
+				Code: "confluent connect apply --file connectors --dry-run",
+			},
+			examples.Example{
+				Text: `Reconcile the connectors in directory "connectors", and delete connectors without a definition.`,
+				Code: "confluent connect apply --file connectors --prune",
+			},
+		),
+	}
+
+	c := &applyCommand{}
+	cmd.RunE = c.apply
+
+	cmd.Flags().StringP("file", "f", "", "Path to a connector definition file, or a directory of connector definition files.")
+	cmd.Flags().Bool("prune", false, "Delete connectors which do not have a definition.")
+	cmd.Flags().Bool("reapply-sensitive", false, "Re-apply sensitive configurations which are masked by the API, even if no other configuration changed.")
+	pcmd.AddDryRunFlag(cmd)
+	pcmd.AddForceFlag(cmd)
+
+	if cfg.IsCloudLogin() {
+		c.AuthenticatedCLICommand = pcmd.NewAuthenticatedCLICommand(cmd, prerunner)
+		cmd.Annotations = map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLogin}
+
+		pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+		pcmd.AddContextFlag(cmd, c.CLICommand)
+		pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	} else {
+		c.AuthenticatedCLICommand = pcmd.NewAuthenticatedWithMDSCLICommand(cmd, prerunner)
+		cmd.Annotations = map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin}
+
+		cmd.Flags().String("url", "", `Base URL of the self-managed Kafka Connect REST API. Environment variable "CONFLUENT_CONNECT_URL" may be set in place of this flag.`)
+		cmd.Flags().String("certificate-authority-path", "", `Path to a PEM-encoded Certificate Authority to verify the Kafka Connect REST API. Environment variable "CONFLUENT_PLATFORM_CERTIFICATE_AUTHORITY_PATH" may be set in place of this flag.`)
+		cmd.Flags().String("client-cert-path", "", "Path to client cert to be verified by the Kafka Connect REST API. Include for mTLS authentication.")
+		cmd.Flags().String("client-key-path", "", "Path to client private key, include for mTLS authentication.")
+		cmd.Flags().Bool("prompt", false, "Bypass use of available login credentials and prompt for Kafka Connect credentials.")
+		pcmd.AddContextFlag(cmd, c.CLICommand)
+
+		cmd.MarkFlagsRequiredTogether("client-cert-path", "client-key-path")
+	}
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("file"))
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "json", "yaml", "yml"))
+
+	return cmd
+}
+
+func (c *applyCommand) apply(cmd *cobra.Command, _ []string) error {
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	prune, err := cmd.Flags().GetBool("prune")
+	if err != nil {
+		return err
+	}
+
+	reapplySensitive, err := cmd.Flags().GetBool("reapply-sensitive")
+	if err != nil {
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	definitions, err := readConnectorDefinitions(file)
+	if err != nil {
+		return err
+	}
+
+	if err := interpolateConnectorDefinitions(definitions, os.LookupEnv); err != nil {
+		return err
+	}
+
+	client, err := c.getConnectorApplyClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	connectors, err := client.listConnectors()
+	if err != nil {
+		return err
+	}
+
+	plans := planConnectorApply(definitions, connectors, prune, reapplySensitive)
+
+	list := output.NewList(cmd)
+	list.Sort(false)
+	for _, plan := range plans {
+		list.Add(&connectorApplyOut{
+			Name:    plan.name,
+			Actions: plan.actions,
+			Changes: plan.changes,
+		})
+	}
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	var deletions []string
+	for _, plan := range plans {
+		if slices.Contains(plan.actions, connectorApplyActionDelete) {
+			deletions = append(deletions, plan.name)
+		}
+	}
+	if len(deletions) > 0 {
+		if err := deletion.ConfirmPrompt(cmd, deletion.DefaultYesNoPromptString(cmd, resource.Connector, deletions, "")); err != nil {
+			return err
+		}
+	}
+
+	errs := &multierror.Error{ErrorFormat: errors.CustomMultierrorList}
+	applied := 0
+	changed := 0
+	for _, plan := range plans {
+		if slices.Equal(plan.actions, []string{connectorApplyActionNone}) {
+			continue
+		}
+		changed++
+		if err := executeConnectorApplyPlan(client, plan); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf(`failed to apply %s "%s": %w`, resource.Connector, plan.name, err))
+			continue
+		}
+		applied++
+	}
+
+	if output.GetFormat(cmd) == output.Human && changed > 0 {
+		output.Printf(c.Config.EnableColor, "Applied changes to %d of %d %s.\n", applied, changed, plural.Plural(resource.Connector))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func readConnectorDefinitions(path string) ([]connectorDefinition, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read connector definitions: %w", err)
+	}
+
+	files := []string{path}
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read connector definitions: %w", err)
+		}
+
+		files = nil
+		for _, entry := range entries {
+			if !entry.IsDir() && slices.Contains([]string{".json", ".yaml", ".yml"}, filepath.Ext(entry.Name())) {
+				files = append(files, filepath.Join(path, entry.Name()))
+			}
+		}
+	}
+
+	var definitions []connectorDefinition
+	for _, file := range files {
+		definition, err := readConnectorDefinition(file)
+		if err != nil {
+			return nil, err
+		}
+
+		if slices.ContainsFunc(definitions, func(d connectorDefinition) bool { return d.Name == definition.Name }) {
+			return nil, fmt.Errorf(`connector "%s" is defined more than once`, definition.Name)
+		}
+		definitions = append(definitions, definition)
+	}
+
+	if len(definitions) == 0 {
+		return nil, fmt.Errorf(`no connector definitions found in "%s"`, path)
+	}
+
+	slices.SortFunc(definitions, func(a, b connectorDefinition) int { return strings.Compare(a.Name, b.Name) })
+	return definitions, nil
+}
+
+func readConnectorDefinition(path string) (connectorDefinition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return connectorDefinition{}, fmt.Errorf(errors.UnableToReadConfigurationFileErrorMsg, path, err)
+	}
+
+	var definition connectorDefinition
+	switch ext := filepath.Ext(path); ext {
+	case ".json":
+		err = json.Unmarshal(data, &definition)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &definition)
+	default:
+		return connectorDefinition{}, errors.NewErrorWithSuggestions(fmt.Sprintf("unsupported file format: %s", ext), "Supported file formats are .json, .yaml, and .yml.")
+	}
+	if err != nil {
+		return connectorDefinition{}, fmt.Errorf(errors.UnableToReadConfigurationFileErrorMsg, path, err)
+	}
+
+	if definition.Name == "" {
+		definition.Name = definition.Config["name"]
+	}
+	if definition.Name == "" {
+		return connectorDefinition{}, fmt.Errorf(`connector definition "%s" has no name`, path)
+	}
+	if definition.Config == nil {
+		definition.Config = map[string]string{}
+	}
+	if name, ok := definition.Config["name"]; ok && name != definition.Name {
+		return connectorDefinition{}, fmt.Errorf(`connector definition "%s" has name "%s" but configuration "name" is "%s"`, path, definition.Name, name)
+	}
+	definition.Config["name"] = definition.Name
+
+	definition.State = strings.ToUpper(definition.State)
+	if definition.State == "" {
+		definition.State = connectorStateRunning
+	}
+	if definition.State != connectorStateRunning && definition.State != connectorStatePaused {
+		return connectorDefinition{}, fmt.Errorf(`invalid state "%s" for connector "%s": state must be "%s" or "%s"`, definition.State, definition.Name, connectorStateRunning, connectorStatePaused)
+	}
+
+	return definition, nil
+}
+
+// interpolateConnectorDefinitions replaces references to environment variables, such as "${KAFKA_API_SECRET}", in the
+// configuration values of the definitions. Secrets can then be kept out of the definition files.
+func interpolateConnectorDefinitions(definitions []connectorDefinition, lookupEnv func(string) (string, bool)) error {
+	var missing []string
+	for _, definition := range definitions {
+		for key, value := range definition.Config {
+			definition.Config[key] = connectorDefinitionEnvVarRegex.ReplaceAllStringFunc(value, func(match string) string {
+				name := connectorDefinitionEnvVarRegex.FindStringSubmatch(match)[1]
+				envValue, ok := lookupEnv(name)
+				if !ok && !slices.Contains(missing, name) {
+					missing = append(missing, name)
+				}
+				return envValue
+			})
+		}
+	}
+
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf("connector definitions reference unset environment variables: %s", strings.Join(missing, ", ")),
+			"Set the environment variables before running `confluent connect apply`.",
+		)
+	}
+
+	return nil
+}
+
+func planConnectorApply(definitions []connectorDefinition, connectors map[string]connectorApplyState, prune, reapplySensitive bool) []connectorApplyPlan {
+	var plans []connectorApplyPlan
+	for _, definition := range definitions {
+		plan := connectorApplyPlan{name: definition.Name, config: definition.Config}
+		paused := definition.State == connectorStatePaused
+
+		current, ok := connectors[definition.Name]
+		if !ok {
+			plan.actions = append(plan.actions, connectorApplyActionCreate)
+			if paused {
+				plan.actions = append(plan.actions, connectorApplyActionPause)
+			}
+			plans = append(plans, plan)
+			continue
+		}
+
+		// The API masks the values of sensitive configurations, so they cannot be compared. Since an update sends the
+		// whole configuration, they are only re-applied along with other changes unless requested.
+		changed := false
+		for _, key := range types.GetSortedKeys(definition.Config) {
+			currentValue, ok := current.config[key]
+			switch {
+			case ok && currentValue == definition.Config[key]:
+				continue
+			case ok && currentValue == maskedConfigValue && isSensitiveConfig(key):
+				plan.changes = append(plan.changes, fmt.Sprintf("%s (unknown, will be re-applied)", key))
+			default:
+				plan.changes = append(plan.changes, key)
+				changed = true
+			}
+		}
+		if !changed && !reapplySensitive {
+			plan.changes = nil
+		}
+		if len(plan.changes) > 0 {
+			plan.actions = append(plan.actions, connectorApplyActionUpdate)
+		}
+
+		if paused && !current.paused {
+			plan.actions = append(plan.actions, connectorApplyActionPause)
+		} else if !paused && current.paused {
+			plan.actions = append(plan.actions, connectorApplyActionResume)
+		}
+
+		if len(plan.actions) == 0 {
+			plan.actions = []string{connectorApplyActionNone}
+		}
+		plans = append(plans, plan)
+	}
+
+	if prune {
+		for _, name := range types.GetSortedKeys(connectors) {
+			if !slices.ContainsFunc(definitions, func(d connectorDefinition) bool { return d.Name == name }) {
+				plans = append(plans, connectorApplyPlan{name: name, actions: []string{connectorApplyActionDelete}})
+			}
+		}
+	}
+
+	return plans
+}
+
+func executeConnectorApplyPlan(client connectorApplyClient, plan connectorApplyPlan) error {
+	for _, action := range plan.actions {
+		var err error
+		switch action {
+		case connectorApplyActionCreate:
+			err = client.createConnector(plan.name, plan.config)
+		case connectorApplyActionUpdate:
+			err = client.updateConnector(plan.name, plan.config)
+		case connectorApplyActionPause:
+			err = client.pauseConnector(plan.name)
+		case connectorApplyActionResume:
+			err = client.resumeConnector(plan.name)
+		case connectorApplyActionDelete:
+			err = client.deleteConnector(plan.name)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to %s: %w", action, err)
+		}
+	}
+	return nil
+}
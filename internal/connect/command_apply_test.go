@@ -0,0 +1,102 @@
+package connect
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadConnectorDefinitions(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "orders.json"), []byte(`{"name": "orders-sink", "config": {"connector.class": "S3_SINK", "topics": "orders"}}`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "payments.yaml"), []byte("config:\n  name: payments-source\n  connector.class: PostgresSource\nstate: paused\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# Connectors"), 0600))
+
+	definitions, err := readConnectorDefinitions(dir)
+	require.NoError(t, err)
+	require.Equal(t, []connectorDefinition{
+		{Name: "orders-sink", Config: map[string]string{"name": "orders-sink", "connector.class": "S3_SINK", "topics": "orders"}, State: connectorStateRunning},
+		{Name: "payments-source", Config: map[string]string{"name": "payments-source", "connector.class": "PostgresSource"}, State: connectorStatePaused},
+	}, definitions)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "duplicate.json"), []byte(`{"name": "orders-sink", "config": {}}`), 0600))
+	_, err = readConnectorDefinitions(dir)
+	require.EqualError(t, err, `connector "orders-sink" is defined more than once`)
+
+	_, err = readConnectorDefinitions(t.TempDir())
+	require.Error(t, err)
+}
+
+func TestInterpolateConnectorDefinitions(t *testing.T) {
+	env := map[string]string{"KAFKA_API_KEY": "key", "KAFKA_API_SECRET": "secret"}
+	lookupEnv := func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+
+	definitions := []connectorDefinition{{Name: "orders-sink", Config: map[string]string{
+		"kafka.api.key":    "${KAFKA_API_KEY}",
+		"kafka.api.secret": "${KAFKA_API_SECRET}",
+		"topics":           "orders",
+	}}}
+	require.NoError(t, interpolateConnectorDefinitions(definitions, lookupEnv))
+	require.Equal(t, map[string]string{"kafka.api.key": "key", "kafka.api.secret": "secret", "topics": "orders"}, definitions[0].Config)
+
+	definitions = []connectorDefinition{{Name: "orders-sink", Config: map[string]string{"aws.secret.access.key": "${AWS_SECRET_ACCESS_KEY}", "aws.access.key.id": "${AWS_ACCESS_KEY_ID}"}}}
+	err := interpolateConnectorDefinitions(definitions, lookupEnv)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY")
+}
+
+func TestPlanConnectorApply(t *testing.T) {
+	definitions := []connectorDefinition{
+		{Name: "inventory-sink", Config: map[string]string{"name": "inventory-sink", "tasks.max": "1"}, State: connectorStatePaused},
+		{Name: "orders-sink", Config: map[string]string{"name": "orders-sink", "kafka.api.secret": "secret", "tasks.max": "2"}, State: connectorStateRunning},
+		{Name: "payments-source", Config: map[string]string{"name": "payments-source", "tasks.max": "1"}, State: connectorStatePaused},
+		{Name: "shipments-sink", Config: map[string]string{"name": "shipments-sink", "tasks.max": "1"}, State: connectorStateRunning},
+		{Name: "users-sink", Config: map[string]string{"name": "users-sink", "kafka.api.secret": "secret"}, State: connectorStateRunning},
+	}
+	connectors := map[string]connectorApplyState{
+		"audit-sink":      {config: map[string]string{"name": "audit-sink"}},
+		"orders-sink":     {config: map[string]string{"name": "orders-sink", "kafka.api.secret": maskedConfigValue, "kafka.endpoint": "SASL_SSL://pkc-123456", "tasks.max": "1"}},
+		"payments-source": {config: map[string]string{"name": "payments-source", "tasks.max": "1"}},
+		"shipments-sink":  {config: map[string]string{"name": "shipments-sink", "tasks.max": "1"}, paused: true},
+		"users-sink":      {config: map[string]string{"name": "users-sink", "kafka.api.secret": maskedConfigValue}},
+	}
+
+	type planSummary struct {
+		name    string
+		actions []string
+		changes []string
+	}
+	summarize := func(plans []connectorApplyPlan) []planSummary {
+		var summaries []planSummary
+		for _, plan := range plans {
+			summaries = append(summaries, planSummary{plan.name, plan.actions, plan.changes})
+		}
+		return summaries
+	}
+
+	expected := []planSummary{
+		{"inventory-sink", []string{connectorApplyActionCreate, connectorApplyActionPause}, nil},
+		{"orders-sink", []string{connectorApplyActionUpdate}, []string{"kafka.api.secret (unknown, will be re-applied)", "tasks.max"}},
+		{"payments-source", []string{connectorApplyActionPause}, nil},
+		{"shipments-sink", []string{connectorApplyActionResume}, nil},
+		// Masked sensitive configurations alone do not cause an update
+		{"users-sink", []string{connectorApplyActionNone}, nil},
+	}
+	require.Equal(t, expected, summarize(planConnectorApply(definitions, connectors, false, false)))
+
+	expected = append(expected, planSummary{"audit-sink", []string{connectorApplyActionDelete}, nil})
+	require.Equal(t, expected, summarize(planConnectorApply(definitions, connectors, true, false)))
+
+	connectors["payments-source"] = connectorApplyState{config: map[string]string{"name": "payments-source", "tasks.max": "1"}, paused: true}
+	plans := planConnectorApply(definitions[2:3], connectors, false, false)
+	require.Equal(t, []string{connectorApplyActionNone}, plans[0].actions)
+
+	plans = planConnectorApply(definitions[4:5], connectors, false, true)
+	require.Equal(t, []string{connectorApplyActionUpdate}, plans[0].actions)
+	require.Equal(t, []string{"kafka.api.secret (unknown, will be re-applied)"}, plans[0].changes)
+}
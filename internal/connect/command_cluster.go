@@ -37,6 +37,7 @@ func newClusterCommand(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Comm
 		cmd.AddCommand(c.newPauseCommand())
 		cmd.AddCommand(c.newResumeCommand())
 		cmd.AddCommand(c.newUpdateCommand())
+		cmd.AddCommand(c.newValidateCommand())
 	} else {
 		c.AuthenticatedCLICommand = pcmd.NewAuthenticatedWithMDSCLICommand(cmd, prerunner)
 		cmd.AddCommand(c.newListCommandOnPrem())
@@ -2,17 +2,34 @@ package connect
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/spf13/cobra"
 
 	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
 	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
 	"github.com/confluentinc/cli/v4/pkg/kafka"
 	"github.com/confluentinc/cli/v4/pkg/output"
 	"github.com/confluentinc/cli/v4/pkg/properties"
 	"github.com/confluentinc/cli/v4/pkg/resource"
+	"github.com/confluentinc/cli/v4/pkg/types"
 )
 
+const (
+	configChangeAdd     = "add"
+	configChangeRemove  = "remove"
+	configChangeUpdate  = "update"
+	configChangeUnknown = "unknown"
+)
+
+type configChangeOut struct {
+	Config  string `human:"Config"`
+	Change  string `human:"Change"`
+	Current string `human:"Current"`
+	New     string `human:"New"`
+}
+
 func (c *clusterCommand) newUpdateCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:         "update <id>",
@@ -20,10 +37,17 @@ func (c *clusterCommand) newUpdateCommand() *cobra.Command {
 		Args:        cobra.ExactArgs(1),
 		RunE:        c.update,
 		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Preview the changes to connector "lcc-123456" from configuration file "config.json", with sensitive values masked.`,
+				Code: "confluent connect cluster update lcc-123456 --config-file config.json --dry-run",
+			},
+		),
 	}
 
 	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the connector being updated.`)
 	cmd.Flags().String("config-file", "", "JSON connector configuration file.")
+	pcmd.AddDryRunFlag(cmd)
 	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
 	pcmd.AddContextFlag(cmd, c.CLICommand)
 	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
@@ -79,6 +103,25 @@ func (c *clusterCommand) update(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		changes := diffConnectorConfigs(connector.Info.GetConfig(), userConfigs)
+		if len(changes) == 0 {
+			output.Printf(c.Config.EnableColor, "No changes to connector \"%s\".\n", args[0])
+			return nil
+		}
+
+		list := output.NewList(cmd)
+		list.Sort(false)
+		for _, change := range changes {
+			list.Add(change)
+		}
+		return list.Print()
+	}
+
 	if _, err := c.V2Client.CreateOrUpdateConnectorConfig(connector.Info.GetName(), environmentId, kafkaCluster.ID, userConfigs); err != nil {
 		return err
 	}
@@ -86,3 +129,46 @@ func (c *clusterCommand) update(cmd *cobra.Command, args []string) error {
 	output.Printf(c.Config.EnableColor, errors.UpdatedResourceMsg, resource.Connector, args[0])
 	return nil
 }
+
+// diffConnectorConfigs returns the changes from the current to the desired configuration of a connector, sorted by
+// name. The values of sensitive configurations are masked. Since the API masks their current values too, a sensitive
+// configuration set to a new value is an unknown change. Server-managed configurations are not reported as removed.
+func diffConnectorConfigs(current, desired map[string]string) []*configChangeOut {
+	names := types.GetSortedKeys(current)
+	for _, name := range types.GetSortedKeys(desired) {
+		if _, ok := current[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	var changes []*configChangeOut
+	for _, name := range names {
+		currentValue, inCurrent := current[name]
+		desiredValue, inDesired := desired[name]
+
+		change := &configChangeOut{
+			Config:  name,
+			Current: maskConfigValue(name, currentValue),
+			New:     maskConfigValue(name, desiredValue),
+		}
+		switch {
+		case !inCurrent:
+			change.Change = configChangeAdd
+		case !inDesired:
+			if slices.Contains(serverManagedConfigs, name) {
+				continue
+			}
+			change.Change = configChangeRemove
+		case currentValue == desiredValue:
+			continue
+		case currentValue == maskedConfigValue && isSensitiveConfig(name):
+			change.Change = configChangeUnknown
+		default:
+			change.Change = configChangeUpdate
+		}
+		changes = append(changes, change)
+	}
+
+	return changes
+}
@@ -0,0 +1,83 @@
+package connect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
+)
+
+func TestIsSensitiveConfig(t *testing.T) {
+	for _, name := range []string{"kafka.api.secret", "connection.password", "gcs.credentials.config", "sasl.jaas.config", "KAFKA.API.KEY"} {
+		require.True(t, isSensitiveConfig(name), name)
+	}
+	for _, name := range []string{"name", "connector.class", "topics", "tasks.max"} {
+		require.False(t, isSensitiveConfig(name), name)
+	}
+}
+
+func TestMaskConfigValue(t *testing.T) {
+	require.Equal(t, maskedConfigValue, maskConfigValue("connection.password", "hunter2"))
+	require.Equal(t, "", maskConfigValue("connection.password", ""))
+	require.Equal(t, "orders", maskConfigValue("topics", "orders"))
+}
+
+func TestDiffConnectorConfigs(t *testing.T) {
+	current := map[string]string{
+		"connection.password": "old",
+		"kafka.api.secret":    maskedConfigValue,
+		"kafka.endpoint":      "SASL_SSL://pkc-123456",
+		"name":                "my-connector",
+		"sasl.jaas.config":    maskedConfigValue,
+		"tasks.max":           "1",
+		"topics":              "orders",
+	}
+	desired := map[string]string{
+		"connection.password": "new",
+		"kafka.api.secret":    "secret",
+		"name":                "my-connector",
+		"sasl.jaas.config":    maskedConfigValue,
+		"tasks.max":           "2",
+		"time.interval":       "DAILY",
+	}
+
+	require.Equal(t, []*configChangeOut{
+		{Config: "connection.password", Change: configChangeUpdate, Current: maskedConfigValue, New: maskedConfigValue},
+		{Config: "kafka.api.secret", Change: configChangeUnknown, Current: maskedConfigValue, New: maskedConfigValue},
+		{Config: "tasks.max", Change: configChangeUpdate, Current: "1", New: "2"},
+		{Config: "time.interval", Change: configChangeAdd, New: "DAILY"},
+		{Config: "topics", Change: configChangeRemove, Current: "orders"},
+	}, diffConnectorConfigs(current, desired))
+
+	require.Empty(t, diffConnectorConfigs(current, current))
+}
+
+func TestGetConfigValidations(t *testing.T) {
+	newConfig := func(name, value, configType string, errors []string) connectv1.InlineResponse2003Configs {
+		return connectv1.InlineResponse2003Configs{
+			Definition: &connectv1.InlineResponse2003Definition{Name: connectv1.PtrString(name), Type: connectv1.PtrString(configType)},
+			Value:      &connectv1.InlineResponse2003Value{Name: connectv1.PtrString(name), Value: connectv1.PtrString(value), Errors: &errors},
+		}
+	}
+
+	configs := []connectv1.InlineResponse2003Configs{
+		newConfig("topics", "orders", "LIST", nil),
+		newConfig("ssl.truststore.location", "/truststore.jks", "STRING", nil),
+		newConfig("tasks.max", "0", "INT", []string{"Invalid value 0 for configuration tasks.max"}),
+		newConfig("connection.user.info", "admin:admin", "PASSWORD", nil),
+		newConfig("kafka.topic", "", "STRING", []string{"Missing required configuration"}),
+	}
+	userConfigs := map[string]string{"topics": "orders", "tasks.max": "0", "connection.user.info": "admin:admin"}
+
+	rows, errorCount := getConfigValidations(configs, userConfigs)
+	require.Equal(t, 2, errorCount)
+
+	var names, values []string
+	for _, row := range rows {
+		names = append(names, row.Config)
+		values = append(values, row.Value)
+	}
+	require.Equal(t, []string{"kafka.topic", "tasks.max", "connection.user.info", "topics"}, names)
+	require.Equal(t, []string{"", "0", maskedConfigValue, "orders"}, values)
+}
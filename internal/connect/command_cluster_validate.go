@@ -0,0 +1,147 @@
+package connect
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/kafka"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+type configValidationOut struct {
+	Config            string   `human:"Config" serialized:"config"`
+	Value             string   `human:"Value" serialized:"value"`
+	IsRequired        bool     `human:"Required" serialized:"is_required"`
+	Errors            []string `human:"Errors" serialized:"errors"`
+	RecommendedValues []string `human:"Recommended Values" serialized:"recommended_values"`
+}
+
+func (c *clusterCommand) newValidateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate a connector configuration.",
+		Long:  "Validate a connector configuration against its plugin without creating or updating the connector. Configurations which are set in the file or which have errors are listed, with the values of sensitive configurations masked.",
+		Args:  cobra.NoArgs,
+		RunE:  c.validate,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Validate the connector configuration in "config.json" before creating the connector.`,
+				Code: "confluent connect cluster validate --config-file config.json",
+			},
+		),
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLogin},
+	}
+
+	cmd.Flags().String("config-file", "", "JSON connector configuration file.")
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagFilename("config-file", "json"))
+
+	cobra.CheckErr(cmd.MarkFlagRequired("config-file"))
+
+	return cmd
+}
+
+func (c *clusterCommand) validate(cmd *cobra.Command, _ []string) error {
+	kafkaCluster, err := kafka.GetClusterForCommand(c.V2Client, c.Context)
+	if err != nil {
+		return err
+	}
+
+	userConfigs, _, err := getConfigAndOffsets(cmd, false)
+	if err != nil {
+		return err
+	}
+
+	environmentId, err := c.Context.EnvironmentId()
+	if err != nil {
+		return err
+	}
+
+	plugin := userConfigs["connector.class"]
+	if plugin == "" {
+		return fmt.Errorf(`required config "connector.class" missing from connector config file`)
+	}
+
+	reply, err := c.V2Client.ValidateConnectorPlugin(plugin, environmentId, kafkaCluster.ID, userConfigs)
+	if err != nil {
+		return errors.NewWrapErrorWithSuggestions(err, "failed to validate connector configuration", "To list available connector plugin types, use `confluent connect plugin list`.")
+	}
+
+	rows, errorCount := getConfigValidations(reply.GetConfigs(), userConfigs)
+
+	list := output.NewList(cmd)
+	list.Sort(false)
+	for _, row := range rows {
+		list.Add(row)
+	}
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	if errorCount > 0 {
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf("connector configuration has %d error(s)", errorCount),
+			"Fix the errors listed above, then run `confluent connect cluster validate` again.",
+		)
+	}
+
+	if !output.GetFormat(cmd).IsSerialized() {
+		output.Println(c.Config.EnableColor, "Connector configuration is valid.")
+	}
+	return nil
+}
+
+// getConfigValidations returns the configurations which are set by the user or have errors, with configurations that
+// have errors listed first, along with the number of errors.
+func getConfigValidations(configs []connectv1.InlineResponse2003Configs, userConfigs map[string]string) ([]*configValidationOut, int) {
+	var invalid, valid []*configValidationOut
+	errorCount := 0
+	for _, config := range configs {
+		name := config.Value.GetName()
+		configErrors := config.Value.GetErrors()
+		if _, ok := userConfigs[name]; !ok && len(configErrors) == 0 {
+			continue
+		}
+
+		value := maskConfigValue(name, config.Value.GetValue())
+		if strings.EqualFold(config.Definition.GetType(), "PASSWORD") && value != "" {
+			value = maskedConfigValue
+		}
+
+		row := &configValidationOut{
+			Config:            name,
+			Value:             value,
+			IsRequired:        config.Definition.GetRequired(),
+			Errors:            configErrors,
+			RecommendedValues: config.Value.GetRecommendedValues(),
+		}
+		if len(configErrors) > 0 {
+			errorCount += len(configErrors)
+			invalid = append(invalid, row)
+		} else {
+			valid = append(valid, row)
+		}
+	}
+
+	sortConfigValidations(invalid)
+	sortConfigValidations(valid)
+	return append(invalid, valid...), errorCount
+}
+
+func sortConfigValidations(rows []*configValidationOut) {
+	slices.SortFunc(rows, func(a, b *configValidationOut) int {
+		return strings.Compare(a.Config, b.Config)
+	})
+}
@@ -0,0 +1,575 @@
+package connect
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+
+	"github.com/confluentinc/cli/v4/pkg/ccloudv2"
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/kafka"
+	"github.com/confluentinc/cli/v4/pkg/output"
+	"github.com/confluentinc/cli/v4/pkg/schemaregistry"
+)
+
+const (
+	doctorSeverityCritical = "CRITICAL"
+	doctorSeverityWarning  = "WARNING"
+	doctorSeverityInfo     = "INFO"
+
+	doctorLogsMaxPages   = 5
+	doctorMaxSubjects    = 10
+	connectLogEventTopic = "confluent-connect-log-events"
+)
+
+var doctorSeverityRanks = map[string]int{
+	doctorSeverityCritical: 0,
+	doctorSeverityWarning:  1,
+	doctorSeverityInfo:     2,
+}
+
+// schemaTypesByDataFormat maps the data formats of managed connectors which use Schema Registry to the type of their
+// schemas. Schema Registry reports the type of Avro schemas as "".
+var schemaTypesByDataFormat = map[string]string{
+	"AVRO":     "AVRO",
+	"JSON_SR":  "JSON",
+	"PROTOBUF": "PROTOBUF",
+}
+
+type doctorCommand struct {
+	*pcmd.AuthenticatedCLICommand
+}
+
+type doctorFindingOut struct {
+	Severity   string `human:"Severity" serialized:"severity"`
+	Check      string `human:"Check" serialized:"check"`
+	Finding    string `human:"Finding" serialized:"finding"`
+	Suggestion string `human:"Suggestion" serialized:"suggestion"`
+}
+
+// connectorDiagnosis holds everything gathered about a connector by `confluent connect doctor`. An error from one of
+// the sources is reported as a finding, so that the other checks still run.
+type connectorDiagnosis struct {
+	id        string
+	since     string
+	connector *connectv1.ConnectV1ConnectorExpansion
+
+	logs    []ccloudv2.LoggingLogEntry
+	logsErr error
+
+	lags   []kafkarestv3.ConsumerLagData
+	lagErr error
+
+	// schemaTypes holds the type of the latest schema of each subject checked, or "" if the subject does not exist
+	schemaTypes map[string]string
+	schemaErr   error
+
+	eventsClusterId     string
+	eventsEnvironmentId string
+}
+
+func newDoctorCommand(prerunner pcmd.PreRunner) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "doctor <id>",
+		Short: "Diagnose the health of a connector.",
+		Long: "Diagnose a managed connector by gathering its status, error logs, consumer lag, and Schema Registry subjects concurrently, and report the findings from most to least severe.\n\n" +
+			"Task failures are correlated with the latest error log of each task. Consumer lag is only checked for sink connectors in dedicated Kafka clusters. " +
+			"The configuration is checked for a missing dead letter queue, and for a data format which does not match the latest schema of the value subject of each topic.",
+		Args: cobra.ExactArgs(1),
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Diagnose connector "lcc-123456", looking for error logs from the last hour.`,
+				Code: "confluent connect doctor lcc-123456",
+			},
+			examples.Example{
+				Text: "Diagnose a connector, looking for error logs from the last day.",
+				Code: "confluent connect doctor lcc-123456 --since 1d",
+			},
+		),
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLogin},
+	}
+
+	c := &doctorCommand{pcmd.NewAuthenticatedCLICommand(cmd, prerunner)}
+	cmd.RunE = c.doctor
+
+	cmd.Flags().String("since", "1h", `Look for error logs in a time window ending now, such as "15m", "2h", or "1d", of at most 72 hours.`)
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *doctorCommand) doctor(cmd *cobra.Command, args []string) error {
+	since, err := cmd.Flags().GetString("since")
+	if err != nil {
+		return err
+	}
+
+	window, err := parseLogsSince(since)
+	if err != nil {
+		return err
+	}
+
+	kafkaCluster, err := kafka.GetClusterForCommand(c.V2Client, c.Context)
+	if err != nil {
+		return err
+	}
+
+	environmentId, err := c.Context.EnvironmentId()
+	if err != nil {
+		return err
+	}
+
+	connector, err := c.V2Client.GetConnectorExpansionById(args[0], environmentId, kafkaCluster.ID)
+	if err != nil {
+		return err
+	}
+
+	auditLog := c.Context.GetOrganization().GetAuditLog()
+	diagnosis := &connectorDiagnosis{
+		id:                  args[0],
+		since:               since,
+		connector:           connector,
+		eventsClusterId:     auditLog.GetClusterId(),
+		eventsEnvironmentId: auditLog.GetAccountId(),
+	}
+
+	// The clients are created before any request is sent, since creating them can update the configuration
+	var kafkaREST *pcmd.KafkaREST
+	if isSinkConnector(connector) {
+		kafkaREST, diagnosis.lagErr = c.GetKafkaREST(cmd)
+	}
+
+	var srClient *schemaregistry.Client
+	_, subjects := getDoctorSubjects(connector)
+	if len(subjects) > 0 {
+		srClient, diagnosis.schemaErr = c.GetSchemaRegistryClient(cmd)
+	}
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		now := time.Now().UTC().Truncate(time.Second)
+		query := logsQuery{
+			crn:         fmt.Sprintf("crn://confluent.cloud/organization=%s/environment=%s/cloud-cluster=%s/connector=%s", c.Context.GetCurrentOrganization(), environmentId, kafkaCluster.ID, connector.Info.GetName()),
+			connectorId: args[0],
+			levels:      []string{"ERROR"},
+		}
+		logs := &logsCommand{c.AuthenticatedCLICommand}
+		diagnosis.logs, diagnosis.logsErr = logs.searchAllConnectorLogs(query, formatLogsTime(now.Add(-window)), formatLogsTime(now), doctorLogsMaxPages)
+	}()
+
+	if kafkaREST != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			diagnosis.lags, diagnosis.lagErr = kafkaREST.CloudClient.ListKafkaConsumerLags(getConnectorConsumerGroup(args[0]))
+		}()
+	}
+
+	if srClient != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			diagnosis.schemaTypes, diagnosis.schemaErr = getLatestSchemaTypes(srClient, subjects)
+		}()
+	}
+
+	wg.Wait()
+
+	findings := diagnosis.diagnose()
+	if len(findings) == 0 && !output.GetFormat(cmd).IsSerialized() {
+		output.Printf(c.Config.EnableColor, "No issues found for connector \"%s\".\n", args[0])
+		return nil
+	}
+
+	list := output.NewList(cmd)
+	list.Sort(false)
+	for _, finding := range findings {
+		list.Add(finding)
+	}
+	return list.Print()
+}
+
+// getLatestSchemaTypes returns the type of the latest schema of each subject, or "" for subjects which do not exist.
+func getLatestSchemaTypes(client *schemaregistry.Client, subjects []string) (map[string]string, error) {
+	schemaTypes := make(map[string]string, len(subjects))
+	for _, subject := range subjects {
+		existing, err := client.List(subject, false)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(existing, subject) {
+			schemaTypes[subject] = ""
+			continue
+		}
+
+		schema, err := client.GetSchemaByVersion(subject, "latest", false)
+		if err != nil {
+			return nil, err
+		}
+		schemaTypes[subject] = cmp.Or(schema.GetSchemaType(), "AVRO")
+	}
+	return schemaTypes, nil
+}
+
+func isSinkConnector(connector *connectv1.ConnectV1ConnectorExpansion) bool {
+	return strings.EqualFold(connector.Status.GetType(), "sink")
+}
+
+// getConnectorConsumerGroup returns the consumer group of a managed sink connector.
+func getConnectorConsumerGroup(id string) string {
+	return "connect-" + id
+}
+
+// getDoctorSubjects returns the data format of the records a connector reads or writes, and the value subjects of the
+// topics they are in, using the default subject name strategy.
+func getDoctorSubjects(connector *connectv1.ConnectV1ConnectorExpansion) (string, []string) {
+	config := connector.Info.GetConfig()
+
+	var format string
+	var topics []string
+	if isSinkConnector(connector) {
+		format = config["input.data.format"]
+		for _, topic := range strings.Split(config["topics"], ",") {
+			if topic = strings.TrimSpace(topic); topic != "" {
+				topics = append(topics, topic)
+			}
+		}
+	} else {
+		format = config["output.data.format"]
+		if topic := strings.TrimSpace(config["kafka.topic"]); topic != "" {
+			topics = append(topics, topic)
+		}
+	}
+
+	if format == "" {
+		return "", nil
+	}
+
+	subjects := make([]string, 0, len(topics))
+	for _, topic := range topics[:min(len(topics), doctorMaxSubjects)] {
+		subjects = append(subjects, topic+"-value")
+	}
+	return strings.ToUpper(format), subjects
+}
+
+// diagnose returns the findings for a connector, from most to least severe.
+func (d *connectorDiagnosis) diagnose() []*doctorFindingOut {
+	var findings []*doctorFindingOut
+	findings = append(findings, d.checkConnector()...)
+	findings = append(findings, d.checkTasks()...)
+	findings = append(findings, d.checkLogs()...)
+	findings = append(findings, d.checkLag()...)
+	findings = append(findings, d.checkDeadLetterQueue()...)
+	findings = append(findings, d.checkSchemas()...)
+	findings = append(findings, d.checkEvents()...)
+
+	slices.SortStableFunc(findings, func(a, b *doctorFindingOut) int {
+		return cmp.Compare(doctorSeverityRanks[a.Severity], doctorSeverityRanks[b.Severity])
+	})
+	return findings
+}
+
+func (d *connectorDiagnosis) isFailing() bool {
+	if d.connector.Status.Connector.GetState() == connectorStateFailed {
+		return true
+	}
+	return slices.ContainsFunc(d.connector.Status.GetTasks(), func(task connectv1.InlineResponse2001Tasks) bool {
+		return task.GetState() == connectorStateFailed
+	})
+}
+
+func (d *connectorDiagnosis) checkConnector() []*doctorFindingOut {
+	switch state := d.connector.Status.Connector.GetState(); state {
+	case connectorStateRunning:
+		return nil
+	case connectorStateFailed:
+		finding := "Connector is FAILED."
+		if trace := getFirstLine(d.connector.Status.Connector.GetTrace()); trace != "" {
+			finding = fmt.Sprintf("Connector is FAILED: %s", trace)
+		}
+		return []*doctorFindingOut{{
+			Severity:   doctorSeverityCritical,
+			Check:      "Connector",
+			Finding:    finding,
+			Suggestion: fmt.Sprintf("Fix the cause of the failure with `confluent connect cluster update %s`, which restarts the connector.", d.id),
+		}}
+	case connectorStatePaused:
+		return []*doctorFindingOut{{
+			Severity:   doctorSeverityInfo,
+			Check:      "Connector",
+			Finding:    "Connector is PAUSED.",
+			Suggestion: fmt.Sprintf("Run `confluent connect cluster resume %s` to resume it.", d.id),
+		}}
+	default:
+		return []*doctorFindingOut{{
+			Severity: doctorSeverityWarning,
+			Check:    "Connector",
+			Finding:  fmt.Sprintf("Connector is %s.", state),
+		}}
+	}
+}
+
+func (d *connectorDiagnosis) checkTasks() []*doctorFindingOut {
+	var findings []*doctorFindingOut
+	for _, task := range d.connector.Status.GetTasks() {
+		if task.GetState() != connectorStateFailed {
+			continue
+		}
+
+		finding := fmt.Sprintf("Task %d is FAILED", task.GetId())
+		if msg := getFirstLine(task.GetMsg()); msg != "" {
+			finding += ": " + msg
+		}
+		finding += "."
+		if entry := d.getLatestTaskLog(task.GetId()); entry != nil {
+			finding += fmt.Sprintf(" Its latest error log, at %s, is: %s", entry.Timestamp, getLogSummary(*entry))
+		}
+
+		findings = append(findings, &doctorFindingOut{
+			Severity:   doctorSeverityCritical,
+			Check:      "Tasks",
+			Finding:    finding,
+			Suggestion: fmt.Sprintf("Run `confluent connect logs %s --since %s --group-by exception` to see all of its errors.", d.id, d.since),
+		})
+	}
+	return findings
+}
+
+// getLatestTaskLog returns the latest error log of a task, if any.
+func (d *connectorDiagnosis) getLatestTaskLog(id int32) *ccloudv2.LoggingLogEntry {
+	var latest *ccloudv2.LoggingLogEntry
+	for i, entry := range d.logs {
+		if entry.TaskId != fmt.Sprintf("task-%d", id) {
+			continue
+		}
+		if latest == nil || parseLogTimestamp(entry.Timestamp).After(parseLogTimestamp(latest.Timestamp)) {
+			latest = &d.logs[i]
+		}
+	}
+	return latest
+}
+
+func (d *connectorDiagnosis) checkLogs() []*doctorFindingOut {
+	if d.logsErr != nil {
+		return []*doctorFindingOut{{
+			Severity: doctorSeverityInfo,
+			Check:    "Logs",
+			Finding:  fmt.Sprintf("Failed to read the error logs: %v", d.logsErr),
+		}}
+	}
+
+	if len(d.logs) == 0 {
+		return nil
+	}
+
+	finding := fmt.Sprintf("%d error log(s) in the last %s", len(d.logs), d.since)
+	if groups := groupLogsByException(d.logs); len(groups) > 0 {
+		finding += fmt.Sprintf(", with %d exception(s) of the most frequent kind: %s", groups[0].Count, groups[0].Exception)
+	} else {
+		latest := slices.MaxFunc(d.logs, func(a, b ccloudv2.LoggingLogEntry) int {
+			return parseLogTimestamp(a.Timestamp).Compare(parseLogTimestamp(b.Timestamp))
+		})
+		finding += fmt.Sprintf(", the latest of which is: %s", latest.Message)
+	}
+
+	// Errors logged by a connector which is not failing usually mean that records are being skipped
+	severity := doctorSeverityWarning
+	if d.isFailing() {
+		severity = doctorSeverityInfo
+	}
+
+	return []*doctorFindingOut{{
+		Severity:   severity,
+		Check:      "Logs",
+		Finding:    finding,
+		Suggestion: fmt.Sprintf("Run `confluent connect logs %s --since %s --group-by exception` to see all of them.", d.id, d.since),
+	}}
+}
+
+func (d *connectorDiagnosis) checkLag() []*doctorFindingOut {
+	if !isSinkConnector(d.connector) {
+		return nil
+	}
+
+	group := getConnectorConsumerGroup(d.id)
+	if d.lagErr != nil {
+		return []*doctorFindingOut{{
+			Severity: doctorSeverityInfo,
+			Check:    "Lag",
+			Finding:  fmt.Sprintf(`Failed to read the lag of consumer group "%s": %v`, group, d.lagErr),
+		}}
+	}
+
+	var total int64
+	var partitions int
+	var maxLag *kafkarestv3.ConsumerLagData
+	for i, lag := range d.lags {
+		if lag.GetLag() <= 0 {
+			continue
+		}
+		total += lag.GetLag()
+		partitions++
+		if maxLag == nil || lag.GetLag() > maxLag.GetLag() {
+			maxLag = &d.lags[i]
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+
+	finding := fmt.Sprintf(`Consumer group "%s" is %d record(s) behind across %d partition(s), and %d behind on partition %d of topic "%s".`, group, total, partitions, maxLag.GetLag(), maxLag.GetPartitionId(), maxLag.GetTopicName())
+	if d.isFailing() {
+		return []*doctorFindingOut{{
+			Severity:   doctorSeverityWarning,
+			Check:      "Lag",
+			Finding:    finding + " Records are not being delivered while the connector is failing.",
+			Suggestion: "Fix the failures above for the connector to catch up.",
+		}}
+	}
+
+	return []*doctorFindingOut{{
+		Severity:   doctorSeverityInfo,
+		Check:      "Lag",
+		Finding:    finding,
+		Suggestion: `Run this command again to check that the lag is decreasing. If it keeps growing, increase "tasks.max".`,
+	}}
+}
+
+func (d *connectorDiagnosis) checkDeadLetterQueue() []*doctorFindingOut {
+	if !isSinkConnector(d.connector) {
+		return nil
+	}
+
+	config := d.connector.Info.GetConfig()
+	if strings.EqualFold(config["errors.tolerance"], "none") {
+		return []*doctorFindingOut{{
+			Severity:   doctorSeverityWarning,
+			Check:      "Dead Letter Queue",
+			Finding:    `"errors.tolerance" is "none", so a record which cannot be processed fails the connector instead of being sent to a dead letter queue.`,
+			Suggestion: fmt.Sprintf(`Set "errors.tolerance" to "all" with `+"`confluent connect cluster update %s`.", d.id),
+		}}
+	}
+
+	if topic, ok := config["errors.deadletterqueue.topic.name"]; ok && strings.TrimSpace(topic) == "" {
+		return []*doctorFindingOut{{
+			Severity:   doctorSeverityWarning,
+			Check:      "Dead Letter Queue",
+			Finding:    "No dead letter queue topic is set, so records which cannot be processed are dropped.",
+			Suggestion: fmt.Sprintf(`Set "errors.deadletterqueue.topic.name" with `+"`confluent connect cluster update %s`.", d.id),
+		}}
+	}
+
+	return nil
+}
+
+func (d *connectorDiagnosis) checkSchemas() []*doctorFindingOut {
+	format, subjects := getDoctorSubjects(d.connector)
+	if len(subjects) == 0 {
+		return nil
+	}
+
+	if d.schemaErr != nil {
+		return []*doctorFindingOut{{
+			Severity: doctorSeverityInfo,
+			Check:    "Schema",
+			Finding:  fmt.Sprintf("Failed to read the subjects from Schema Registry: %v", d.schemaErr),
+		}}
+	}
+
+	isSink := isSinkConnector(d.connector)
+	formatConfig := "output.data.format"
+	verb := "writes"
+	if isSink {
+		formatConfig = "input.data.format"
+		verb = "reads"
+	}
+
+	expectedSchemaType, usesSchemaRegistry := schemaTypesByDataFormat[format]
+
+	var findings []*doctorFindingOut
+	for _, subject := range subjects {
+		schemaType := d.schemaTypes[subject]
+		switch {
+		case usesSchemaRegistry && schemaType == "":
+			// Source connectors register the subject when they first write to the topic
+			if isSink {
+				findings = append(findings, &doctorFindingOut{
+					Severity:   doctorSeverityWarning,
+					Check:      "Schema",
+					Finding:    fmt.Sprintf(`The connector reads %s records, but subject "%s" does not exist.`, format, subject),
+					Suggestion: fmt.Sprintf(`Check that the records are produced with Schema Registry, or set "%s" to the format they are produced in.`, formatConfig),
+				})
+			}
+		case usesSchemaRegistry && schemaType != expectedSchemaType:
+			severity := doctorSeverityWarning
+			if isSink {
+				severity = doctorSeverityCritical
+			}
+			findings = append(findings, &doctorFindingOut{
+				Severity:   severity,
+				Check:      "Schema",
+				Finding:    fmt.Sprintf(`The connector %s %s records, but the latest schema of subject "%s" is %s.`, verb, format, subject, schemaType),
+				Suggestion: fmt.Sprintf(`Set "%s" to "%s" with `+"`confluent connect cluster update %s`.", formatConfig, getDataFormat(schemaType), d.id),
+			})
+		case !usesSchemaRegistry && schemaType != "" && isSink:
+			findings = append(findings, &doctorFindingOut{
+				Severity:   doctorSeverityWarning,
+				Check:      "Schema",
+				Finding:    fmt.Sprintf(`The connector reads %s records, but subject "%s" has a %s schema, so the records are likely serialized with Schema Registry.`, format, subject, schemaType),
+				Suggestion: fmt.Sprintf(`Set "%s" to "%s" with `+"`confluent connect cluster update %s`.", formatConfig, getDataFormat(schemaType), d.id),
+			})
+		}
+	}
+	return findings
+}
+
+func (d *connectorDiagnosis) checkEvents() []*doctorFindingOut {
+	if !d.isFailing() || d.eventsClusterId == "" {
+		return nil
+	}
+
+	return []*doctorFindingOut{{
+		Severity:   doctorSeverityInfo,
+		Check:      "Events",
+		Finding:    fmt.Sprintf(`Connect log events, including the state changes of the connector, are published to topic "%s" in Kafka cluster "%s".`, connectLogEventTopic, d.eventsClusterId),
+		Suggestion: fmt.Sprintf("Run `confluent kafka topic consume %s --cluster %s --environment %s --from-beginning` to see them.", connectLogEventTopic, d.eventsClusterId, d.eventsEnvironmentId),
+	}}
+}
+
+// getDataFormat returns the data format of managed connectors for a schema type.
+func getDataFormat(schemaType string) string {
+	for format, formatSchemaType := range schemaTypesByDataFormat {
+		if formatSchemaType == schemaType {
+			return format
+		}
+	}
+	return schemaType
+}
+
+// getLogSummary returns the exception of a log entry, or its message if it does not have one.
+func getLogSummary(entry ccloudv2.LoggingLogEntry) string {
+	if entry.Exception != nil && strings.TrimSpace(entry.Exception.Stacktrace) != "" {
+		return getExceptionSummary(entry.Exception.Stacktrace)
+	}
+	return getFirstLine(entry.Message)
+}
+
+func getFirstLine(s string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(s), "\n")
+	return strings.TrimSpace(line)
+}
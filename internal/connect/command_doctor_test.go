@@ -0,0 +1,125 @@
+package connect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+
+	"github.com/confluentinc/cli/v4/pkg/ccloudv2"
+)
+
+func newTestConnectorExpansion(connectorType, state string, tasks []connectv1.InlineResponse2001Tasks, config map[string]string) *connectv1.ConnectV1ConnectorExpansion {
+	return &connectv1.ConnectV1ConnectorExpansion{
+		Info: &connectv1.ConnectV1ConnectorExpansionInfo{
+			Name:   connectv1.PtrString("orders-sink"),
+			Config: &config,
+		},
+		Status: &connectv1.ConnectV1ConnectorExpansionStatus{
+			Name:      "orders-sink",
+			Type:      connectorType,
+			Connector: connectv1.ConnectV1ConnectorExpansionStatusConnector{State: state},
+			Tasks:     &tasks,
+		},
+	}
+}
+
+func TestDiagnoseHealthyConnector(t *testing.T) {
+	diagnosis := &connectorDiagnosis{
+		id:          "lcc-123456",
+		since:       "1h",
+		connector:   newTestConnectorExpansion("sink", "RUNNING", []connectv1.InlineResponse2001Tasks{{Id: 0, State: "RUNNING"}}, map[string]string{"topics": "orders", "input.data.format": "AVRO"}),
+		lags:        []kafkarestv3.ConsumerLagData{{TopicName: "orders", PartitionId: 0, Lag: 0}},
+		schemaTypes: map[string]string{"orders-value": "AVRO"},
+	}
+	require.Empty(t, diagnosis.diagnose())
+}
+
+func TestDiagnoseFailedTask(t *testing.T) {
+	diagnosis := &connectorDiagnosis{
+		id:    "lcc-123456",
+		since: "1h",
+		connector: newTestConnectorExpansion("sink", "RUNNING", []connectv1.InlineResponse2001Tasks{
+			{Id: 0, State: "RUNNING"},
+			{Id: 1, State: "FAILED", Msg: connectv1.PtrString("org.apache.kafka.connect.errors.ConnectException: Tolerance exceeded\n\tat WorkerSinkTask.java")},
+		}, map[string]string{"topics": "orders", "input.data.format": "AVRO", "errors.tolerance": "none"}),
+		logs: []ccloudv2.LoggingLogEntry{
+			{Timestamp: "2025-02-01T00:00:00Z", TaskId: "task-1", Message: "Task threw an uncaught exception", Exception: &ccloudv2.LoggingException{Stacktrace: "org.apache.kafka.common.errors.SerializationException: Unknown magic byte!\n\tat AbstractKafkaAvroDeserializer.java"}},
+			{Timestamp: "2025-02-01T00:05:00Z", TaskId: "task-1", Message: "Task is being killed"},
+		},
+		lags: []kafkarestv3.ConsumerLagData{
+			{TopicName: "orders", PartitionId: 0, Lag: 10},
+			{TopicName: "orders", PartitionId: 1, Lag: 90},
+		},
+		schemaTypes:         map[string]string{"orders-value": "PROTOBUF"},
+		eventsClusterId:     "lkc-events",
+		eventsEnvironmentId: "env-events",
+	}
+
+	require.Equal(t, []*doctorFindingOut{
+		{
+			Severity:   doctorSeverityCritical,
+			Check:      "Tasks",
+			Finding:    "Task 1 is FAILED: org.apache.kafka.connect.errors.ConnectException: Tolerance exceeded. Its latest error log, at 2025-02-01T00:05:00Z, is: Task is being killed",
+			Suggestion: "Run `confluent connect logs lcc-123456 --since 1h --group-by exception` to see all of its errors.",
+		},
+		{
+			Severity:   doctorSeverityCritical,
+			Check:      "Schema",
+			Finding:    `The connector reads AVRO records, but the latest schema of subject "orders-value" is PROTOBUF.`,
+			Suggestion: `Set "input.data.format" to "PROTOBUF" with ` + "`confluent connect cluster update lcc-123456`.",
+		},
+		{
+			Severity:   doctorSeverityWarning,
+			Check:      "Lag",
+			Finding:    `Consumer group "connect-lcc-123456" is 100 record(s) behind across 2 partition(s), and 90 behind on partition 1 of topic "orders". Records are not being delivered while the connector is failing.`,
+			Suggestion: "Fix the failures above for the connector to catch up.",
+		},
+		{
+			Severity:   doctorSeverityWarning,
+			Check:      "Dead Letter Queue",
+			Finding:    `"errors.tolerance" is "none", so a record which cannot be processed fails the connector instead of being sent to a dead letter queue.`,
+			Suggestion: `Set "errors.tolerance" to "all" with ` + "`confluent connect cluster update lcc-123456`.",
+		},
+		{
+			Severity:   doctorSeverityInfo,
+			Check:      "Logs",
+			Finding:    "2 error log(s) in the last 1h, with 1 exception(s) of the most frequent kind: org.apache.kafka.common.errors.SerializationException: Unknown magic byte!",
+			Suggestion: "Run `confluent connect logs lcc-123456 --since 1h --group-by exception` to see all of them.",
+		},
+		{
+			Severity:   doctorSeverityInfo,
+			Check:      "Events",
+			Finding:    `Connect log events, including the state changes of the connector, are published to topic "confluent-connect-log-events" in Kafka cluster "lkc-events".`,
+			Suggestion: "Run `confluent kafka topic consume confluent-connect-log-events --cluster lkc-events --environment env-events --from-beginning` to see them.",
+		},
+	}, diagnosis.diagnose())
+}
+
+func TestDiagnoseSchemaSubjects(t *testing.T) {
+	diagnosis := &connectorDiagnosis{
+		id:          "lcc-123456",
+		connector:   newTestConnectorExpansion("sink", "RUNNING", nil, map[string]string{"topics": "orders, payments", "input.data.format": "JSON"}),
+		schemaTypes: map[string]string{"orders-value": "", "payments-value": "JSON"},
+	}
+	require.Equal(t, []*doctorFindingOut{{
+		Severity:   doctorSeverityWarning,
+		Check:      "Schema",
+		Finding:    `The connector reads JSON records, but subject "payments-value" has a JSON schema, so the records are likely serialized with Schema Registry.`,
+		Suggestion: `Set "input.data.format" to "JSON_SR" with ` + "`confluent connect cluster update lcc-123456`.",
+	}}, diagnosis.diagnose())
+
+	diagnosis = &connectorDiagnosis{
+		id:          "lcc-123456",
+		connector:   newTestConnectorExpansion("source", "PAUSED", nil, map[string]string{"kafka.topic": "orders", "output.data.format": "JSON_SR"}),
+		schemaTypes: map[string]string{"orders-value": ""},
+	}
+	require.Equal(t, []*doctorFindingOut{{
+		Severity:   doctorSeverityInfo,
+		Check:      "Connector",
+		Finding:    "Connector is PAUSED.",
+		Suggestion: "Run `confluent connect cluster resume lcc-123456` to resume it.",
+	}}, diagnosis.diagnose())
+}
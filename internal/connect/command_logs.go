@@ -57,6 +57,18 @@ func newLogsCommand(prerunner pcmd.PreRunner) *cobra.Command {
 				Text: "Query connector logs with log level ERROR and matching regex \"exa*\" in logs between the provided time window, and store in file:",
 				Code: `confluent connect logs lcc-123456 --level "ERROR" --search-text "exa*" --start-time "2025-02-01T00:00:00Z" --end-time "2025-02-01T23:59:59Z" --output-file errors.json`,
 			},
+			examples.Example{
+				Text: "Query connector logs with log level ERROR from the last 15 minutes:",
+				Code: "confluent connect logs lcc-123456 --since 15m",
+			},
+			examples.Example{
+				Text: "Follow new connector logs with log level ERROR and WARN, starting from the last hour:",
+				Code: `confluent connect logs lcc-123456 --level "ERROR|WARN" --since 1h --follow`,
+			},
+			examples.Example{
+				Text: "Count the connector logs with log level ERROR from the last day by exception:",
+				Code: "confluent connect logs lcc-123456 --since 1d --group-by exception",
+			},
 		),
 		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLogin},
 	}
@@ -65,17 +77,29 @@ func newLogsCommand(prerunner pcmd.PreRunner) *cobra.Command {
 	cmd.RunE = c.queryLogs
 	cmd.Flags().String("start-time", "", "Start time for log query in ISO 8601 (https://en.wikipedia.org/wiki/ISO_8601) UTC datetime format (e.g., 2025-02-01T00:00:00Z).")
 	cmd.Flags().String("end-time", "", "End time for log query in ISO 8601 (https://en.wikipedia.org/wiki/ISO_8601) UTC datetime format (e.g., 2025-02-01T23:59:59Z).")
+	cmd.Flags().String("since", "", `Query logs from a time window ending now, such as "15m", "2h", or "1d", of at most 72 hours.`)
 	cmd.Flags().String("level", "ERROR", "Log level filter (INFO, WARN, ERROR). Defaults to ERROR. Use '|' to specify multiple levels (e.g., ERROR|WARN).")
 	cmd.Flags().String("search-text", "", "Search text within logs.")
 	cmd.Flags().String("output-file", "", "Output file path to append connector logs.")
 	cmd.Flags().Bool("next", false, "Whether to fetch next page of logs after the next execution of the command.")
+	cmd.Flags().Bool("follow", false, "Poll for new logs continuously until interrupted.")
+	cmd.Flags().String("group-by", "", `Count the logs in the time window by "exception", merging repeated stack traces.`)
 
 	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
 	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
 	pcmd.AddOutputFlag(cmd)
 
-	cobra.CheckErr(cmd.MarkFlagRequired("start-time"))
-	cobra.CheckErr(cmd.MarkFlagRequired("end-time"))
+	pcmd.RegisterFlagCompletionFunc(cmd, "group-by", func(_ *cobra.Command, _ []string) []string { return []string{logsGroupByException} })
+
+	cmd.MarkFlagsRequiredTogether("start-time", "end-time")
+	cmd.MarkFlagsOneRequired("start-time", "since", "follow")
+	cmd.MarkFlagsMutuallyExclusive("start-time", "since")
+	cmd.MarkFlagsMutuallyExclusive("end-time", "follow")
+	cmd.MarkFlagsMutuallyExclusive("next", "since")
+	cmd.MarkFlagsMutuallyExclusive("next", "follow")
+	cmd.MarkFlagsMutuallyExclusive("next", "group-by")
+	cmd.MarkFlagsMutuallyExclusive("follow", "group-by")
+	cmd.MarkFlagsMutuallyExclusive("output-file", "group-by")
 
 	return cmd
 }
@@ -122,22 +146,52 @@ func (c *logsCommand) queryLogs(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	since, err := cmd.Flags().GetString("since")
+	if err != nil {
+		return err
+	}
+
+	follow, err := cmd.Flags().GetBool("follow")
+	if err != nil {
+		return err
+	}
+
+	groupBy, err := cmd.Flags().GetString("group-by")
+	if err != nil {
+		return err
+	}
+	if groupBy != "" && groupBy != logsGroupByException {
+		return fmt.Errorf(`invalid value for --group-by: %s: must be "%s"`, groupBy, logsGroupByException)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	if since != "" {
+		window, err := parseLogsSince(since)
+		if err != nil {
+			return err
+		}
+		startTime = formatLogsTime(now.Add(-window))
+		endTime = formatLogsTime(now)
+	} else if follow {
+		startTime = formatLogsTime(now)
+	}
+
 	if err := validateTimeFormat(startTime); err != nil {
 		return fmt.Errorf("invalid start-time format: %w", err)
 	}
 
-	if err := validateTimeFormat(endTime); err != nil {
-		return fmt.Errorf("invalid end-time format: %w", err)
-	}
+	if !follow {
+		if err := validateTimeFormat(endTime); err != nil {
+			return fmt.Errorf("invalid end-time format: %w", err)
+		}
 
-	if endTime < startTime {
-		return fmt.Errorf("end-time must be greater than start-time")
+		if endTime < startTime {
+			return fmt.Errorf("end-time must be greater than start-time")
+		}
 	}
 
 	startTimeParsed, _ := time.Parse(time.RFC3339, startTime)
-	now := time.Now()
-	maxAge := 72 * time.Hour
-	if startTimeParsed.Before(now.Add(-maxAge)) {
+	if startTimeParsed.Before(now.Add(-logsMaxAge)) {
 		return fmt.Errorf("start-time cannot be older than 72 hours")
 	}
 
@@ -178,6 +232,14 @@ func (c *logsCommand) queryLogs(cmd *cobra.Command, args []string) error {
 		connectorName,
 	)
 
+	query := logsQuery{crn: crn, connectorId: connectorId, levels: levels, searchText: searchText}
+	if follow {
+		return c.followLogs(cmd, query, startTimeParsed, outputFile)
+	}
+	if groupBy == logsGroupByException {
+		return c.groupLogsByException(cmd, query, startTime, endTime)
+	}
+
 	logs, err := c.V2Client.SearchConnectorLogs(crn, connectorId, startTime, endTime, levels, searchText, lastQueryPageToken)
 	if err != nil {
 		return fmt.Errorf("failed to query connector logs: %w", err)
@@ -260,16 +322,7 @@ func writeLogsToFile(outputFile string, logs *ccloudv2.LoggingSearchResponse) er
 	defer file.Close()
 
 	for _, log := range logs.Data {
-		logEntry := &logEntryOut{
-			Timestamp: log.Timestamp,
-			Level:     log.Level,
-			TaskId:    log.TaskId,
-			Message:   log.Message,
-		}
-		if log.Exception != nil {
-			logEntry.Exception = log.Exception.Stacktrace
-		}
-		data, err := json.Marshal(logEntry)
+		data, err := json.Marshal(newLogEntryOut(log))
 		if err != nil {
 			return fmt.Errorf("failed to marshal log entry to JSON: %w", err)
 		}
@@ -311,16 +364,7 @@ func extractPageToken(urlStr string) (string, error) {
 func printHumanLogs(cmd *cobra.Command, logs *ccloudv2.LoggingSearchResponse, connectorId string) error {
 	list := output.NewList(cmd)
 	for _, log := range logs.Data {
-		logOut := &logEntryOut{
-			Timestamp: log.Timestamp,
-			Level:     log.Level,
-			TaskId:    log.TaskId,
-			Message:   log.Message,
-		}
-		if log.Exception != nil {
-			logOut.Exception = log.Exception.Stacktrace
-		}
-		list.Add(logOut)
+		list.Add(newLogEntryOut(log))
 	}
 
 	if len(logs.Data) == 0 {
@@ -331,3 +375,16 @@ func printHumanLogs(cmd *cobra.Command, logs *ccloudv2.LoggingSearchResponse, co
 	output.Printf(false, "Found %d log entries for connector %s:\n\n", len(logs.Data), connectorId)
 	return list.Print()
 }
+
+func newLogEntryOut(log ccloudv2.LoggingLogEntry) *logEntryOut {
+	logOut := &logEntryOut{
+		Timestamp: log.Timestamp,
+		Level:     log.Level,
+		TaskId:    log.TaskId,
+		Message:   log.Message,
+	}
+	if log.Exception != nil {
+		logOut.Exception = log.Exception.Stacktrace
+	}
+	return logOut
+}
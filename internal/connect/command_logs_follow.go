@@ -0,0 +1,240 @@
+package connect
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v4/pkg/ccloudv2"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+const (
+	logsMaxAge = 72 * time.Hour
+
+	// Log entries can be indexed some time after they are written, so every poll looks back over this overlap and
+	// skips the entries which were already printed.
+	logsFollowOverlap     = time.Minute
+	logsFollowMinInterval = 5 * time.Second
+	logsFollowMaxInterval = time.Minute
+)
+
+type logsQuery struct {
+	crn         string
+	connectorId string
+	levels      []string
+	searchText  string
+}
+
+// logsFollower tracks the log entries which have been printed while following the logs of a connector.
+type logsFollower struct {
+	start  time.Time
+	cursor time.Time
+	seen   map[string]time.Time
+}
+
+func newLogsFollower(start time.Time) *logsFollower {
+	return &logsFollower{
+		start:  start,
+		cursor: start,
+		seen:   make(map[string]time.Time),
+	}
+}
+
+// queryStart returns the start of the time window of the next poll.
+func (f *logsFollower) queryStart() time.Time {
+	start := f.cursor.Add(-logsFollowOverlap)
+	if start.Before(f.start) {
+		return f.start
+	}
+	return start
+}
+
+// add returns the entries which have not been returned before, from oldest to newest, and moves the cursor to the
+// newest entry.
+func (f *logsFollower) add(entries []ccloudv2.LoggingLogEntry) []ccloudv2.LoggingLogEntry {
+	entries = slices.Clone(entries)
+	slices.SortStableFunc(entries, func(a, b ccloudv2.LoggingLogEntry) int {
+		return parseLogTimestamp(a.Timestamp).Compare(parseLogTimestamp(b.Timestamp))
+	})
+
+	var added []ccloudv2.LoggingLogEntry
+	for _, entry := range entries {
+		timestamp := parseLogTimestamp(entry.Timestamp)
+		if !timestamp.IsZero() && timestamp.Before(f.start) {
+			continue
+		}
+
+		key := strings.Join([]string{entry.Timestamp, entry.TaskId, entry.Level, entry.Message}, "\x00")
+		if _, ok := f.seen[key]; ok {
+			continue
+		}
+		f.seen[key] = timestamp
+		added = append(added, entry)
+
+		if timestamp.After(f.cursor) {
+			f.cursor = timestamp
+		}
+	}
+
+	// Entries before the next time window cannot be returned again
+	queryStart := f.queryStart()
+	for key, timestamp := range f.seen {
+		if timestamp.Before(queryStart) {
+			delete(f.seen, key)
+		}
+	}
+
+	return added
+}
+
+// followLogs polls for new log entries until interrupted. The interval between polls doubles while there are no new
+// entries, up to a minute, and is reset once there are.
+func (c *logsCommand) followLogs(cmd *cobra.Command, query logsQuery, start time.Time, outputFile string) error {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	defer signal.Stop(signals)
+
+	follower := newLogsFollower(start)
+	interval := logsFollowMinInterval
+	for {
+		entries, err := c.searchAllConnectorLogs(query, formatLogsTime(follower.queryStart()), formatLogsTime(time.Now().UTC()), 0)
+		if err != nil {
+			output.ErrPrintf(c.Config.EnableColor, "[WARN] Failed to query connector logs: %v\n", err)
+			interval = min(2*interval, logsFollowMaxInterval)
+		} else if added := follower.add(entries); len(added) > 0 {
+			if err := printFollowedLogs(cmd, added, outputFile); err != nil {
+				return err
+			}
+			interval = logsFollowMinInterval
+		} else {
+			interval = min(2*interval, logsFollowMaxInterval)
+		}
+
+		select {
+		case <-signals:
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// searchAllConnectorLogs returns the log entries in a time window from every page of results, or from at most
+// maxPages pages if it is positive.
+func (c *logsCommand) searchAllConnectorLogs(query logsQuery, startTime, endTime string, maxPages int) ([]ccloudv2.LoggingLogEntry, error) {
+	var entries []ccloudv2.LoggingLogEntry
+	pageToken := ""
+	for page := 1; maxPages <= 0 || page <= maxPages; page++ {
+		logs, err := c.V2Client.SearchConnectorLogs(query.crn, query.connectorId, startTime, endTime, query.levels, query.searchText, pageToken)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, logs.Data...)
+
+		if logs.Metadata == nil || logs.Metadata.Next == "" {
+			return entries, nil
+		}
+		pageToken, err = extractPageToken(logs.Metadata.Next)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract page token: %w", err)
+		}
+		if pageToken == "" {
+			return entries, nil
+		}
+	}
+
+	output.ErrPrintf(false, "[WARN] Only the first %d log entries were fetched; narrow the time window or filters to include the rest.\n", len(entries))
+	return entries, nil
+}
+
+func printFollowedLogs(cmd *cobra.Command, entries []ccloudv2.LoggingLogEntry, outputFile string) error {
+	if outputFile != "" {
+		return writeLogsToFile(outputFile, &ccloudv2.LoggingSearchResponse{Data: entries})
+	}
+
+	for _, entry := range entries {
+		if !output.GetFormat(cmd).IsSerialized() {
+			output.Println(false, formatLogEntry(entry))
+			continue
+		}
+
+		// Entries are serialized with the same fields as without --follow
+		table := output.NewTable(cmd)
+		table.Add(newLogEntryOut(entry))
+		out, err := table.PrintString()
+		if err != nil {
+			return err
+		}
+
+		if output.GetFormat(cmd) == output.YAML {
+			output.Println(false, "---")
+			output.Print(false, out)
+			continue
+		}
+
+		// Each entry is printed on a single line, so that the stream of entries can be read as JSON Lines
+		line := &bytes.Buffer{}
+		if err := json.Compact(line, []byte(out)); err != nil {
+			return err
+		}
+		output.Println(false, line.String())
+	}
+	return nil
+}
+
+// formatLogEntry formats a log entry as a single line, followed by its stack trace, if any.
+func formatLogEntry(entry ccloudv2.LoggingLogEntry) string {
+	line := fmt.Sprintf("%s %-5s ", entry.Timestamp, entry.Level)
+	if entry.TaskId != "" {
+		line += fmt.Sprintf("[%s] ", entry.TaskId)
+	}
+	line += entry.Message
+
+	if entry.Exception != nil && entry.Exception.Stacktrace != "" {
+		line += "\n    " + strings.ReplaceAll(strings.TrimRight(entry.Exception.Stacktrace, "\n"), "\n", "\n    ")
+	}
+	return line
+}
+
+// parseLogsSince parses a relative time window, such as "15m", "2h", or "1d".
+func parseLogsSince(since string) (time.Duration, error) {
+	var window time.Duration
+	if days, ok := strings.CutSuffix(since, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf(`invalid value for --since: %s: must be a duration such as "15m", "2h", or "1d"`, since)
+		}
+		window = time.Duration(n) * 24 * time.Hour
+	} else {
+		var err error
+		window, err = time.ParseDuration(since)
+		if err != nil {
+			return 0, fmt.Errorf(`invalid value for --since: %s: must be a duration such as "15m", "2h", or "1d"`, since)
+		}
+	}
+
+	if window < time.Minute {
+		return 0, fmt.Errorf("invalid value for --since: %s: must be at least 1 minute", since)
+	}
+	if window > logsMaxAge {
+		return 0, fmt.Errorf("invalid value for --since: %s: must be at most 72 hours", since)
+	}
+	return window, nil
+}
+
+func formatLogsTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05Z")
+}
+
+func parseLogTimestamp(timestamp string) time.Time {
+	t, _ := time.Parse(time.RFC3339Nano, timestamp)
+	return t
+}
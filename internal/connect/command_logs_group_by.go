@@ -0,0 +1,115 @@
+package connect
+
+import (
+	"cmp"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v4/pkg/ccloudv2"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+const (
+	logsGroupByException = "exception"
+	logsGroupByMaxPages  = 50
+)
+
+var exceptionSignatureNumberRegex = regexp.MustCompile(`\d+`)
+
+type logExceptionGroupOut struct {
+	Count     int      `human:"Count" serialized:"count"`
+	Exception string   `human:"Exception" serialized:"exception"`
+	Tasks     []string `human:"Tasks" serialized:"tasks"`
+	FirstSeen string   `human:"First Seen" serialized:"first_seen"`
+	LastSeen  string   `human:"Last Seen" serialized:"last_seen"`
+}
+
+func (c *logsCommand) groupLogsByException(cmd *cobra.Command, query logsQuery, startTime, endTime string) error {
+	entries, err := c.searchAllConnectorLogs(query, startTime, endTime, logsGroupByMaxPages)
+	if err != nil {
+		return err
+	}
+
+	groups := groupLogsByException(entries)
+	if len(groups) == 0 && !output.GetFormat(cmd).IsSerialized() {
+		output.Println(false, "No exceptions found for the current query")
+		return nil
+	}
+
+	list := output.NewList(cmd)
+	list.Sort(false)
+	for _, group := range groups {
+		list.Add(group)
+	}
+	return list.Print()
+}
+
+// groupLogsByException counts the log entries with an exception by the signature of their stack trace, from the most
+// to the least frequent. Log entries without an exception are not counted.
+func groupLogsByException(entries []ccloudv2.LoggingLogEntry) []*logExceptionGroupOut {
+	groupsBySignature := make(map[string]*logExceptionGroupOut)
+	var groups []*logExceptionGroupOut
+	for _, entry := range entries {
+		if entry.Exception == nil || strings.TrimSpace(entry.Exception.Stacktrace) == "" {
+			continue
+		}
+
+		signature := getExceptionSignature(entry.Exception.Stacktrace)
+		group, ok := groupsBySignature[signature]
+		if !ok {
+			group = &logExceptionGroupOut{
+				Exception: getExceptionSummary(entry.Exception.Stacktrace),
+				FirstSeen: entry.Timestamp,
+				LastSeen:  entry.Timestamp,
+			}
+			groupsBySignature[signature] = group
+			groups = append(groups, group)
+		}
+
+		group.Count++
+		if entry.TaskId != "" && !slices.Contains(group.Tasks, entry.TaskId) {
+			group.Tasks = append(group.Tasks, entry.TaskId)
+		}
+		if timestamp := parseLogTimestamp(entry.Timestamp); timestamp.Before(parseLogTimestamp(group.FirstSeen)) {
+			group.FirstSeen = entry.Timestamp
+		} else if timestamp.After(parseLogTimestamp(group.LastSeen)) {
+			group.LastSeen = entry.Timestamp
+		}
+	}
+
+	for _, group := range groups {
+		slices.Sort(group.Tasks)
+	}
+	slices.SortStableFunc(groups, func(a, b *logExceptionGroupOut) int {
+		if a.Count != b.Count {
+			return cmp.Compare(b.Count, a.Count)
+		}
+		return parseLogTimestamp(b.LastSeen).Compare(parseLogTimestamp(a.LastSeen))
+	})
+
+	return groups
+}
+
+// getExceptionSignature returns the class and message of an exception, with numbers such as offsets and IDs removed,
+// and the frame it was thrown from, so that repeated failures have the same signature.
+func getExceptionSignature(stacktrace string) string {
+	lines := strings.Split(strings.TrimSpace(stacktrace), "\n")
+
+	signature := exceptionSignatureNumberRegex.ReplaceAllString(strings.TrimSpace(lines[0]), "N")
+	for _, line := range lines[1:] {
+		if line = strings.TrimSpace(line); strings.HasPrefix(line, "at ") {
+			signature += "\n" + line
+			break
+		}
+	}
+	return signature
+}
+
+// getExceptionSummary returns the first line of a stack trace, which holds the class and message of the exception.
+func getExceptionSummary(stacktrace string) string {
+	summary, _, _ := strings.Cut(strings.TrimSpace(stacktrace), "\n")
+	return strings.TrimSpace(summary)
+}
@@ -0,0 +1,99 @@
+package connect
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/cli/v4/pkg/ccloudv2"
+)
+
+func TestParseLogsSince(t *testing.T) {
+	window, err := parseLogsSince("15m")
+	require.NoError(t, err)
+	require.Equal(t, 15*time.Minute, window)
+
+	window, err = parseLogsSince("3d")
+	require.NoError(t, err)
+	require.Equal(t, 72*time.Hour, window)
+
+	for _, invalid := range []string{"", "30s", "4d", "-1h", "yesterday"} {
+		_, err := parseLogsSince(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestLogsFollower(t *testing.T) {
+	start := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	entry := func(timestamp, message string) ccloudv2.LoggingLogEntry {
+		return ccloudv2.LoggingLogEntry{Timestamp: timestamp, Level: "ERROR", TaskId: "0", Message: message}
+	}
+
+	follower := newLogsFollower(start)
+	require.Equal(t, start, follower.queryStart())
+
+	// Entries are returned from newest to oldest
+	added := follower.add([]ccloudv2.LoggingLogEntry{
+		entry("2025-02-01T00:05:00Z", "second"),
+		entry("2025-02-01T00:04:00Z", "first"),
+		entry("2025-01-31T23:59:00Z", "before start"),
+	})
+	require.Equal(t, []ccloudv2.LoggingLogEntry{entry("2025-02-01T00:04:00Z", "first"), entry("2025-02-01T00:05:00Z", "second")}, added)
+	require.Equal(t, start.Add(4*time.Minute), follower.queryStart())
+
+	added = follower.add([]ccloudv2.LoggingLogEntry{
+		entry("2025-02-01T00:05:00Z", "third"),
+		entry("2025-02-01T00:05:00Z", "second"),
+		entry("2025-02-01T00:04:00Z", "first"),
+	})
+	require.Equal(t, []ccloudv2.LoggingLogEntry{entry("2025-02-01T00:05:00Z", "third")}, added)
+
+	require.Empty(t, follower.add(nil))
+}
+
+func TestGroupLogsByException(t *testing.T) {
+	entry := func(timestamp, taskId, stacktrace string) ccloudv2.LoggingLogEntry {
+		return ccloudv2.LoggingLogEntry{Timestamp: timestamp, Level: "ERROR", TaskId: taskId, Exception: &ccloudv2.LoggingException{Stacktrace: stacktrace}}
+	}
+
+	timeout := "org.apache.kafka.common.errors.TimeoutException: Expiring 12 record(s)\n\tat org.apache.kafka.clients.producer.internals.Sender.send(Sender.java:100)\n\tat Worker.run(Worker.java:1)"
+	timeoutOther := "org.apache.kafka.common.errors.TimeoutException: Expiring 3 record(s)\n\tat org.apache.kafka.clients.producer.internals.Sender.send(Sender.java:100)\n\tat Worker.run(Worker.java:2)"
+	auth := "org.apache.kafka.common.errors.SaslAuthenticationException: Authentication failed\n\tat org.apache.kafka.common.network.Authenticator.authenticate(Authenticator.java:50)"
+
+	groups := groupLogsByException([]ccloudv2.LoggingLogEntry{
+		entry("2025-02-01T00:03:00Z", "1", timeout),
+		entry("2025-02-01T00:02:00Z", "0", auth),
+		entry("2025-02-01T00:01:00Z", "0", timeoutOther),
+		{Timestamp: "2025-02-01T00:00:30Z", Level: "ERROR", Message: "no exception"},
+		entry("2025-02-01T00:00:00Z", "1", timeout),
+	})
+
+	require.Equal(t, []*logExceptionGroupOut{
+		{
+			Count:     3,
+			Exception: "org.apache.kafka.common.errors.TimeoutException: Expiring 12 record(s)",
+			Tasks:     []string{"0", "1"},
+			FirstSeen: "2025-02-01T00:00:00Z",
+			LastSeen:  "2025-02-01T00:03:00Z",
+		},
+		{
+			Count:     1,
+			Exception: "org.apache.kafka.common.errors.SaslAuthenticationException: Authentication failed",
+			Tasks:     []string{"0"},
+			FirstSeen: "2025-02-01T00:02:00Z",
+			LastSeen:  "2025-02-01T00:02:00Z",
+		},
+	}, groups)
+}
+
+func TestFormatLogEntry(t *testing.T) {
+	entry := ccloudv2.LoggingLogEntry{
+		Timestamp: "2025-02-01T00:00:00Z",
+		Level:     "WARN",
+		TaskId:    "lcc-123456-0",
+		Message:   "Retrying",
+		Exception: &ccloudv2.LoggingException{Stacktrace: "java.io.IOException: Broken pipe\n\tat Foo.bar(Foo.java:1)\n"},
+	}
+	require.Equal(t, "2025-02-01T00:00:00Z WARN  [lcc-123456-0] Retrying\n    java.io.IOException: Broken pipe\n    \tat Foo.bar(Foo.java:1)", formatLogEntry(entry))
+}
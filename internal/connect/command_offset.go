@@ -27,6 +27,8 @@ func newOffsetCommand(prerunner pcmd.PreRunner) *cobra.Command {
 
 	cmd.AddCommand(c.newDeleteCommand())
 	cmd.AddCommand(c.newDescribeCommand())
+	cmd.AddCommand(c.newExportCommand())
+	cmd.AddCommand(c.newImportCommand())
 	cmd.AddCommand(c.newStatusCommand())
 	cmd.AddCommand(c.newUpdateCommand())
 
@@ -1,7 +1,6 @@
 package connect
 
 import (
-	"fmt"
 	"strings"
 	"time"
 
@@ -13,7 +12,6 @@ import (
 	"github.com/confluentinc/cli/v4/pkg/examples"
 	"github.com/confluentinc/cli/v4/pkg/kafka"
 	"github.com/confluentinc/cli/v4/pkg/output"
-	"github.com/confluentinc/cli/v4/pkg/retry"
 )
 
 func (c *offsetCommand) newDeleteCommand() *cobra.Command {
@@ -69,27 +67,9 @@ func (c *offsetCommand) delete(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	offsetStatus := connectv1.ConnectV1AlterOffsetStatus{
-		Request: alterOffsetRequestInfo,
-		Status: connectv1.ConnectV1AlterOffsetStatusStatus{
-			Phase: "PENDING",
-		},
-	}
-
-	var apiErr error
-	_ = retry.Retry(time.Second, 30*time.Second, func() error {
-		offsetStatus, apiErr = c.V2Client.AlterConnectorOffsetsRequestStatus(connectorName, environmentId, kafkaCluster.ID)
-		if apiErr != nil {
-			return nil
-		}
-
-		if strings.ToUpper(offsetStatus.Status.GetPhase()) != "PENDING" {
-			return nil
-		}
-		return fmt.Errorf("delete offset request still pending, checking status again")
-	})
-	if apiErr != nil {
-		return apiErr
+	offsetStatus, err := c.waitForOffsetRequest(connectorName, environmentId, kafkaCluster.ID, alterOffsetRequestInfo, 30*time.Second)
+	if err != nil {
+		return err
 	}
 
 	if strings.ToUpper(offsetStatus.Status.GetPhase()) == "PENDING" {
@@ -95,10 +95,25 @@ func (c *offsetCommand) describe(cmd *cobra.Command, args []string) error {
 	}
 
 	connectorName := connector.Info.GetName()
+	offsets, err := c.getConnectorOffsets(connectorName, environmentId, kafkaCluster.ID, stalenessThreshold, timeout)
+	if err != nil {
+		return err
+	}
+
+	if output.GetFormat(cmd) == output.Human {
+		return printHumanDescribeOffset(cmd, offsets, args[0], connectorName)
+	}
+
+	return printSerializedDescribeOffsets(cmd, offsets, args[0], connectorName)
+}
+
+// getConnectorOffsets repeatedly fetches the offsets of a connector until they were observed within the staleness
+// threshold, or until the timeout, in seconds.
+func (c *offsetCommand) getConnectorOffsets(connectorName, environmentId, kafkaClusterId string, stalenessThreshold, timeout uint) (connectv1.ConnectV1ConnectorOffsets, error) {
 	var apiErr error
 	var offsets connectv1.ConnectV1ConnectorOffsets
 	_ = retry.Retry(time.Second, time.Duration(timeout)*time.Second, func() error {
-		offsets, apiErr = c.V2Client.GetConnectorOffset(connector.Info.GetName(), environmentId, kafkaCluster.ID)
+		offsets, apiErr = c.V2Client.GetConnectorOffset(connectorName, environmentId, kafkaClusterId)
 		if apiErr != nil {
 			return apiErr
 		}
@@ -109,15 +124,8 @@ func (c *offsetCommand) describe(cmd *cobra.Command, args []string) error {
 
 		return fmt.Errorf("got stale offsets, fetching again")
 	})
-	if apiErr != nil {
-		return apiErr
-	}
-
-	if output.GetFormat(cmd) == output.Human {
-		return printHumanDescribeOffset(cmd, offsets, args[0], connectorName)
-	}
 
-	return printSerializedDescribeOffsets(cmd, offsets, args[0], connectorName)
+	return offsets, apiErr
 }
 
 func printHumanDescribeOffset(cmd *cobra.Command, offsets connectv1.ConnectV1ConnectorOffsets, id, name string) error {
@@ -0,0 +1,111 @@
+package connect
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/kafka"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+func (c *offsetCommand) newExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "export <id>",
+		Short:             "Export connector offsets to a file.",
+		Long:              "Export the offsets of a connector to a JSON file, which can be imported into another connector with `confluent connect offset import`.",
+		Args:              cobra.ExactArgs(1),
+		RunE:              c.export,
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validArgs),
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Export offsets for connector "lcc-123456" to "offsets.json".`,
+				Code: "confluent connect offset export lcc-123456 --file offsets.json",
+			},
+		),
+	}
+
+	cmd.Flags().String("file", "", "Path to write the offsets to. Defaults to printing JSON to standard output.")
+	cmd.Flags().Uint("staleness-threshold", 120, "Repeatedly fetch offsets, until receiving an offset with an observed time within the staleness threshold in seconds, for a minimum of 5 seconds.")
+	cmd.Flags().Uint("timeout", 30, "Max time in seconds to wait until we get an offset within the staleness threshold.")
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "json"))
+
+	return cmd
+}
+
+func (c *offsetCommand) export(cmd *cobra.Command, args []string) error {
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	stalenessThreshold, err := cmd.Flags().GetUint("staleness-threshold")
+	if err != nil {
+		return err
+	}
+
+	if stalenessThreshold < 5 {
+		return fmt.Errorf("`--staleness-threshold` cannot be less than 5 seconds")
+	}
+
+	timeout, err := cmd.Flags().GetUint("timeout")
+	if err != nil {
+		return err
+	}
+
+	kafkaCluster, err := kafka.GetClusterForCommand(c.V2Client, c.Context)
+	if err != nil {
+		return err
+	}
+
+	environmentId, err := c.Context.EnvironmentId()
+	if err != nil {
+		return err
+	}
+
+	connector, err := c.V2Client.GetConnectorExpansionById(args[0], environmentId, kafkaCluster.ID)
+	if err != nil {
+		return err
+	}
+
+	connectorName := connector.Info.GetName()
+	offsets, err := c.getConnectorOffsets(connectorName, environmentId, kafkaCluster.ID, stalenessThreshold, timeout)
+	if err != nil {
+		return err
+	}
+
+	// The exported file has the same format as the serialized output of `confluent connect offset describe`
+	out := &serializedOffsetConnectorOut{
+		Id:      args[0],
+		Name:    connectorName,
+		Offsets: offsets.GetOffsets(),
+	}
+	if offsets.HasMetadata() {
+		out.Metadata = offsetMetadataOut{ObservedAt: offsets.Metadata.GetObservedAt()}
+	}
+
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if file == "" {
+		output.Println(false, string(data))
+		return nil
+	}
+
+	if err := os.WriteFile(file, append(data, '\n'), 0644); err != nil {
+		return err
+	}
+
+	output.Printf(c.Config.EnableColor, "Exported %d offset(s) for connector \"%s\" to \"%s\".\n", len(out.Offsets), args[0], file)
+	return nil
+}
@@ -0,0 +1,342 @@
+package connect
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/kafka"
+	"github.com/confluentinc/cli/v4/pkg/output"
+	"github.com/confluentinc/cli/v4/pkg/retry"
+)
+
+type offsetImportOut struct {
+	Partition string `human:"Partition" serialized:"partition"`
+	Offset    string `human:"Offset" serialized:"offset"`
+}
+
+// offsetPartitionMapping rewrites the partitions of imported offsets, for connectors whose partitions have a different
+// shape than those of the connector the offsets were exported from.
+type offsetPartitionMapping struct {
+	// Keys renames partition keys. A key renamed to "" is removed.
+	Keys map[string]string `json:"keys"`
+	// Values replaces the string values of partition keys, by the original name of the key.
+	Values map[string]map[string]string `json:"values"`
+}
+
+func (c *offsetCommand) newImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <id>",
+		Short: "Import connector offsets from a file.",
+		Long: "Import offsets exported with `confluent connect offset export` into a connector, such as one which replaces the connector they were exported from.\n\n" +
+			"The connector is paused while the offsets are applied, and resumed once they have been, unless it was already paused. " +
+			"If the partitions of the connector have a different shape, pass a mapping file to rename partition keys and replace their values.",
+		Args:              cobra.ExactArgs(1),
+		RunE:              c.importOffsets,
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validArgs),
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Import offsets from "offsets.json" into connector "lcc-654321".`,
+				Code: "confluent connect offset import lcc-654321 --from offsets.json",
+			},
+			examples.Example{
+				Text: `The partition mapping file renames the partition key "server" to "topic.prefix", and replaces topic "orders" with "orders_v2".`,
+				Code: `{
+  "keys": {
+    "server": "topic.prefix"
+  },
+  "values": {
+    "kafka_topic": {
+      "orders": "orders_v2"
+    }
+  }
+}`,
+			},
+			examples.Example{
+				Text: "Preview the offsets which would be imported, with their partitions rewritten by a mapping file.",
+				Code: "confluent connect offset import lcc-654321 --from offsets.json --partition-mapping mapping.json --dry-run",
+			},
+		),
+	}
+
+	cmd.Flags().String("from", "", `JSON file containing connector offsets, as written by "confluent connect offset export".`)
+	cmd.Flags().String("partition-mapping", "", "JSON file mapping the partition keys and values of the imported offsets to those of the connector.")
+	cmd.Flags().Uint("timeout", 30, "Max time in seconds to wait until the connector is paused, and again until the offsets are applied.")
+	pcmd.AddDryRunFlag(cmd)
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagFilename("from", "json"))
+	cobra.CheckErr(cmd.MarkFlagFilename("partition-mapping", "json"))
+	cobra.CheckErr(cmd.MarkFlagRequired("from"))
+
+	return cmd
+}
+
+func (c *offsetCommand) importOffsets(cmd *cobra.Command, args []string) error {
+	from, err := cmd.Flags().GetString("from")
+	if err != nil {
+		return err
+	}
+
+	offsets, err := readOffsetsFile(from)
+	if err != nil {
+		return err
+	}
+
+	partitionMapping, err := cmd.Flags().GetString("partition-mapping")
+	if err != nil {
+		return err
+	}
+
+	if partitionMapping != "" {
+		mapping, err := readOffsetPartitionMapping(partitionMapping)
+		if err != nil {
+			return err
+		}
+		offsets, err = mapping.apply(offsets)
+		if err != nil {
+			return err
+		}
+	}
+
+	timeout, err := cmd.Flags().GetUint("timeout")
+	if err != nil {
+		return err
+	}
+
+	kafkaCluster, err := kafka.GetClusterForCommand(c.V2Client, c.Context)
+	if err != nil {
+		return err
+	}
+
+	environmentId, err := c.Context.EnvironmentId()
+	if err != nil {
+		return err
+	}
+
+	connector, err := c.V2Client.GetConnectorExpansionById(args[0], environmentId, kafkaCluster.ID)
+	if err != nil {
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		list := output.NewList(cmd)
+		list.Sort(false)
+		for _, offset := range offsets {
+			out, err := newOffsetImportOut(offset)
+			if err != nil {
+				return err
+			}
+			list.Add(out)
+		}
+		return list.Print()
+	}
+
+	connectorName := connector.Info.GetName()
+	paused := connector.Status.Connector.GetState() == connectorStatePaused
+	if !paused {
+		if err := c.V2Client.PauseConnector(connectorName, environmentId, kafkaCluster.ID); err != nil {
+			return err
+		}
+		output.ErrPrintf(c.Config.EnableColor, "Paused connector \"%s\".\n", args[0])
+
+		// Offsets can only be altered once the connector has stopped, which happens asynchronously
+		if err := c.waitForConnectorPaused(args[0], environmentId, kafkaCluster.ID, time.Duration(timeout)*time.Second); err != nil {
+			return importOffsetsError(err, args[0], paused)
+		}
+	}
+
+	request := connectv1.ConnectV1AlterOffsetRequest{Type: connectv1.ConnectV1AlterOffsetRequestType("PATCH")}
+	request.SetOffsets(offsets)
+
+	alterOffsetRequestInfo, err := c.V2Client.AlterConnectorOffsets(connectorName, environmentId, kafkaCluster.ID, request)
+	if err != nil {
+		return importOffsetsError(err, args[0], paused)
+	}
+
+	offsetStatus, err := c.waitForOffsetRequest(connectorName, environmentId, kafkaCluster.ID, alterOffsetRequestInfo, time.Duration(timeout)*time.Second)
+	if err != nil {
+		return importOffsetsError(err, args[0], paused)
+	}
+
+	phase := strings.ToUpper(offsetStatus.Status.GetPhase())
+	if phase == "PENDING" {
+		output.Printf(c.Config.EnableColor, "Operation is PENDING. Please run `confluent connect offset status describe` to get the latest status of the import request, then `confluent connect cluster resume %s` once it is applied.\n", args[0])
+		return nil
+	}
+
+	if phase != "APPLIED" {
+		var message string
+		if offsetStatus.Status.Message != nil {
+			message = *offsetStatus.Status.Message
+		}
+		return importOffsetsError(fmt.Errorf("offset import request %s: %s", strings.ToLower(phase), message), args[0], paused)
+	}
+
+	if !paused {
+		if err := c.V2Client.ResumeConnector(connectorName, environmentId, kafkaCluster.ID); err != nil {
+			return err
+		}
+		output.ErrPrintf(c.Config.EnableColor, "Resumed connector \"%s\".\n", args[0])
+	}
+
+	if output.GetFormat(cmd) == output.Human {
+		return printHumanDescribeOffsetStatus(cmd, offsetStatus, args[0])
+	}
+
+	return printSerializedDescribeOffsetStatus(cmd, offsetStatus, args[0])
+}
+
+func (c *offsetCommand) waitForConnectorPaused(connectorId, environmentId, kafkaClusterId string, timeout time.Duration) error {
+	var apiErr error
+	err := retry.Retry(time.Second, timeout, func() error {
+		var connector *connectv1.ConnectV1ConnectorExpansion
+		connector, apiErr = c.V2Client.GetConnectorExpansionById(connectorId, environmentId, kafkaClusterId)
+		if apiErr != nil {
+			return nil
+		}
+
+		if state := connector.Status.Connector.GetState(); state != connectorStatePaused {
+			return fmt.Errorf("connector is %s, checking state again", state)
+		}
+		return nil
+	})
+	if apiErr != nil {
+		return apiErr
+	}
+	if err != nil {
+		return fmt.Errorf("connector was not paused after %v", timeout)
+	}
+	return nil
+}
+
+func importOffsetsError(err error, id string, paused bool) error {
+	if paused {
+		return err
+	}
+	return errors.NewErrorWithSuggestions(
+		fmt.Sprintf("failed to import offsets into connector \"%s\": %v", id, err),
+		fmt.Sprintf("The connector remains paused. Run `confluent connect offset status describe %[1]s` to check the offsets, then `confluent connect cluster resume %[1]s` to resume it.", id),
+	)
+}
+
+func newOffsetImportOut(offset map[string]any) (*offsetImportOut, error) {
+	partition, err := json.Marshal(offset["partition"])
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := json.Marshal(offset["offset"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &offsetImportOut{
+		Partition: string(partition),
+		Offset:    string(value),
+	}, nil
+}
+
+// readOffsetsFile reads the offsets from a file written by `confluent connect offset export`, or from a configuration
+// file of `confluent connect offset update`.
+func readOffsetsFile(path string) ([]map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf(errors.UnableToReadConfigurationFileErrorMsg, path, err)
+	}
+
+	var file struct {
+		Offsets []map[string]any `json:"offsets"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf(errors.UnableToReadConfigurationFileErrorMsg, path, err)
+	}
+
+	if len(file.Offsets) == 0 {
+		return nil, fmt.Errorf(`offset file "%s" does not contain any offsets`, path)
+	}
+
+	for i, offset := range file.Offsets {
+		if _, ok := offset["partition"].(map[string]any); !ok {
+			return nil, fmt.Errorf(`offset %d in "%s" does not have a partition`, i+1, path)
+		}
+		if _, ok := offset["offset"]; !ok {
+			return nil, fmt.Errorf(`offset %d in "%s" does not have an offset`, i+1, path)
+		}
+	}
+
+	return file.Offsets, nil
+}
+
+func readOffsetPartitionMapping(path string) (*offsetPartitionMapping, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf(errors.UnableToReadConfigurationFileErrorMsg, path, err)
+	}
+
+	mapping := new(offsetPartitionMapping)
+	if err := json.Unmarshal(data, mapping); err != nil {
+		return nil, fmt.Errorf(errors.UnableToReadConfigurationFileErrorMsg, path, err)
+	}
+
+	return mapping, nil
+}
+
+// apply returns a copy of the offsets with their partitions rewritten. Two offsets cannot have the same partition once
+// rewritten, since only one of them could be applied.
+func (m *offsetPartitionMapping) apply(offsets []map[string]any) ([]map[string]any, error) {
+	mapped := make([]map[string]any, len(offsets))
+	partitions := make(map[string]bool)
+	for i, offset := range offsets {
+		partition, _ := offset["partition"].(map[string]any)
+
+		mappedPartition := make(map[string]any)
+		for key, value := range partition {
+			if str, ok := value.(string); ok {
+				if newValue, ok := m.Values[key][str]; ok {
+					value = newValue
+				}
+			}
+			if newKey, ok := m.Keys[key]; ok {
+				if newKey == "" {
+					continue
+				}
+				key = newKey
+			}
+			if _, ok := mappedPartition[key]; ok {
+				return nil, fmt.Errorf(`partition key "%s" is mapped to more than once`, key)
+			}
+			mappedPartition[key] = value
+		}
+
+		// Maps are marshaled with sorted keys, so equal partitions have the same encoding
+		encoded, err := json.Marshal(mappedPartition)
+		if err != nil {
+			return nil, err
+		}
+		if partitions[string(encoded)] {
+			return nil, fmt.Errorf("more than one offset is mapped to partition %s", encoded)
+		}
+		partitions[string(encoded)] = true
+
+		mapped[i] = map[string]any{"partition": mappedPartition, "offset": offset["offset"]}
+	}
+
+	return mapped, nil
+}
@@ -0,0 +1,57 @@
+package connect
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOffsetsFile(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "offsets.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"id": "lcc-123456", "name": "orders-sink", "offsets": [{"partition": {"kafka_topic": "orders", "kafka_partition": 0}, "offset": {"kafka_offset": 1000}}]}`), 0644))
+	offsets, err := readOffsetsFile(path)
+	require.NoError(t, err)
+	require.Equal(t, []map[string]any{{
+		"partition": map[string]any{"kafka_topic": "orders", "kafka_partition": float64(0)},
+		"offset":    map[string]any{"kafka_offset": float64(1000)},
+	}}, offsets)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"offsets": []}`), 0644))
+	_, err = readOffsetsFile(path)
+	require.EqualError(t, err, `offset file "`+path+`" does not contain any offsets`)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"offsets": [{"offset": {"kafka_offset": 1000}}]}`), 0644))
+	_, err = readOffsetsFile(path)
+	require.EqualError(t, err, `offset 1 in "`+path+`" does not have a partition`)
+}
+
+func TestOffsetPartitionMappingApply(t *testing.T) {
+	offsets := []map[string]any{
+		{"partition": map[string]any{"server": "dbzv2", "table": "orders"}, "offset": map[string]any{"pos": 2001}},
+		{"partition": map[string]any{"server": "dbzv2", "table": "payments"}, "offset": map[string]any{"pos": 42}},
+	}
+
+	mapping := &offsetPartitionMapping{
+		Keys:   map[string]string{"server": "topic.prefix"},
+		Values: map[string]map[string]string{"table": {"orders": "orders_v2"}},
+	}
+	mapped, err := mapping.apply(offsets)
+	require.NoError(t, err)
+	require.Equal(t, []map[string]any{
+		{"partition": map[string]any{"topic.prefix": "dbzv2", "table": "orders_v2"}, "offset": map[string]any{"pos": 2001}},
+		{"partition": map[string]any{"topic.prefix": "dbzv2", "table": "payments"}, "offset": map[string]any{"pos": 42}},
+	}, mapped)
+	require.Equal(t, map[string]any{"server": "dbzv2", "table": "orders"}, offsets[0]["partition"])
+
+	mapping = &offsetPartitionMapping{Keys: map[string]string{"table": ""}}
+	_, err = mapping.apply(offsets)
+	require.EqualError(t, err, `more than one offset is mapped to partition {"server":"dbzv2"}`)
+
+	mapping = &offsetPartitionMapping{Keys: map[string]string{"table": "server"}}
+	_, err = mapping.apply(offsets)
+	require.EqualError(t, err, `partition key "server" is mapped to more than once`)
+}
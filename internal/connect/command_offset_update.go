@@ -97,16 +97,35 @@ func (c *offsetCommand) update(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	offsetStatus, err := c.waitForOffsetRequest(connectorName, environmentId, kafkaCluster.ID, alterOffsetRequestInfo, 30*time.Second)
+	if err != nil {
+		return err
+	}
+
+	if strings.ToUpper(offsetStatus.Status.GetPhase()) == "PENDING" {
+		output.Println(c.Config.EnableColor, "Operation is PENDING. Please run `confluent connect offset status describe` to get the latest status of the update request.")
+		return nil
+	}
+
+	if output.GetFormat(cmd) == output.Human {
+		return printHumanDescribeOffsetStatus(cmd, offsetStatus, args[0])
+	}
+
+	return printSerializedDescribeOffsetStatus(cmd, offsetStatus, args[0])
+}
+
+// waitForOffsetRequest polls the status of an offset request until it is no longer pending, or until the timeout.
+func (c *offsetCommand) waitForOffsetRequest(connectorName, environmentId, kafkaClusterId string, request connectv1.ConnectV1AlterOffsetRequestInfo, timeout time.Duration) (connectv1.ConnectV1AlterOffsetStatus, error) {
 	offsetStatus := connectv1.ConnectV1AlterOffsetStatus{
-		Request: alterOffsetRequestInfo,
+		Request: request,
 		Status: connectv1.ConnectV1AlterOffsetStatusStatus{
 			Phase: "PENDING",
 		},
 	}
 
 	var apiErr error
-	_ = retry.Retry(time.Second, 30*time.Second, func() error {
-		offsetStatus, apiErr = c.V2Client.AlterConnectorOffsetsRequestStatus(connectorName, environmentId, kafkaCluster.ID)
+	_ = retry.Retry(time.Second, timeout, func() error {
+		offsetStatus, apiErr = c.V2Client.AlterConnectorOffsetsRequestStatus(connectorName, environmentId, kafkaClusterId)
 		if apiErr != nil {
 			return nil
 		}
@@ -114,22 +133,10 @@ func (c *offsetCommand) update(cmd *cobra.Command, args []string) error {
 		if strings.ToUpper(offsetStatus.Status.GetPhase()) != "PENDING" {
 			return nil
 		}
-		return fmt.Errorf("update offset request still pending, checking status again")
+		return fmt.Errorf("offset request still pending, checking status again")
 	})
-	if apiErr != nil {
-		return apiErr
-	}
 
-	if strings.ToUpper(offsetStatus.Status.GetPhase()) == "PENDING" {
-		output.Println(c.Config.EnableColor, "Operation is PENDING. Please run `confluent connect offset status describe` to get the latest status of the update request.")
-		return nil
-	}
-
-	if output.GetFormat(cmd) == output.Human {
-		return printHumanDescribeOffsetStatus(cmd, offsetStatus, args[0])
-	}
-
-	return printSerializedDescribeOffsetStatus(cmd, offsetStatus, args[0])
+	return offsetStatus, apiErr
 }
 
 func (c *offsetCommand) getAlterOffsetRequestBody(configFile string) (*connectv1.ConnectV1AlterOffsetRequest, error) {
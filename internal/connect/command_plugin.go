@@ -29,6 +29,9 @@ func newPluginCommand(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Comma
 		cmd.AddCommand(c.newListCommand())
 	} else {
 		cmd.AddCommand(newInstallCommand(prerunner))
+		cmd.AddCommand(newInstalledCommand(prerunner))
+		cmd.AddCommand(newUninstallCommand(prerunner))
+		cmd.AddCommand(newUpgradeCommand(prerunner))
 	}
 
 	return cmd
@@ -4,6 +4,7 @@ import (
 	"archive/zip"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -196,3 +197,81 @@ func TestRunningWorkerConfigLocations(t *testing.T) {
 	}
 	require.Equal(t, runningWorkerConfigs, expectedConfigs)
 }
+
+func TestFindInstalledPlugins(t *testing.T) {
+	tempDir := t.TempDir()
+
+	pluginDir := filepath.Join(tempDir, "confluent-hub-components")
+	writeTestManifest(t, filepath.Join(pluginDir, "confluentinc-kafka-connect-datagen"), `{"name": "kafka-connect-datagen", "title": "Kafka Connect Datagen", "version": "0.6.0", "owner": {"username": "confluentinc"}}`)
+	require.NoError(t, os.MkdirAll(filepath.Join(pluginDir, "no-manifest"), 0755))
+	writeTestManifest(t, filepath.Join(tempDir, "jdbc"), `{"name": "kafka-connect-jdbc", "title": "JDBC Connector", "version": "10.7.4", "owner": {"username": "confluentinc"}}`)
+
+	distributed := filepath.Join(tempDir, "connect-distributed.properties")
+	require.NoError(t, os.WriteFile(distributed, []byte(fmt.Sprintf("plugin.path=/usr/share/java, %s, %s/jdbc", pluginDir, tempDir)), 0644))
+	standalone := filepath.Join(tempDir, "connect-standalone.properties")
+	require.NoError(t, os.WriteFile(standalone, []byte("plugin.path="+pluginDir), 0644))
+
+	plugins, err := findInstalledPlugins([]string{distributed, standalone})
+	require.NoError(t, err)
+	require.Len(t, plugins, 2)
+
+	require.Equal(t, "confluentinc/kafka-connect-datagen", plugins[0].id())
+	require.Equal(t, "0.6.0", plugins[0].Manifest.Version)
+	require.Equal(t, filepath.Join(pluginDir, "confluentinc-kafka-connect-datagen"), plugins[0].Path)
+	require.Equal(t, []string{distributed, standalone}, plugins[0].WorkerConfigs)
+
+	require.Equal(t, "confluentinc/kafka-connect-jdbc", plugins[1].id())
+	require.Equal(t, filepath.Join(tempDir, "jdbc"), plugins[1].Path)
+	require.Equal(t, []string{distributed}, plugins[1].WorkerConfigs)
+}
+
+func TestRemovePluginFromWorkerConfig(t *testing.T) {
+	tempDir := t.TempDir()
+
+	pluginDir := filepath.Join(tempDir, "confluent-hub-components")
+	datagen := filepath.Join(pluginDir, "confluentinc-kafka-connect-datagen")
+	writeTestManifest(t, datagen, `{"name": "kafka-connect-datagen", "version": "0.6.0", "owner": {"username": "confluentinc"}}`)
+	jdbc := filepath.Join(tempDir, "jdbc")
+	writeTestManifest(t, jdbc, `{"name": "kafka-connect-jdbc", "version": "10.7.4", "owner": {"username": "confluentinc"}}`)
+
+	workerConfigPath := filepath.Join(tempDir, "connect-distributed.properties")
+	pluginPath := fmt.Sprintf("/usr/share/java, %s, %s", pluginDir, jdbc)
+	require.NoError(t, os.WriteFile(workerConfigPath, []byte("plugin.path="+pluginPath), 0644))
+
+	// Dry run: expect no changes
+	removed, err := removePluginFromWorkerConfig(jdbc, workerConfigPath, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{jdbc}, removed)
+
+	workerConfig, err := properties.LoadFile(workerConfigPath, properties.UTF8)
+	require.NoError(t, err)
+	require.Equal(t, pluginPath, workerConfig.GetString("plugin.path", ""))
+
+	// Actual run
+	removed, err = removePluginFromWorkerConfig(jdbc, workerConfigPath, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{jdbc}, removed)
+
+	workerConfig, err = properties.LoadFile(workerConfigPath, properties.UTF8)
+	require.NoError(t, err)
+	require.Equal(t, "/usr/share/java, "+pluginDir, workerConfig.GetString("plugin.path", ""))
+
+	// The plugin directory is only removed once no other plugins are left in it
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, ".gitkeep"), nil, 0644))
+	removed, err = removePluginFromWorkerConfig(datagen, workerConfigPath, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{pluginDir}, removed)
+
+	workerConfig, err = properties.LoadFile(workerConfigPath, properties.UTF8)
+	require.NoError(t, err)
+	require.Equal(t, "/usr/share/java", workerConfig.GetString("plugin.path", ""))
+
+	removed, err = removePluginFromWorkerConfig(datagen, workerConfigPath, false)
+	require.NoError(t, err)
+	require.Empty(t, removed)
+}
+
+func writeTestManifest(t *testing.T, pluginDir, manifest string) {
+	require.NoError(t, os.MkdirAll(pluginDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, "manifest.json"), []byte(manifest), 0644))
+}
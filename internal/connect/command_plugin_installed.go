@@ -0,0 +1,18 @@
+package connect
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+)
+
+func newInstalledCommand(prerunner pcmd.PreRunner) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "installed",
+		Short: "Manage installed Connect plugins.",
+	}
+
+	cmd.AddCommand(newInstalledListCommand(prerunner))
+
+	return cmd
+}
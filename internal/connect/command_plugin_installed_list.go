@@ -0,0 +1,81 @@
+package connect
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+type installedPluginOut struct {
+	Id      string `human:"ID" serialized:"id"`
+	Title   string `human:"Title" serialized:"title"`
+	Version string `human:"Version" serialized:"version"`
+	Path    string `human:"Path" serialized:"path"`
+}
+
+func newInstalledListCommand(prerunner pcmd.PreRunner) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List installed Connect plugins.",
+		Long:  "List the Connect plugins with a manifest, such as those installed from Confluent Hub, in the plugin path of each worker configuration file.",
+		Args:  cobra.NoArgs,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "List the plugins installed for the worker configuration files of your local Confluent Platform environment.",
+				Code: "confluent connect plugin installed list",
+			},
+			examples.Example{
+				Text: "List the plugins installed for a worker configuration file.",
+				Code: "confluent connect plugin installed list --worker-configurations $CONFLUENT_HOME/etc/kafka/connect-distributed.properties",
+			},
+		),
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLogout},
+	}
+
+	addWorkerConfigurationFlags(cmd)
+	pcmd.AddOutputFlag(cmd)
+
+	c := &pluginInstallCommand{pcmd.NewAnonymousCLICommand(cmd, prerunner)}
+	cmd.RunE = c.installedList
+
+	return cmd
+}
+
+func (c *pluginInstallCommand) installedList(cmd *cobra.Command, _ []string) error {
+	if runtime.GOOS == "windows" {
+		return fmt.Errorf("this command is not available on Windows")
+	}
+
+	workerConfigs, err := getWorkerConfigs(cmd)
+	if err != nil {
+		return err
+	}
+
+	plugins, err := findInstalledPlugins(workerConfigs)
+	if err != nil {
+		return err
+	}
+
+	list := output.NewList(cmd)
+	for _, plugin := range plugins {
+		list.Add(&installedPluginOut{
+			Id:      plugin.id(),
+			Title:   plugin.Manifest.Title,
+			Version: plugin.Manifest.Version,
+			Path:    plugin.Path,
+		})
+	}
+	return list.Print()
+}
+
+func addWorkerConfigurationFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("worker-configurations", []string{}, "A comma-separated list of paths to one or more Kafka Connect worker configuration files. By default, the worker configuration files of your Confluent Platform installations and running Connect workers are used.")
+	cmd.Flags().String("confluent-platform", "", "The path to a Confluent Platform archive installation. By default, this command will search for Confluent Platform installations in common locations.")
+
+	cmd.MarkFlagsMutuallyExclusive("worker-configurations", "confluent-platform")
+}
@@ -0,0 +1,121 @@
+package connect
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/form"
+	"github.com/confluentinc/cli/v4/pkg/output"
+	"github.com/confluentinc/cli/v4/pkg/utils"
+)
+
+func newUninstallCommand(prerunner pcmd.PreRunner) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "uninstall <plugin>",
+		Short: "Uninstall a Connect plugin.",
+		Long:  "Uninstall each installation of a Connect plugin, and remove it from the plugin path of the worker configuration files, along with the directory it was installed in if no other plugins are left there.",
+		Args:  cobra.ExactArgs(1),
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Uninstall the Datagen connector from your local Confluent Platform environment.",
+				Code: "confluent connect plugin uninstall confluentinc/kafka-connect-datagen",
+			},
+			examples.Example{
+				Text: "Preview the uninstallation of the Datagen connector for a worker configuration file.",
+				Code: "confluent connect plugin uninstall confluentinc/kafka-connect-datagen --worker-configurations $CONFLUENT_HOME/etc/kafka/connect-distributed.properties --dry-run",
+			},
+		),
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLogout},
+	}
+
+	addWorkerConfigurationFlags(cmd)
+	pcmd.AddDryRunFlag(cmd)
+	pcmd.AddForceFlag(cmd)
+
+	c := &pluginInstallCommand{pcmd.NewAnonymousCLICommand(cmd, prerunner)}
+	cmd.RunE = c.uninstall
+
+	return cmd
+}
+
+func (c *pluginInstallCommand) uninstall(cmd *cobra.Command, args []string) error {
+	if runtime.GOOS == "windows" {
+		return fmt.Errorf("this command is not available on Windows")
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	force, err := cmd.Flags().GetBool("force")
+	if err != nil {
+		return err
+	}
+
+	owner, name, pluginVersion, err := parseInstalledPluginId(args[0])
+	if err != nil {
+		return err
+	}
+
+	plugins, err := getInstalledPluginsById(cmd, owner, name)
+	if err != nil {
+		return err
+	}
+
+	if pluginVersion != "latest" {
+		plugins = slices.DeleteFunc(plugins, func(plugin *installedPlugin) bool { return plugin.Manifest.Version != pluginVersion })
+		if len(plugins) == 0 {
+			return fmt.Errorf(`version %s of plugin "%s/%s" is not installed`, pluginVersion, owner, name)
+		}
+	}
+
+	prompt := form.NewPrompt()
+	for _, plugin := range plugins {
+		if !force {
+			f := form.New(form.Field{
+				ID:        "confirm",
+				Prompt:    fmt.Sprintf(`Do you want to uninstall %s %s located at "%s"?`, plugin.Manifest.Title, plugin.Manifest.Version, plugin.Path),
+				IsYesOrNo: true,
+			})
+			if err := f.Prompt(prompt); err != nil {
+				return err
+			}
+			if !f.Responses["confirm"].(bool) {
+				continue
+			}
+		}
+
+		uninstallStr := fmt.Sprintf("Uninstalled %s %s from \"%s\".\n", plugin.Manifest.Title, plugin.Manifest.Version, plugin.Path)
+		if dryRun {
+			uninstallStr = utils.AddDryRunPrefix(uninstallStr)
+		} else if err := os.RemoveAll(plugin.Path); err != nil {
+			return err
+		}
+		output.Print(c.Config.EnableColor, uninstallStr)
+
+		for _, workerConfig := range plugin.WorkerConfigs {
+			removedPluginPathElements, err := removePluginFromWorkerConfig(plugin.Path, workerConfig, dryRun)
+			if err != nil {
+				return err
+			}
+			if len(removedPluginPathElements) == 0 {
+				continue
+			}
+
+			updateWorkerStr := fmt.Sprintf("Removed %s from the plugin path in worker configuration file \"%s\".\n", utils.ArrayToCommaDelimitedString(removedPluginPathElements, "and"), workerConfig)
+			if dryRun {
+				updateWorkerStr = utils.AddDryRunPrefix(updateWorkerStr)
+			}
+			output.Print(c.Config.EnableColor, updateWorkerStr)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package connect
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/hashicorp/go-version"
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/cpstructs"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/form"
+	"github.com/confluentinc/cli/v4/pkg/hub"
+	"github.com/confluentinc/cli/v4/pkg/output"
+	"github.com/confluentinc/cli/v4/pkg/utils"
+)
+
+func newUpgradeCommand(prerunner pcmd.PreRunner) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade <plugin>",
+		Short: "Upgrade an installed Connect plugin.",
+		Long:  "Upgrade each installation of a Connect plugin to the latest or a specific version from Confluent Hub, in place.",
+		Args:  cobra.ExactArgs(1),
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Upgrade the Datagen connector to the latest version.",
+				Code: "confluent connect plugin upgrade confluentinc/kafka-connect-datagen",
+			},
+			examples.Example{
+				Text: "Upgrade the Datagen connector to version 0.6.2 for a worker configuration file.",
+				Code: "confluent connect plugin upgrade confluentinc/kafka-connect-datagen:0.6.2 --worker-configurations $CONFLUENT_HOME/etc/kafka/connect-distributed.properties",
+			},
+		),
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLogout},
+	}
+
+	addWorkerConfigurationFlags(cmd)
+	pcmd.AddDryRunFlag(cmd)
+	pcmd.AddForceFlag(cmd)
+
+	c := &pluginInstallCommand{pcmd.NewAnonymousCLICommand(cmd, prerunner)}
+	cmd.RunE = c.upgrade
+
+	return cmd
+}
+
+func (c *pluginInstallCommand) upgrade(cmd *cobra.Command, args []string) error {
+	if runtime.GOOS == "windows" {
+		return fmt.Errorf("this command is not available on Windows")
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	force, err := cmd.Flags().GetBool("force")
+	if err != nil {
+		return err
+	}
+
+	owner, name, pluginVersion, err := parseInstalledPluginId(args[0])
+	if err != nil {
+		return err
+	}
+
+	plugins, err := getInstalledPluginsById(cmd, owner, name)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.GetHubClient()
+	if err != nil {
+		return err
+	}
+
+	pluginManifest, err := client.GetRemoteManifest(owner, name, pluginVersion)
+	if err != nil {
+		return err
+	}
+
+	var outdatedPlugins []*installedPlugin
+	for _, plugin := range plugins {
+		if plugin.Manifest.Version == pluginManifest.Version || (pluginVersion == "latest" && !isNewerPluginVersion(pluginManifest.Version, plugin.Manifest.Version)) {
+			output.Printf(c.Config.EnableColor, "%s %s located at \"%s\" is up to date.\n", plugin.Manifest.Title, plugin.Manifest.Version, plugin.Path)
+			continue
+		}
+		outdatedPlugins = append(outdatedPlugins, plugin)
+	}
+	if len(outdatedPlugins) == 0 {
+		return nil
+	}
+
+	if !dryRun {
+		if err := checkLicenseAcceptance(pluginManifest, form.NewPrompt(), force); err != nil {
+			return err
+		}
+	}
+
+	for _, plugin := range outdatedPlugins {
+		upgradeStr := fmt.Sprintf("Upgraded %s from %s to %s at \"%s\".\n", pluginManifest.Title, plugin.Manifest.Version, pluginManifest.Version, plugin.Path)
+		if dryRun {
+			output.Print(c.Config.EnableColor, utils.AddDryRunPrefix(upgradeStr))
+			continue
+		}
+
+		if err := upgradePlugin(client, plugin, pluginManifest); err != nil {
+			return err
+		}
+		output.Print(c.Config.EnableColor, upgradeStr)
+	}
+
+	if !dryRun {
+		output.Println(c.Config.EnableColor, "Restart the Connect workers to load the new version of the plugin.")
+	}
+
+	return nil
+}
+
+// upgradePlugin replaces an installed plugin with the version of a manifest from Confluent Hub. The new version is
+// extracted next to the installed one, which is moved aside while the new version is moved into place, and is only
+// removed once the new version is in place.
+func upgradePlugin(client *hub.Client, plugin *installedPlugin, pluginManifest *cpstructs.Manifest) error {
+	tempDir, err := os.MkdirTemp(filepath.Dir(plugin.Path), ".upgrade-")
+	if err != nil {
+		return err
+	}
+
+	// The previous version is kept if it cannot be restored, so that it can be restored by hand
+	keepTempDir := false
+	defer func() {
+		if !keepTempDir {
+			_ = os.RemoveAll(tempDir)
+		}
+	}()
+
+	if err := installFromRemote(client, pluginManifest, tempDir); err != nil {
+		return err
+	}
+
+	previousPath := filepath.Join(tempDir, "previous")
+	if err := os.Rename(plugin.Path, previousPath); err != nil {
+		return err
+	}
+
+	if err := os.Rename(filepath.Join(tempDir, fmt.Sprintf("%s-%s", pluginManifest.Owner.Username, pluginManifest.Name)), plugin.Path); err != nil {
+		if restoreErr := os.Rename(previousPath, plugin.Path); restoreErr != nil {
+			keepTempDir = true
+			return fmt.Errorf("failed to upgrade plugin at \"%s\": %w, and failed to restore the previous version from \"%s\": %w", plugin.Path, err, previousPath, restoreErr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// isNewerPluginVersion reports whether a plugin version is newer than the installed one, or whether they differ if
+// either is not a semantic version.
+func isNewerPluginVersion(pluginVersion, installedVersion string) bool {
+	v1, err1 := version.NewVersion(pluginVersion)
+	v2, err2 := version.NewVersion(installedVersion)
+	if err1 != nil || err2 != nil {
+		return pluginVersion != installedVersion
+	}
+	return v1.GreaterThan(v2)
+}
@@ -0,0 +1,92 @@
+package connect
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/md5"
+	"crypto/sha1"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/cli/v4/pkg/cpstructs"
+	"github.com/confluentinc/cli/v4/pkg/hub"
+)
+
+func TestUpgradePlugin(t *testing.T) {
+	manifestJson := `{"name": "kafka-connect-datagen", "title": "Kafka Connect Datagen", "version": "0.6.2", "owner": {"username": "confluentinc"}}`
+
+	buf := new(bytes.Buffer)
+	writer := zip.NewWriter(buf)
+	for name, content := range map[string]string{
+		"confluentinc-kafka-connect-datagen-0.6.2/manifest.json":                       manifestJson,
+		"confluentinc-kafka-connect-datagen-0.6.2/lib/kafka-connect-datagen-0.6.2.jar": "jar",
+	} {
+		file, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = file.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	archive := buf.Bytes()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/plugins/confluentinc/kafka-connect-datagen/versions/0.6.2/archive.zip", r.URL.Path)
+		_, err := w.Write(archive)
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	client := &hub.Client{URL: server.URL, Client: server.Client()}
+	pluginManifest := &cpstructs.Manifest{
+		Name:    "kafka-connect-datagen",
+		Version: "0.6.2",
+		Owner:   cpstructs.Owner{Username: "confluentinc"},
+		Archive: cpstructs.Archive{
+			Url:  server.URL + "/api/plugins/confluentinc/kafka-connect-datagen/versions/0.6.2/archive.zip",
+			Md5:  fmt.Sprintf("%x", md5.Sum(archive)),
+			Sha1: fmt.Sprintf("%x", sha1.Sum(archive)),
+		},
+	}
+
+	pluginDir := filepath.Join(t.TempDir(), "datagen")
+	writeTestManifest(t, pluginDir, `{"name": "kafka-connect-datagen", "version": "0.6.0", "owner": {"username": "confluentinc"}}`)
+	require.NoError(t, os.MkdirAll(filepath.Join(pluginDir, "lib"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, "lib", "kafka-connect-datagen-0.6.0.jar"), []byte("jar"), 0644))
+
+	require.NoError(t, upgradePlugin(client, &installedPlugin{Path: pluginDir}, pluginManifest))
+
+	installedManifest, err := readPluginManifest(pluginDir)
+	require.NoError(t, err)
+	require.Equal(t, "0.6.2", installedManifest.Version)
+
+	entries, err := os.ReadDir(filepath.Join(pluginDir, "lib"))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "kafka-connect-datagen-0.6.2.jar", entries[0].Name())
+
+	// The temporary directory is removed
+	entries, err = os.ReadDir(filepath.Dir(pluginDir))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	// A corrupted archive leaves the installed version in place
+	pluginManifest.Archive.Md5 = "12345"
+	require.Error(t, upgradePlugin(client, &installedPlugin{Path: pluginDir}, pluginManifest))
+	installedManifest, err = readPluginManifest(pluginDir)
+	require.NoError(t, err)
+	require.Equal(t, "0.6.2", installedManifest.Version)
+}
+
+func TestIsNewerPluginVersion(t *testing.T) {
+	require.True(t, isNewerPluginVersion("0.6.2", "0.6.0"))
+	require.True(t, isNewerPluginVersion("10.7.4", "10.7.4-rc1"))
+	require.False(t, isNewerPluginVersion("0.6.0", "0.6.2"))
+	require.False(t, isNewerPluginVersion("0.6.2", "0.6.2"))
+	require.True(t, isNewerPluginVersion("latest-build", "0.6.2"))
+}
@@ -1,10 +1,12 @@
 package connect
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -12,6 +14,7 @@ import (
 
 	"github.com/confluentinc/properties"
 
+	"github.com/confluentinc/cli/v4/pkg/cpstructs"
 	"github.com/confluentinc/cli/v4/pkg/errors"
 	"github.com/confluentinc/cli/v4/pkg/exec"
 	"github.com/confluentinc/cli/v4/pkg/form"
@@ -345,15 +348,19 @@ func runningWorkerConfigLocations(searchProcessCmd exec.Command) ([]WorkerConfig
 	return result, nil
 }
 
-func chooseWorkerConfigs(cmd *cobra.Command, installation *platformInstallation, prompt form.Prompt, force bool) ([]string, error) {
+// detectWorkerConfigs returns the existing worker configuration files in the standard locations of each installation,
+// followed by those used by running Connect workers.
+func detectWorkerConfigs(installations []platformInstallation) ([]WorkerConfig, error) {
 	var workerConfigs []WorkerConfig
 
-	if standardWorkerConfigs, err := standardWorkerConfigLocations(installation); err != nil {
-		return nil, fmt.Errorf("could not infer possible worker configuration file locations from standard candidates: %w", err)
-	} else {
-		for _, workerConfig := range standardWorkerConfigs {
-			if utils.DoesPathExist(workerConfig.Path) {
-				workerConfigs = append(workerConfigs, workerConfig)
+	for _, installation := range installations {
+		if standardWorkerConfigs, err := standardWorkerConfigLocations(&installation); err != nil {
+			return nil, fmt.Errorf("could not infer possible worker configuration file locations from standard candidates: %w", err)
+		} else {
+			for _, workerConfig := range standardWorkerConfigs {
+				if utils.DoesPathExist(workerConfig.Path) {
+					workerConfigs = append(workerConfigs, workerConfig)
+				}
 			}
 		}
 	}
@@ -375,6 +382,15 @@ func chooseWorkerConfigs(cmd *cobra.Command, installation *platformInstallation,
 		}
 	}
 
+	return workerConfigs, nil
+}
+
+func chooseWorkerConfigs(cmd *cobra.Command, installation *platformInstallation, prompt form.Prompt, force bool) ([]string, error) {
+	workerConfigs, err := detectWorkerConfigs([]platformInstallation{*installation})
+	if err != nil {
+		return nil, err
+	}
+
 	var filteredWorkerConfigs []WorkerConfig
 	if len(workerConfigs) == 0 {
 		output.Println(false, "No worker configuration files found.")
@@ -468,3 +484,256 @@ func updateWorkerConfig(pluginDir, workerConfigPath string, dryRun bool) error {
 	}
 	return nil
 }
+
+type installedPlugin struct {
+	Manifest      *cpstructs.Manifest
+	Path          string
+	WorkerConfigs []string
+}
+
+func (p *installedPlugin) id() string {
+	return fmt.Sprintf("%s/%s", p.Manifest.Owner.Username, p.Manifest.Name)
+}
+
+// getWorkerConfigs returns the worker configuration files passed with `--worker-configurations`, or otherwise those
+// detected for the Confluent Platform installations and running Connect workers, without prompting.
+func getWorkerConfigs(cmd *cobra.Command) ([]string, error) {
+	workerConfigs, err := getWorkerConfigsFromFlag(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if len(workerConfigs) > 0 {
+		return workerConfigs, nil
+	}
+
+	var installations []platformInstallation
+	if cmd.Flags().Changed("confluent-platform") {
+		installation, err := getPlatformInstallationFromFlag(cmd)
+		if err != nil {
+			return nil, err
+		}
+		installations = append(installations, *installation)
+	} else {
+		installations, err = findInstallationDirectories()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	detectedWorkerConfigs, err := detectWorkerConfigs(installations)
+	if err != nil {
+		return nil, err
+	}
+
+	set := types.NewSet[string]()
+	for _, workerConfig := range detectedWorkerConfigs {
+		if !set.Contains(workerConfig.Path) {
+			set.Add(workerConfig.Path)
+			workerConfigs = append(workerConfigs, workerConfig.Path)
+		}
+	}
+
+	if len(workerConfigs) == 0 {
+		return nil, errors.NewErrorWithSuggestions(
+			"unable to detect any worker configuration files",
+			"Pass the worker configuration files with `--worker-configurations`.",
+		)
+	}
+
+	return workerConfigs, nil
+}
+
+// findInstalledPlugins returns the plugins with a manifest, such as those installed from Confluent Hub, in the plugin
+// path of each worker configuration file.
+func findInstalledPlugins(workerConfigs []string) ([]*installedPlugin, error) {
+	var plugins []*installedPlugin
+	pluginsByPath := make(map[string]*installedPlugin)
+	for _, workerConfigPath := range workerConfigs {
+		pluginPathElements, err := getPluginPathElements(workerConfigPath)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pluginPathElement := range pluginPathElements {
+			pluginDirs, err := findPluginDirectories(pluginPathElement)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, pluginDir := range pluginDirs {
+				plugin, ok := pluginsByPath[pluginDir]
+				if !ok {
+					manifest, err := readPluginManifest(pluginDir)
+					if err != nil {
+						return nil, err
+					}
+					plugin = &installedPlugin{Manifest: manifest, Path: pluginDir}
+					pluginsByPath[pluginDir] = plugin
+					plugins = append(plugins, plugin)
+				}
+				if !slices.Contains(plugin.WorkerConfigs, workerConfigPath) {
+					plugin.WorkerConfigs = append(plugin.WorkerConfigs, workerConfigPath)
+				}
+			}
+		}
+	}
+
+	slices.SortFunc(plugins, func(a, b *installedPlugin) int {
+		if a.id() != b.id() {
+			return strings.Compare(a.id(), b.id())
+		}
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return plugins, nil
+}
+
+// getInstalledPluginsById returns the installations of a plugin in the plugin path of each worker configuration file.
+func getInstalledPluginsById(cmd *cobra.Command, owner, name string) ([]*installedPlugin, error) {
+	workerConfigs, err := getWorkerConfigs(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	plugins, err := findInstalledPlugins(workerConfigs)
+	if err != nil {
+		return nil, err
+	}
+
+	id := fmt.Sprintf("%s/%s", owner, name)
+	plugins = slices.DeleteFunc(plugins, func(plugin *installedPlugin) bool { return plugin.id() != id })
+	if len(plugins) == 0 {
+		return nil, errors.NewErrorWithSuggestions(
+			fmt.Sprintf(`plugin "%s" is not installed`, id),
+			"List the installed plugins with `confluent connect plugin installed list`.",
+		)
+	}
+
+	return plugins, nil
+}
+
+func getPluginPathElements(workerConfigPath string) ([]string, error) {
+	workerConfig, err := properties.LoadFile(workerConfigPath, properties.UTF8)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to parse worker configuration file "%s": %w`, workerConfigPath, err)
+	}
+
+	var pluginPathElements []string
+	for _, pluginPathElement := range regexp.MustCompile(" *, *").Split(workerConfig.GetString("plugin.path", ""), -1) {
+		if pluginPathElement = strings.TrimSpace(pluginPathElement); pluginPathElement != "" {
+			pluginPathElements = append(pluginPathElements, pluginPathElement)
+		}
+	}
+	return pluginPathElements, nil
+}
+
+// findPluginDirectories returns the directories with a manifest in an element of the plugin path, which is either a
+// plugin or a directory of plugins.
+func findPluginDirectories(pluginPathElement string) ([]string, error) {
+	pluginPathElement = filepath.Clean(pluginPathElement)
+	if utils.DoesPathExist(filepath.Join(pluginPathElement, "manifest.json")) {
+		return []string{pluginPathElement}, nil
+	}
+
+	entries, err := os.ReadDir(pluginPathElement)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf(`failed to read plugin directory "%s": %w`, pluginPathElement, err)
+	}
+
+	var pluginDirs []string
+	for _, entry := range entries {
+		pluginDir := filepath.Join(pluginPathElement, entry.Name())
+		if entry.IsDir() && utils.DoesPathExist(filepath.Join(pluginDir, "manifest.json")) {
+			pluginDirs = append(pluginDirs, pluginDir)
+		}
+	}
+	return pluginDirs, nil
+}
+
+func readPluginManifest(pluginDir string) (*cpstructs.Manifest, error) {
+	manifestPath := filepath.Join(pluginDir, "manifest.json")
+	data, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to read manifest file "%s": %w`, manifestPath, err)
+	}
+
+	pluginManifest := new(cpstructs.Manifest)
+	if err := json.Unmarshal(data, pluginManifest); err != nil {
+		return nil, fmt.Errorf(`failed to parse manifest file "%s": %w`, manifestPath, err)
+	}
+	return pluginManifest, nil
+}
+
+// removePluginFromWorkerConfig removes an uninstalled plugin from the plugin path of a worker configuration file, along
+// with the directory it was installed in if no other plugins are left there, and returns the removed elements.
+func removePluginFromWorkerConfig(pluginDir, workerConfigPath string, dryRun bool) ([]string, error) {
+	pluginPathProperty := "plugin.path"
+
+	workerConfig, err := properties.LoadFile(workerConfigPath, properties.UTF8)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to parse worker configuration file "%s": %w`, workerConfigPath, err)
+	}
+	pluginPath := workerConfig.GetString(pluginPathProperty, "")
+
+	var newPluginPathElements, removedPluginPathElements []string
+	for _, pluginPathElement := range regexp.MustCompile(" *, *").Split(pluginPath, -1) {
+		cleanPluginPathElement := filepath.Clean(pluginPathElement)
+		if cleanPluginPathElement == pluginDir || cleanPluginPathElement == filepath.Dir(pluginDir) && !hasOtherPlugins(cleanPluginPathElement, pluginDir) {
+			removedPluginPathElements = append(removedPluginPathElements, pluginPathElement)
+			continue
+		}
+		newPluginPathElements = append(newPluginPathElements, pluginPathElement)
+	}
+	if len(removedPluginPathElements) == 0 {
+		return nil, nil
+	}
+
+	newPluginPath := strings.Join(newPluginPathElements, ", ")
+	if newPluginPath == "" {
+		workerConfig.Delete(pluginPathProperty)
+	} else if _, _, err := workerConfig.Set(pluginPathProperty, newPluginPath); err != nil {
+		return nil, fmt.Errorf(`failed to update %s property to "%s" for worker configuration "%s": %w`, pluginPathProperty, newPluginPath, workerConfigPath, err)
+	}
+	fileInfo, err := os.Stat(workerConfigPath)
+	if err != nil {
+		return nil, err
+	}
+	if dryRun {
+		return removedPluginPathElements, nil
+	}
+	workerConfigFile, err := os.OpenFile(workerConfigPath, os.O_TRUNC|os.O_RDWR, fileInfo.Mode())
+	if err != nil {
+		return nil, fmt.Errorf(`failed to open worker configuration file "%s" before updating with new %s value "%s": %w`, workerConfigPath, pluginPathProperty, newPluginPath, err)
+	}
+	defer workerConfigFile.Close()
+	if _, err := workerConfig.WriteFormattedComment(workerConfigFile, properties.UTF8); err != nil {
+		return nil, fmt.Errorf(`failed to update worker configuration file "%s" with new %s value "%s": %w`, workerConfigPath, pluginPathProperty, newPluginPath, err)
+	}
+	return removedPluginPathElements, nil
+}
+
+// hasOtherPlugins reports whether a directory has any entries other than a plugin and hidden files.
+func hasOtherPlugins(dir, pluginDir string) bool {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return true
+	}
+
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), ".") && filepath.Join(dir, entry.Name()) != pluginDir {
+			return true
+		}
+	}
+	return false
+}
+
+// parseInstalledPluginId parses a plugin ID with the format "<owner>/<name>", optionally followed by ":<version>".
+func parseInstalledPluginId(plugin string) (string, string, string, error) {
+	if !strings.Contains(plugin, ":") {
+		plugin += ":latest"
+	}
+	return parsePluginId(plugin)
+}
@@ -4,12 +4,43 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"regexp"
 
 	"github.com/spf13/cobra"
 
 	"github.com/confluentinc/cli/v4/pkg/errors"
 )
 
+// maskedConfigValue replaces the values of sensitive connector configurations, as in the responses of the Connect API.
+const maskedConfigValue = "****************"
+
+// serverManagedConfigs are added to the configuration of a connector by Confluent Cloud, so they are not removed by an
+// update which leaves them out.
+var serverManagedConfigs = []string{
+	"cloud.environment",
+	"cloud.provider",
+	"kafka.dedicated",
+	"kafka.endpoint",
+	"kafka.max.partition.validation.disable",
+	"kafka.max.partition.validation.max.partitions",
+	"kafka.region",
+	"valid.kafka.api.key",
+}
+
+var sensitiveConfigRegex = regexp.MustCompile(`(?i)(password|secret|credentials|token|jaas\.config|api\.key|private\.key)`)
+
+// isSensitiveConfig returns whether a connector configuration, such as "*.password" or "*.secret", holds a credential.
+func isSensitiveConfig(name string) bool {
+	return sensitiveConfigRegex.MatchString(name)
+}
+
+func maskConfigValue(name, value string) string {
+	if value != "" && isSensitiveConfig(name) {
+		return maskedConfigValue
+	}
+	return value
+}
+
 func getConfigAndOffsets(cmd *cobra.Command, isUpdate bool) (map[string]string, []map[string]any, error) {
 	configFile, err := cmd.Flags().GetString("config-file")
 	if err != nil {
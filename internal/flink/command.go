@@ -53,6 +53,9 @@ func New(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Command {
 		cmd.AddCommand(c.newShellCommand(prerunner, cfg))
 	}
 
+	// Offline Commands
+	cmd.AddCommand(newSqlCommand(prerunner))
+
 	// Cloud Specific Commands
 	cmd.AddCommand(c.newArtifactCommand())
 	cmd.AddCommand(c.newConnectionCommand())
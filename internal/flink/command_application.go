@@ -30,6 +30,7 @@ func (c *command) newApplicationCommand() *cobra.Command {
 		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLogout},
 	}
 
+	cmd.AddCommand(c.newApplicationApplyCommand())
 	cmd.AddCommand(c.newApplicationCreateCommand())
 	cmd.AddCommand(c.newApplicationDeleteCommand())
 	cmd.AddCommand(c.newApplicationDescribeCommand())
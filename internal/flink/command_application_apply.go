@@ -0,0 +1,476 @@
+package flink
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	cmfsdk "github.com/confluentinc/cmf-sdk-go/v1"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/flink"
+	"github.com/confluentinc/cli/v4/pkg/output"
+	"github.com/confluentinc/cli/v4/pkg/wait"
+)
+
+const (
+	applicationApplyActionCreate = "create"
+	applicationApplyActionUpdate = "update"
+	applicationApplyActionNone   = "none"
+
+	applicationApplyPollInterval = 5 * time.Second
+)
+
+var (
+	applicationRunningStates = []string{"RUNNING", "FINISHED"}
+	applicationFailedStates  = []string{"FAILED", "FAILING", "CANCELED"}
+	savepointFailedStates    = []string{"FAILED", "ABANDONED"}
+)
+
+type applicationSpecChange struct {
+	Field   string `human:"Field" json:"field" yaml:"field"`
+	Current string `human:"Current" json:"current" yaml:"current"`
+	Desired string `human:"Desired" json:"desired" yaml:"desired"`
+}
+
+type applicationApplyOut struct {
+	Name        string                  `json:"name" yaml:"name"`
+	Environment string                  `json:"environment" yaml:"environment"`
+	Action      string                  `json:"action" yaml:"action"`
+	Changes     []applicationSpecChange `json:"changes,omitempty" yaml:"changes,omitempty"`
+	Savepoint   string                  `json:"savepoint,omitempty" yaml:"savepoint,omitempty"`
+	Instance    string                  `json:"instance,omitempty" yaml:"instance,omitempty"`
+	JobState    string                  `json:"job_state,omitempty" yaml:"job_state,omitempty"`
+	RolledBack  bool                    `json:"rolled_back,omitempty" yaml:"rolled_back,omitempty"`
+}
+
+type applicationApplyHumanOut struct {
+	Name        string `human:"Name"`
+	Environment string `human:"Environment"`
+	Action      string `human:"Action"`
+	Savepoint   string `human:"Savepoint,omitempty"`
+	Instance    string `human:"Instance,omitempty"`
+	JobState    string `human:"Job State,omitempty"`
+	RolledBack  bool   `human:"Rolled Back"`
+}
+
+func (c *command) newApplicationApplyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Create or update a Flink application and wait for it to roll out.",
+		Long: "Create or update a Flink application from a resource file, and wait for the new application instance to be running.\n\n" +
+			"When the application exists, the fields of its specification which differ from the resource file are shown before it is updated. " +
+			`The command then follows the events of the application until the job of the new instance is "RUNNING" or "FINISHED", or fails.`,
+		Args: cobra.NoArgs,
+		RunE: c.applicationApply,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Preview the changes needed to apply "app.yaml" in environment "prod".`,
+				Code: "confluent flink application apply --file app.yaml --environment prod --dry-run",
+			},
+			examples.Example{
+				Text: `Apply "app.yaml", taking a savepoint first and rolling back if the new instance fails.`,
+				Code: "confluent flink application apply --file app.yaml --environment prod --savepoint --rollback",
+			},
+		),
+	}
+
+	cmd.Flags().StringP("file", "f", "", "Path to a JSON or YAML resource file of the Flink application.")
+	cmd.Flags().String("environment", "", "Name of the Flink environment.")
+	cmd.Flags().Bool("savepoint", false, "Take a savepoint of the application before updating it.")
+	cmd.Flags().Bool("rollback", false, "Take a savepoint of the application before updating it, and restore its previous specification from the savepoint if the new instance fails.")
+	cmd.Flags().Duration("timeout", 10*time.Minute, "Maximum time to wait for the new application instance to be running or failed (e.g., '30s', '5m', '2h').")
+	pcmd.AddDryRunFlag(cmd)
+	addCmfFlagSet(cmd)
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("file"))
+	cobra.CheckErr(cmd.MarkFlagRequired("environment"))
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "json", "yaml", "yml"))
+
+	return cmd
+}
+
+func (c *command) applicationApply(cmd *cobra.Command, _ []string) error {
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	environment, err := cmd.Flags().GetString("environment")
+	if err != nil {
+		return err
+	}
+
+	takeSavepoint, err := cmd.Flags().GetBool("savepoint")
+	if err != nil {
+		return err
+	}
+
+	rollback, err := cmd.Flags().GetBool("rollback")
+	if err != nil {
+		return err
+	}
+
+	timeout, err := cmd.Flags().GetDuration("timeout")
+	if err != nil {
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	sdkApplication, err := readApplicationResourceFile(file)
+	if err != nil {
+		return err
+	}
+
+	name, ok := sdkApplication.Metadata["name"].(string)
+	if !ok || name == "" {
+		return fmt.Errorf(`no application name found in "%s": set "metadata.name"`, file)
+	}
+
+	client, err := c.GetCmfClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	applications, err := client.ListApplications(c.createContext(), environment, 0)
+	if err != nil {
+		return err
+	}
+
+	out := &applicationApplyOut{Name: name, Environment: environment, Action: applicationApplyActionCreate}
+
+	var previous *cmfsdk.FlinkApplication
+	if slices.ContainsFunc(applications, func(application cmfsdk.FlinkApplication) bool { return application.Metadata["name"] == name }) {
+		current, err := client.DescribeApplication(c.createContext(), environment, name)
+		if err != nil {
+			return err
+		}
+		previous = &current
+
+		out.Changes = diffApplicationSpec(current.Spec, sdkApplication.Spec)
+		out.Action = applicationApplyActionUpdate
+		if len(out.Changes) == 0 {
+			out.Action = applicationApplyActionNone
+		}
+	}
+
+	isHuman := output.GetFormat(cmd) == output.Human
+	if isHuman && len(out.Changes) > 0 {
+		list := output.NewList(cmd)
+		for _, change := range out.Changes {
+			list.Add(&change)
+		}
+		if err := list.Print(); err != nil {
+			return err
+		}
+	}
+
+	if dryRun || out.Action == applicationApplyActionNone {
+		return printApplicationApply(cmd, out)
+	}
+
+	if previous == nil {
+		if _, err := client.CreateApplication(c.createContext(), environment, sdkApplication); err != nil {
+			return err
+		}
+	}
+
+	// Instances which exist before the application is updated are ignored while waiting for the rollout
+	var previousInstances []string
+	var savepointPath string
+	if previous != nil {
+		instances, err := client.ListApplicationInstances(c.createContext(), environment, name, 0)
+		if err != nil {
+			return err
+		}
+		for _, instance := range instances {
+			previousInstances = append(previousInstances, instance.Metadata.GetName())
+		}
+
+		// A rollback restores the application from the savepoint, so it always takes one
+		if takeSavepoint || rollback {
+			savepoint, path, err := c.createApplicationApplySavepoint(client, environment, name, timeout)
+			if err != nil {
+				return err
+			}
+			out.Savepoint = savepoint
+			savepointPath = path
+			if isHuman {
+				output.Printf(c.Config.EnableColor, "Created savepoint \"%s\" of application \"%s\".\n", savepoint, name)
+			}
+		}
+
+		if _, err := client.UpdateApplication(c.createContext(), environment, sdkApplication); err != nil {
+			return err
+		}
+	}
+
+	instance, err := c.waitForApplicationRollout(cmd, client, environment, name, previousInstances, timeout)
+	if instance != nil {
+		out.Instance = instance.Metadata.GetName()
+		out.JobState = getApplicationInstanceJobState(*instance)
+	}
+	if err == nil {
+		return printApplicationApply(cmd, out)
+	}
+
+	if rollback && previous != nil {
+		if rollbackErr := c.rollbackApplication(cmd, client, environment, *previous, savepointPath, timeout); rollbackErr != nil {
+			return fmt.Errorf("%w; failed to roll back: %w", err, rollbackErr)
+		}
+		out.RolledBack = true
+		if printErr := printApplicationApply(cmd, out); printErr != nil {
+			return printErr
+		}
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf(`%s; restored the previous specification of the application from savepoint "%s"`, err.Error(), out.Savepoint),
+			fmt.Sprintf("List the instances of the application with `confluent flink application instance list --application %s --environment %s`.", name, environment),
+		)
+	}
+
+	if printErr := printApplicationApply(cmd, out); printErr != nil {
+		return printErr
+	}
+	return err
+}
+
+// rollbackApplication restores the previous specification of an application, started from the savepoint taken before it
+// was updated, and waits for the restored instance to be running.
+func (c *command) rollbackApplication(cmd *cobra.Command, client *flink.CmfRestClient, environment string, previous cmfsdk.FlinkApplication, savepointPath string, timeout time.Duration) error {
+	name, _ := previous.Metadata["name"].(string)
+	if savepointPath == "" {
+		return fmt.Errorf(`savepoint of application "%s" has no path to restore from`, name)
+	}
+
+	// Instances of the failed update are ignored while waiting for the rollback
+	instances, err := client.ListApplicationInstances(c.createContext(), environment, name, 0)
+	if err != nil {
+		return err
+	}
+	var previousInstances []string
+	for _, instance := range instances {
+		previousInstances = append(previousInstances, instance.Metadata.GetName())
+	}
+
+	spec, err := getRollbackApplicationSpec(previous.Spec, savepointPath)
+	if err != nil {
+		return err
+	}
+
+	// The status of the previous application is managed by CMF, so only its specification is restored
+	previousApplication := cmfsdk.FlinkApplication{
+		ApiVersion: previous.ApiVersion,
+		Kind:       previous.Kind,
+		Metadata:   previous.Metadata,
+		Spec:       spec,
+	}
+	if _, err := client.UpdateApplication(c.createContext(), environment, previousApplication); err != nil {
+		return err
+	}
+
+	_, err = c.waitForApplicationRollout(cmd, client, environment, name, previousInstances, timeout)
+	return err
+}
+
+// getRollbackApplicationSpec returns a copy of the previous specification of an application which starts from the given
+// savepoint. The savepoint redeploy nonce is changed, since the initial savepoint path alone is ignored by applications
+// which have been deployed before.
+func getRollbackApplicationSpec(previous map[string]any, savepointPath string) (map[string]any, error) {
+	b, err := json.Marshal(previous)
+	if err != nil {
+		return nil, err
+	}
+	spec := map[string]any{}
+	if err := json.Unmarshal(b, &spec); err != nil {
+		return nil, err
+	}
+
+	job, ok := spec["job"].(map[string]any)
+	if !ok {
+		job = map[string]any{}
+		spec["job"] = job
+	}
+	job["initialSavepointPath"] = savepointPath
+	nonce, _ := job["savepointRedeployNonce"].(float64)
+	job["savepointRedeployNonce"] = nonce + 1
+
+	return spec, nil
+}
+
+func (c *command) createApplicationApplySavepoint(client *flink.CmfRestClient, environment, application string, timeout time.Duration) (string, string, error) {
+	savepoint, err := client.CreateSavepointApplication(c.createContext(), cmfsdk.Savepoint{ApiVersion: "cmf.confluent.io/v1", Kind: "Savepoint"}, environment, application)
+	if err != nil {
+		return "", "", err
+	}
+	name := savepoint.Metadata.GetName()
+
+	// The application is only updated once the savepoint is complete, so that it can be restored from
+	savepoint, err = wait.Poll(c.createContext(), wait.Options[cmfsdk.Savepoint]{
+		Fetch: func() (cmfsdk.Savepoint, error) {
+			return client.DescribeSavepoint(c.createContext(), environment, name, application, "")
+		},
+		IsTerminal: func(savepoint cmfsdk.Savepoint) bool { return savepoint.Status.GetState() == "COMPLETED" },
+		IsFailed: func(savepoint cmfsdk.Savepoint) bool {
+			return slices.Contains(savepointFailedStates, savepoint.Status.GetState())
+		},
+		PollInterval: applicationApplyPollInterval,
+		Timeout:      timeout,
+	})
+	switch err {
+	case nil:
+		return name, savepoint.Status.GetPath(), nil
+	case wait.ErrFailed:
+		return "", "", fmt.Errorf(`savepoint "%s" of application "%s" is in state %q: the application was not updated`, name, application, savepoint.Status.GetState())
+	default:
+		return "", "", fmt.Errorf(`failed to wait for savepoint "%s" of application "%s": %w`, name, application, err)
+	}
+}
+
+// waitForApplicationRollout waits for the job of a new instance of the application to be running or failed, and prints
+// the events of the application as they happen.
+func (c *command) waitForApplicationRollout(cmd *cobra.Command, client *flink.CmfRestClient, environment, application string, previousInstances []string, timeout time.Duration) (*cmfsdk.FlinkApplicationInstance, error) {
+	isHuman := output.GetFormat(cmd) == output.Human
+	seenEvents := map[string]bool{}
+
+	instance, err := wait.Poll(c.createContext(), wait.Options[*cmfsdk.FlinkApplicationInstance]{
+		Fetch: func() (*cmfsdk.FlinkApplicationInstance, error) {
+			if isHuman {
+				events, err := client.ListApplicationEvents(c.createContext(), environment, application, 0)
+				if err != nil {
+					return nil, err
+				}
+				for _, event := range events {
+					if seenEvents[event.Metadata.GetName()] {
+						continue
+					}
+					seenEvents[event.Metadata.GetName()] = true
+					output.Printf(c.Config.EnableColor, "%s [%s] %s\n", event.Metadata.GetCreationTimestamp(), event.Status.GetType(), event.Status.GetMessage())
+				}
+			}
+
+			instances, err := client.ListApplicationInstances(c.createContext(), environment, application, 0)
+			if err != nil {
+				return nil, err
+			}
+			return getNewApplicationInstance(instances, previousInstances), nil
+		},
+		IsTerminal: func(instance *cmfsdk.FlinkApplicationInstance) bool {
+			return instance != nil && slices.Contains(applicationRunningStates, getApplicationInstanceJobState(*instance))
+		},
+		IsFailed: func(instance *cmfsdk.FlinkApplicationInstance) bool {
+			return instance != nil && slices.Contains(applicationFailedStates, getApplicationInstanceJobState(*instance))
+		},
+		PollInterval: applicationApplyPollInterval,
+		Timeout:      timeout,
+	})
+
+	switch err {
+	case nil:
+		return instance, nil
+	case wait.ErrFailed:
+		return instance, fmt.Errorf(`job of instance "%s" of application "%s" is in state %q`, instance.Metadata.GetName(), application, getApplicationInstanceJobState(*instance))
+	case wait.ErrTimeout:
+		return instance, errors.NewErrorWithSuggestions(
+			fmt.Sprintf(`timed out waiting for a new instance of application "%s" to be running`, application),
+			"Increase `--timeout`, or list the events of the application with `confluent flink application event list`.",
+		)
+	default:
+		return instance, err
+	}
+}
+
+// getNewApplicationInstance returns the most recently created instance which is not one of the previous instances.
+func getNewApplicationInstance(instances []cmfsdk.FlinkApplicationInstance, previousInstances []string) *cmfsdk.FlinkApplicationInstance {
+	var newest *cmfsdk.FlinkApplicationInstance
+	for i, instance := range instances {
+		if slices.Contains(previousInstances, instance.Metadata.GetName()) {
+			continue
+		}
+		if newest == nil || instance.Metadata.GetCreationTimestamp() > newest.Metadata.GetCreationTimestamp() {
+			newest = &instances[i]
+		}
+	}
+	return newest
+}
+
+func getApplicationInstanceJobState(instance cmfsdk.FlinkApplicationInstance) string {
+	if instance.Status == nil || instance.Status.JobStatus == nil || instance.Status.JobStatus.State == nil {
+		return ""
+	}
+	return *instance.Status.JobStatus.State
+}
+
+// diffApplicationSpec returns the fields of the desired application specification which differ from the current
+// specification, sorted by field. Fields which are only in the current specification, such as defaults filled in by CMF,
+// are ignored. Nested objects are compared field by field, while lists are compared as a whole.
+func diffApplicationSpec(current, desired map[string]any) []applicationSpecChange {
+	var changes []applicationSpecChange
+	diffApplicationValue("spec", current, desired, &changes)
+	slices.SortFunc(changes, func(a, b applicationSpecChange) int { return strings.Compare(a.Field, b.Field) })
+	return changes
+}
+
+func diffApplicationValue(field string, current, desired any, changes *[]applicationSpecChange) {
+	currentMap, currentIsMap := current.(map[string]any)
+	desiredMap, desiredIsMap := desired.(map[string]any)
+	if currentIsMap && desiredIsMap {
+		for key, value := range desiredMap {
+			diffApplicationValue(field+"."+key, currentMap[key], value, changes)
+		}
+		return
+	}
+
+	if reflect.DeepEqual(current, desired) {
+		return
+	}
+	*changes = append(*changes, applicationSpecChange{
+		Field:   field,
+		Current: formatApplicationValue(current),
+		Desired: formatApplicationValue(desired),
+	})
+}
+
+func formatApplicationValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(b)
+}
+
+func printApplicationApply(cmd *cobra.Command, out *applicationApplyOut) error {
+	if output.GetFormat(cmd) == output.Human {
+		table := output.NewTable(cmd)
+		table.Add(&applicationApplyHumanOut{
+			Name:        out.Name,
+			Environment: out.Environment,
+			Action:      out.Action,
+			Savepoint:   out.Savepoint,
+			Instance:    out.Instance,
+			JobState:    out.JobState,
+			RolledBack:  out.RolledBack,
+		})
+		return table.Print()
+	}
+
+	return output.SerializedOutput(cmd, out)
+}
@@ -0,0 +1,93 @@
+package flink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cmfsdk "github.com/confluentinc/cmf-sdk-go/v1"
+)
+
+func TestDiffApplicationSpec(t *testing.T) {
+	current := map[string]any{
+		"image": "confluentinc/cp-flink:1.19.1-cp2",
+		"job": map[string]any{
+			"jarURI":      "local:///opt/flink/examples/streaming/StateMachineExample.jar",
+			"parallelism": float64(3),
+			"upgradeMode": "stateless",
+		},
+		"flinkConfiguration": map[string]any{
+			"taskmanager.numberOfTaskSlots": "2",
+		},
+		"args": []any{"--input", "orders"},
+	}
+	desired := map[string]any{
+		"image": "confluentinc/cp-flink:1.19.1-cp2",
+		"job": map[string]any{
+			"jarURI":      "local:///opt/flink/examples/streaming/StateMachineExample.jar",
+			"parallelism": float64(4),
+		},
+		"flinkConfiguration": map[string]any{
+			"taskmanager.numberOfTaskSlots": "2",
+			"state.backend.type":            "rocksdb",
+		},
+		"args": []any{"--input", "payments"},
+	}
+
+	require.Equal(t, []applicationSpecChange{
+		{Field: "spec.args", Current: `["--input","orders"]`, Desired: `["--input","payments"]`},
+		{Field: "spec.flinkConfiguration.state.backend.type", Current: "", Desired: "rocksdb"},
+		{Field: "spec.job.parallelism", Current: "3", Desired: "4"},
+	}, diffApplicationSpec(current, desired))
+
+	require.Empty(t, diffApplicationSpec(current, current))
+}
+
+func TestGetRollbackApplicationSpec(t *testing.T) {
+	previous := map[string]any{
+		"image": "confluentinc/cp-flink:1.19.1-cp2",
+		"job": map[string]any{
+			"jarURI":      "local:///opt/flink/examples/streaming/StateMachineExample.jar",
+			"upgradeMode": "savepoint",
+		},
+	}
+
+	spec, err := getRollbackApplicationSpec(previous, "s3://savepoints/savepoint-1")
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"image": "confluentinc/cp-flink:1.19.1-cp2",
+		"job": map[string]any{
+			"jarURI":                 "local:///opt/flink/examples/streaming/StateMachineExample.jar",
+			"upgradeMode":            "savepoint",
+			"initialSavepointPath":   "s3://savepoints/savepoint-1",
+			"savepointRedeployNonce": float64(1),
+		},
+	}, spec)
+
+	// The previous specification is left unchanged
+	require.NotContains(t, previous["job"], "initialSavepointPath")
+
+	spec, err = getRollbackApplicationSpec(spec, "s3://savepoints/savepoint-2")
+	require.NoError(t, err)
+	require.Equal(t, float64(2), spec["job"].(map[string]any)["savepointRedeployNonce"])
+}
+
+func TestGetNewApplicationInstance(t *testing.T) {
+	newInstance := func(name, creationTimestamp string) cmfsdk.FlinkApplicationInstance {
+		return cmfsdk.FlinkApplicationInstance{
+			Metadata: &cmfsdk.ApplicationInstanceMetadata{Name: &name, CreationTimestamp: &creationTimestamp},
+		}
+	}
+
+	instances := []cmfsdk.FlinkApplicationInstance{
+		newInstance("inst-001", "2025-09-17T08:30:00Z"),
+		newInstance("inst-003", "2025-09-19T10:00:00Z"),
+		newInstance("inst-002", "2025-09-18T10:00:00Z"),
+	}
+
+	require.Nil(t, getNewApplicationInstance(instances, []string{"inst-001", "inst-002", "inst-003"}))
+
+	instance := getNewApplicationInstance(instances, []string{"inst-001"})
+	require.NotNil(t, instance)
+	require.Equal(t, "inst-003", instance.Metadata.GetName())
+}
@@ -49,6 +49,7 @@ func newComputePoolCommand(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.
 		c.newListCommand(),
 		c.newUnsetCommand(),
 		c.newUpdateCommand(),
+		c.newUsageCommand(),
 		c.newUseCommand(),
 	)
 
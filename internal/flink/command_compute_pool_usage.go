@@ -0,0 +1,380 @@
+package flink
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	metricsv2 "github.com/confluentinc/ccloud-sdk-go-v2/metrics/v2"
+
+	"github.com/confluentinc/cli/v4/pkg/ccloudv2"
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+const (
+	flinkComputePoolCfuMetric = "io.confluent.flink/compute_pool_utilization/current_cfus"
+	flinkStatementCfuMetric   = "io.confluent.flink/statement_utilization/current_cfus"
+	flinkStatementNameLabel   = "resource.flink_statement.name"
+
+	// The suggested maximum leaves this much headroom above the peak usage
+	computePoolUsageHeadroom = 1.2
+	// A compute pool is saturated when its usage reached its maximum in at least this share of the intervals
+	computePoolSaturatedPercent = 5
+
+	computePoolUsageIncrease = "increase"
+	computePoolUsageDecrease = "decrease"
+	computePoolUsageKeep     = "keep"
+)
+
+var computePoolMaxCfuValues = []int32{5, 10, 20, 30, 40, 50}
+
+type computePoolUsageOut struct {
+	Id               string                      `json:"id" yaml:"id"`
+	Environment      string                      `json:"environment" yaml:"environment"`
+	Window           string                      `json:"window" yaml:"window"`
+	MaxCfu           int32                       `json:"max_cfu" yaml:"max_cfu"`
+	CurrentCfu       int32                       `json:"current_cfu" yaml:"current_cfu"`
+	PeakCfu          float64                     `json:"peak_cfu" yaml:"peak_cfu"`
+	AverageCfu       float64                     `json:"average_cfu" yaml:"average_cfu"`
+	P95Cfu           float64                     `json:"p95_cfu" yaml:"p95_cfu"`
+	CfuHours         float64                     `json:"cfu_hours" yaml:"cfu_hours"`
+	SaturatedPercent float64                     `json:"saturated_percent" yaml:"saturated_percent"`
+	SuggestedMaxCfu  int32                       `json:"suggested_max_cfu" yaml:"suggested_max_cfu"`
+	Recommendation   string                      `json:"recommendation" yaml:"recommendation"`
+	Statements       []computePoolStatementUsage `json:"statements" yaml:"statements"`
+}
+
+type computePoolUsageHumanOut struct {
+	Id               string  `human:"ID"`
+	Environment      string  `human:"Environment"`
+	Window           string  `human:"Window"`
+	MaxCfu           int32   `human:"Max CFU"`
+	CurrentCfu       int32   `human:"Current CFU"`
+	PeakCfu          float64 `human:"Peak CFU"`
+	AverageCfu       float64 `human:"Average CFU"`
+	P95Cfu           float64 `human:"P95 CFU"`
+	CfuHours         float64 `human:"CFU Hours"`
+	SaturatedPercent float64 `human:"Time at Max CFU (%)"`
+	SuggestedMaxCfu  int32   `human:"Suggested Max CFU"`
+	Recommendation   string  `human:"Recommendation"`
+}
+
+type computePoolStatementUsage struct {
+	Name       string  `human:"Statement" json:"name" yaml:"name"`
+	AverageCfu float64 `human:"Average CFU" json:"average_cfu" yaml:"average_cfu"`
+	PeakCfu    float64 `human:"Peak CFU" json:"peak_cfu" yaml:"peak_cfu"`
+	CfuHours   float64 `human:"CFU Hours" json:"cfu_hours" yaml:"cfu_hours"`
+	Share      float64 `human:"Share (%)" json:"share_percent" yaml:"share_percent"`
+}
+
+func (c *computePoolCommand) newUsageCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage [id]",
+		Short: "Report the CFU usage of a Flink compute pool.",
+		Long: "Report the CFU usage of a Flink compute pool over a window of time, the statements which consume the most CFUs, and a suggested maximum number of CFUs.\n\n" +
+			"Usage is averaged over intervals of a minute for windows of up to 6 hours, 15 minutes for windows of up to 2 days, and an hour for longer windows. " +
+			"The suggested maximum leaves 20% of headroom above the peak usage. " +
+			"When the usage reached the maximum in at least 5% of the intervals, the demand beyond the maximum is not measured, so the next larger maximum is suggested.",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validArgs),
+		RunE:              c.usage,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Report the CFU usage of Flink compute pool "lfcp-123456" over the last 7 days.`,
+				Code: "confluent flink compute-pool usage lfcp-123456 --window 7d",
+			},
+			examples.Example{
+				Text: "Report the CFU usage of the current Flink compute pool over the last day as JSON, listing every statement.",
+				Code: "confluent flink compute-pool usage --window 24h --top 0 --output json",
+			},
+		),
+	}
+
+	cmd.Flags().String("window", "7d", `Window of time to report the usage over, such as "24h" or "7d".`)
+	cmd.Flags().Int("top", 5, "Number of statements with the highest CFU consumption to list, or 0 to list every statement.")
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *computePoolCommand) usage(cmd *cobra.Command, args []string) error {
+	id := c.Context.GetCurrentFlinkComputePool()
+	if len(args) > 0 {
+		id = args[0]
+	}
+	if id == "" {
+		return errors.NewErrorWithSuggestions(
+			"no Flink compute pool selected",
+			"Select a Flink compute pool with `confluent flink compute-pool use` or as an argument.",
+		)
+	}
+
+	windowFlag, err := cmd.Flags().GetString("window")
+	if err != nil {
+		return err
+	}
+	window, err := parseComputePoolUsageWindow(windowFlag)
+	if err != nil {
+		return err
+	}
+
+	top, err := cmd.Flags().GetInt("top")
+	if err != nil {
+		return err
+	}
+	if top < 0 {
+		return fmt.Errorf("--top must not be negative")
+	}
+
+	environmentId, err := c.Context.EnvironmentId()
+	if err != nil {
+		return err
+	}
+
+	computePool, httpResp, err := c.V2Client.GetFlinkComputePool(id, environmentId)
+	if err != nil {
+		return errors.NewErrorWithSuggestions(
+			errors.CatchCCloudV2Error(err, httpResp).Error(),
+			"List available Flink compute pools with `confluent flink compute-pool list`.\nMake sure you have selected the Flink compute pool's environment with `confluent environment use`.",
+		)
+	}
+
+	metricsClient, err := c.GetMetricsClient()
+	if err != nil {
+		return err
+	}
+
+	granularity, granularityDuration := getComputePoolUsageGranularity(window)
+	interval := fmt.Sprintf("PT%dM/now", int(window.Minutes()))
+	poolPoints, err := getComputePoolMetric(metricsClient, flinkComputePoolCfuMetric, id, granularity, interval, nil)
+	if err != nil {
+		return err
+	}
+	statementPoints, err := getComputePoolMetric(metricsClient, flinkStatementCfuMetric, id, granularity, interval, []string{flinkStatementNameLabel})
+	if err != nil {
+		return err
+	}
+
+	usage := analyzeComputePoolUsage(poolPoints, statementPoints, computePool.Spec.GetMaxCfu(), granularityDuration, top)
+	usage.Id = computePool.GetId()
+	usage.Environment = computePool.Spec.Environment.GetId()
+	usage.Window = windowFlag
+	usage.CurrentCfu = computePool.Status.GetCurrentCfu()
+
+	if output.GetFormat(cmd).IsSerialized() {
+		return output.SerializedOutput(cmd, usage)
+	}
+
+	table := output.NewTable(cmd)
+	table.Add(&computePoolUsageHumanOut{
+		Id:               usage.Id,
+		Environment:      usage.Environment,
+		Window:           usage.Window,
+		MaxCfu:           usage.MaxCfu,
+		CurrentCfu:       usage.CurrentCfu,
+		PeakCfu:          usage.PeakCfu,
+		AverageCfu:       usage.AverageCfu,
+		P95Cfu:           usage.P95Cfu,
+		CfuHours:         usage.CfuHours,
+		SaturatedPercent: usage.SaturatedPercent,
+		SuggestedMaxCfu:  usage.SuggestedMaxCfu,
+		Recommendation:   usage.Recommendation,
+	})
+	if err := table.Print(); err != nil {
+		return err
+	}
+
+	if len(usage.Statements) == 0 {
+		return nil
+	}
+
+	output.Println(c.Config.EnableColor, "")
+	list := output.NewList(cmd)
+	list.Sort(false)
+	for _, statement := range usage.Statements {
+		list.Add(&statement)
+	}
+	return list.Print()
+}
+
+func parseComputePoolUsageWindow(window string) (time.Duration, error) {
+	var duration time.Duration
+	var err error
+	if days, ok := strings.CutSuffix(window, "d"); ok {
+		var n int
+		n, err = strconv.Atoi(days)
+		duration = time.Duration(n) * 24 * time.Hour
+	} else {
+		duration, err = time.ParseDuration(window)
+	}
+
+	if err != nil || duration < time.Minute {
+		return 0, errors.NewErrorWithSuggestions(fmt.Sprintf(`invalid window "%s"`, window), `Specify a number of days such as "7d", or a duration of at least a minute such as "12h".`)
+	}
+	return duration, nil
+}
+
+// getComputePoolUsageGranularity returns the interval which usage is averaged over for a window.
+func getComputePoolUsageGranularity(window time.Duration) (metricsv2.Granularity, time.Duration) {
+	switch {
+	case window <= 6*time.Hour:
+		return metricsv2.PT1_M, time.Minute
+	case window <= 48*time.Hour:
+		return metricsv2.PT15_M, 15 * time.Minute
+	default:
+		return metricsv2.PT1_H, time.Hour
+	}
+}
+
+func getComputePoolMetric(metricsClient *ccloudv2.MetricsClient, metricName, computePoolId string, granularity metricsv2.Granularity, interval string, groupBy []string) ([]metricsv2.Point, error) {
+	aggFunc := metricsv2.AggregationFunction("SUM")
+	aggregations := []metricsv2.Aggregation{{
+		Metric: metricName,
+		Agg:    *metricsv2.NewNullableAggregationFunction(&aggFunc),
+	}}
+
+	req := metricsv2.NewQueryRequest(aggregations, granularity, []string{interval})
+	req.SetFilter(metricsv2.Filter{
+		FieldFilter: &metricsv2.FieldFilter{
+			Field: metricsv2.PtrString("resource.compute_pool.id"),
+			Op:    "EQ",
+			Value: metricsv2.StringAsFieldFilterValue(metricsv2.PtrString(computePoolId)),
+		},
+	})
+	if len(groupBy) > 0 {
+		req.SetGroupBy(groupBy)
+		req.SetLimit(1000)
+	}
+
+	resp, httpResp, err := metricsClient.MetricsDatasetQuery("cloud", *req)
+	if err != nil && !ccloudv2.IsDataMatchesMoreThanOneSchemaError(err) || resp == nil {
+		return nil, fmt.Errorf(`failed to query metric "%s": %w`, metricName, err)
+	}
+	if err := ccloudv2.UnmarshalFlatQueryResponseIfDataSchemaMatchError(err, resp, httpResp); err != nil {
+		return nil, err
+	}
+
+	return resp.FlatQueryResponse.GetData(), nil
+}
+
+// analyzeComputePoolUsage summarizes the CFU usage of a compute pool, and of each of its statements, from one point
+// per interval. When the compute pool reports no usage, the usage of its statements is summed instead.
+func analyzeComputePoolUsage(poolPoints, statementPoints []metricsv2.Point, maxCfu int32, granularity time.Duration, top int) *computePoolUsageOut {
+	usage := &computePoolUsageOut{
+		MaxCfu:     maxCfu,
+		Statements: []computePoolStatementUsage{},
+	}
+
+	values := make([]float64, 0, len(poolPoints))
+	for _, point := range poolPoints {
+		values = append(values, float64(point.Value))
+	}
+	if len(values) == 0 {
+		totals := map[time.Time]float64{}
+		for _, point := range statementPoints {
+			totals[point.Timestamp] += float64(point.Value)
+		}
+		for _, total := range totals {
+			values = append(values, total)
+		}
+	}
+
+	if len(values) > 0 {
+		slices.Sort(values)
+
+		var sum float64
+		saturated := 0
+		for _, value := range values {
+			sum += value
+			if value >= float64(maxCfu) {
+				saturated++
+			}
+		}
+
+		usage.PeakCfu = roundCfu(values[len(values)-1])
+		usage.AverageCfu = roundCfu(sum / float64(len(values)))
+		usage.P95Cfu = roundCfu(values[int(math.Ceil(0.95*float64(len(values))))-1])
+		usage.CfuHours = roundCfu(sum * granularity.Hours())
+		usage.SaturatedPercent = roundCfu(100 * float64(saturated) / float64(len(values)))
+	}
+
+	usage.SuggestedMaxCfu = suggestComputePoolMaxCfu(usage.PeakCfu, usage.SaturatedPercent, maxCfu)
+	switch {
+	case usage.SuggestedMaxCfu > maxCfu:
+		usage.Recommendation = computePoolUsageIncrease
+	case usage.SuggestedMaxCfu < maxCfu:
+		usage.Recommendation = computePoolUsageDecrease
+	default:
+		usage.Recommendation = computePoolUsageKeep
+	}
+
+	type statementTotal struct {
+		sum   float64
+		count int
+		peak  float64
+	}
+	totals := map[string]*statementTotal{}
+	var sum float64
+	for _, point := range statementPoints {
+		name, _ := point.AdditionalProperties[flinkStatementNameLabel].(string)
+		if totals[name] == nil {
+			totals[name] = &statementTotal{}
+		}
+		totals[name].sum += float64(point.Value)
+		totals[name].count++
+		totals[name].peak = max(totals[name].peak, float64(point.Value))
+		sum += float64(point.Value)
+	}
+
+	for name, total := range totals {
+		statement := computePoolStatementUsage{
+			Name:       name,
+			AverageCfu: roundCfu(total.sum / float64(total.count)),
+			PeakCfu:    roundCfu(total.peak),
+			CfuHours:   roundCfu(total.sum * granularity.Hours()),
+		}
+		if sum > 0 {
+			statement.Share = roundCfu(100 * total.sum / sum)
+		}
+		usage.Statements = append(usage.Statements, statement)
+	}
+	slices.SortFunc(usage.Statements, func(a, b computePoolStatementUsage) int {
+		return cmp.Or(cmp.Compare(b.CfuHours, a.CfuHours), strings.Compare(a.Name, b.Name))
+	})
+	if top > 0 && len(usage.Statements) > top {
+		usage.Statements = usage.Statements[:top]
+	}
+
+	return usage
+}
+
+// suggestComputePoolMaxCfu returns the smallest valid maximum number of CFUs which leaves headroom above the peak
+// usage. A saturated compute pool may have been limited by its maximum, so a larger maximum is always suggested.
+func suggestComputePoolMaxCfu(peakCfu, saturatedPercent float64, maxCfu int32) int32 {
+	target := peakCfu * computePoolUsageHeadroom
+	if saturatedPercent >= computePoolSaturatedPercent {
+		target = max(target, float64(maxCfu)+1)
+	}
+
+	for _, value := range computePoolMaxCfuValues {
+		if float64(value) >= target {
+			return value
+		}
+	}
+	return computePoolMaxCfuValues[len(computePoolMaxCfuValues)-1]
+}
+
+func roundCfu(value float64) float64 {
+	return math.Round(value*100) / 100
+}
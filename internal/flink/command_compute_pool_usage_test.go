@@ -0,0 +1,76 @@
+package flink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	metricsv2 "github.com/confluentinc/ccloud-sdk-go-v2/metrics/v2"
+)
+
+func TestParseComputePoolUsageWindow(t *testing.T) {
+	window, err := parseComputePoolUsageWindow("7d")
+	require.NoError(t, err)
+	require.Equal(t, 7*24*time.Hour, window)
+
+	window, err = parseComputePoolUsageWindow("12h")
+	require.NoError(t, err)
+	require.Equal(t, 12*time.Hour, window)
+
+	for _, invalid := range []string{"0d", "-1d", "30s", "week"} {
+		_, err := parseComputePoolUsageWindow(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestSuggestComputePoolMaxCfu(t *testing.T) {
+	require.Equal(t, int32(5), suggestComputePoolMaxCfu(0, 0, 20))
+	require.Equal(t, int32(10), suggestComputePoolMaxCfu(7, 0, 20))
+	require.Equal(t, int32(20), suggestComputePoolMaxCfu(8.5, 0, 20))
+	require.Equal(t, int32(30), suggestComputePoolMaxCfu(20, 10, 20))
+	require.Equal(t, int32(50), suggestComputePoolMaxCfu(50, 50, 50))
+}
+
+func TestAnalyzeComputePoolUsage(t *testing.T) {
+	start := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	statementPoint := func(hour int, name string, value float32) metricsv2.Point {
+		return metricsv2.Point{
+			Timestamp:            start.Add(time.Duration(hour) * time.Hour),
+			Value:                value,
+			AdditionalProperties: map[string]any{flinkStatementNameLabel: name},
+		}
+	}
+
+	statementPoints := []metricsv2.Point{
+		statementPoint(0, "orders", 4),
+		statementPoint(1, "orders", 6),
+		statementPoint(2, "orders", 8),
+		statementPoint(0, "payments", 1),
+		statementPoint(1, "payments", 1),
+		statementPoint(2, "payments", 2),
+		statementPoint(2, "audit", 1),
+	}
+
+	usage := analyzeComputePoolUsage(nil, statementPoints, 10, time.Hour, 2)
+	require.Equal(t, int32(10), usage.MaxCfu)
+	require.Equal(t, 11.0, usage.PeakCfu)
+	require.Equal(t, 7.67, usage.AverageCfu)
+	require.Equal(t, 11.0, usage.P95Cfu)
+	require.Equal(t, 23.0, usage.CfuHours)
+	require.Equal(t, 33.33, usage.SaturatedPercent)
+	require.Equal(t, int32(20), usage.SuggestedMaxCfu)
+	require.Equal(t, computePoolUsageIncrease, usage.Recommendation)
+	require.Equal(t, []computePoolStatementUsage{
+		{Name: "orders", AverageCfu: 6, PeakCfu: 8, CfuHours: 18, Share: 78.26},
+		{Name: "payments", AverageCfu: 1.33, PeakCfu: 2, CfuHours: 4, Share: 17.39},
+	}, usage.Statements)
+
+	poolPoints := []metricsv2.Point{{Timestamp: start, Value: 2}, {Timestamp: start.Add(time.Hour), Value: 3}}
+	usage = analyzeComputePoolUsage(poolPoints, nil, 30, time.Hour, 5)
+	require.Equal(t, 3.0, usage.PeakCfu)
+	require.Equal(t, 2.5, usage.AverageCfu)
+	require.Equal(t, int32(5), usage.SuggestedMaxCfu)
+	require.Equal(t, computePoolUsageDecrease, usage.Recommendation)
+	require.Empty(t, usage.Statements)
+}
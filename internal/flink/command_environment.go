@@ -30,6 +30,8 @@ func (c *command) newEnvironmentCommand() *cobra.Command {
 	cmd.AddCommand(c.newEnvironmentCreateCommand())
 	cmd.AddCommand(c.newEnvironmentDeleteCommand())
 	cmd.AddCommand(c.newEnvironmentDescribeCommand())
+	cmd.AddCommand(c.newEnvironmentExportCommand())
+	cmd.AddCommand(c.newEnvironmentImportCommand())
 	cmd.AddCommand(c.newEnvironmentListCommand())
 	cmd.AddCommand(c.newEnvironmentUpdateCommand())
 
@@ -0,0 +1,399 @@
+package flink
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	cmfsdk "github.com/confluentinc/cmf-sdk-go/v1"
+
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/flink"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+const (
+	environmentBundleKind = "FlinkEnvironmentBundle"
+	redactedSecretValue   = "<redacted>"
+)
+
+var secretEnvVarReplacer = regexp.MustCompile("[^A-Z0-9]+")
+
+// sensitiveFlinkConfigurationKeys are the parts of Flink configuration keys whose values Flink itself hides.
+var sensitiveFlinkConfigurationKeys = []string{"password", "secret", "fs.azure.account.key", "apikey", "api-key", "auth-params", "service-key", "token", "basic-auth", "jaas.config", "http-headers"}
+
+// environmentBundle holds a Flink environment and every resource needed to recreate it in another deployment of
+// Confluent Manager for Apache Flink. Server-managed fields, such as creation timestamps and statuses, are omitted.
+type environmentBundle struct {
+	Kind           string                     `json:"kind" yaml:"kind"`
+	Environment    LocalEnvironment           `json:"environment" yaml:"environment"`
+	Secrets        []LocalSecret              `json:"secrets,omitempty" yaml:"secrets,omitempty"`
+	Catalogs       []environmentBundleCatalog `json:"catalogs,omitempty" yaml:"catalogs,omitempty"`
+	SecretMappings []LocalSecretMapping       `json:"secretMappings,omitempty" yaml:"secretMappings,omitempty"`
+	ComputePools   []LocalComputePool         `json:"computePools,omitempty" yaml:"computePools,omitempty"`
+	Applications   []LocalFlinkApplication    `json:"applications,omitempty" yaml:"applications,omitempty"`
+}
+
+type environmentBundleCatalog struct {
+	Catalog   LocalKafkaCatalog    `json:"catalog" yaml:"catalog"`
+	Databases []LocalKafkaDatabase `json:"databases,omitempty" yaml:"databases,omitempty"`
+}
+
+func (c *command) newEnvironmentExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export <name>",
+		Short: "Export a Flink environment and its resources to a bundle.",
+		Long: "Export a Flink environment to a YAML or JSON bundle, which can be imported with `confluent flink environment import`.\n\n" +
+			"The bundle contains the environment with its secret mappings, compute pools, and applications, along with the catalogs and databases, and the secrets referenced by the secret mappings. " +
+			`Secret values, and the values of sensitive keys in the Flink configuration of the environment defaults and applications, are replaced with "<redacted>" unless --include-secret-values is set.`,
+		Args: cobra.ExactArgs(1),
+		RunE: c.environmentExport,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Export environment "prod" to "bundle.yaml".`,
+				Code: "confluent flink environment export prod --file bundle.yaml",
+			},
+		),
+	}
+
+	cmd.Flags().String("file", "", "Path to write the bundle to (with .yml, .yaml or .json extension). Defaults to printing YAML to standard output.")
+	cmd.Flags().Bool("include-secret-values", false, "Include the values of secrets and sensitive Flink configuration keys in the bundle instead of redacting them.")
+	addPageSizeFlag(cmd)
+	addCmfFlagSet(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "yaml", "yml", "json"))
+
+	return cmd
+}
+
+func (c *command) environmentExport(cmd *cobra.Command, args []string) error {
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	includeSecretValues, err := cmd.Flags().GetBool("include-secret-values")
+	if err != nil {
+		return err
+	}
+
+	pageSize, err := getPageSize(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.GetCmfClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	bundle, err := c.exportEnvironmentBundle(client, args[0], pageSize)
+	if err != nil {
+		return err
+	}
+
+	if !includeSecretValues {
+		redactEnvironmentBundleSecrets(bundle)
+	}
+
+	if file == "" {
+		data, err := yaml.Marshal(bundle)
+		if err != nil {
+			return err
+		}
+		output.Print(false, string(data))
+		return nil
+	}
+
+	if err := writeEnvironmentBundle(file, bundle); err != nil {
+		return err
+	}
+
+	output.Printf(c.Config.EnableColor, "Exported environment \"%s\" to \"%s\".\n", args[0], file)
+	return nil
+}
+
+func (c *command) exportEnvironmentBundle(client *flink.CmfRestClient, environment string, pageSize int32) (*environmentBundle, error) {
+	sdkEnvironment, err := client.DescribeEnvironment(c.createContext(), environment)
+	if err != nil {
+		return nil, err
+	}
+
+	localEnvironment := convertSdkEnvironmentToLocalEnvironment(sdkEnvironment)
+	localEnvironment.Secrets = nil
+	localEnvironment.CreatedTime = nil
+	localEnvironment.UpdatedTime = nil
+
+	bundle := &environmentBundle{
+		Kind:        environmentBundleKind,
+		Environment: localEnvironment,
+	}
+
+	mappings, err := client.ListSecretMappings(c.createContext(), environment, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	var secretNames []string
+	for _, mapping := range mappings {
+		localMapping := convertSdkSecretMappingToLocalSecretMapping(mapping)
+		localMapping.Metadata = LocalSecretMappingMetadata{
+			Name:        localMapping.Metadata.Name,
+			Labels:      localMapping.Metadata.Labels,
+			Annotations: localMapping.Metadata.Annotations,
+		}
+		bundle.SecretMappings = append(bundle.SecretMappings, localMapping)
+		if !slices.Contains(secretNames, localMapping.Spec.SecretName) {
+			secretNames = append(secretNames, localMapping.Spec.SecretName)
+		}
+	}
+
+	for _, secretName := range secretNames {
+		secret, err := client.DescribeSecret(c.createContext(), secretName)
+		if err != nil {
+			return nil, err
+		}
+		localSecret := convertSdkSecretToLocalSecret(secret)
+		localSecret.Metadata = LocalSecretMetadata{
+			Name:        localSecret.Metadata.Name,
+			Labels:      localSecret.Metadata.Labels,
+			Annotations: localSecret.Metadata.Annotations,
+		}
+		localSecret.Status = nil
+		bundle.Secrets = append(bundle.Secrets, localSecret)
+	}
+
+	catalogs, err := client.ListCatalog(c.createContext(), pageSize)
+	if err != nil {
+		return nil, err
+	}
+	for _, catalog := range catalogs {
+		localCatalog := convertSdkCatalogToLocalCatalog(catalog)
+		localCatalog.Metadata = LocalCatalogMetadata{
+			Name:        localCatalog.Metadata.Name,
+			Labels:      localCatalog.Metadata.Labels,
+			Annotations: localCatalog.Metadata.Annotations,
+		}
+
+		databases, err := client.ListDatabases(c.createContext(), localCatalog.Metadata.Name, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		bundleCatalog := environmentBundleCatalog{Catalog: localCatalog}
+		for _, database := range databases {
+			localDatabase := convertSdkDatabaseToLocalDatabase(database)
+			localDatabase.Metadata = LocalDatabaseMetadata{
+				Name:        localDatabase.Metadata.Name,
+				Labels:      localDatabase.Metadata.Labels,
+				Annotations: localDatabase.Metadata.Annotations,
+			}
+			bundleCatalog.Databases = append(bundleCatalog.Databases, localDatabase)
+		}
+		bundle.Catalogs = append(bundle.Catalogs, bundleCatalog)
+	}
+
+	computePools, err := client.ListComputePools(c.createContext(), environment, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	for _, computePool := range computePools {
+		localComputePool := convertSdkComputePoolToLocalComputePool(computePool)
+		localComputePool.Metadata = LocalComputePoolMetadata{
+			Name:        localComputePool.Metadata.Name,
+			Labels:      localComputePool.Metadata.Labels,
+			Annotations: localComputePool.Metadata.Annotations,
+		}
+		localComputePool.Status = nil
+		bundle.ComputePools = append(bundle.ComputePools, localComputePool)
+	}
+
+	applications, err := client.ListApplications(c.createContext(), environment, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	for _, application := range applications {
+		localApplication := convertSdkApplicationToLocalApplication(application)
+		metadata := map[string]any{}
+		for _, key := range []string{"name", "labels", "annotations"} {
+			if value, ok := localApplication.Metadata[key]; ok {
+				metadata[key] = value
+			}
+		}
+		localApplication.Metadata = metadata
+		localApplication.Status = nil
+		bundle.Applications = append(bundle.Applications, localApplication)
+	}
+
+	return bundle, nil
+}
+
+// redactEnvironmentBundleSecrets replaces the values of every secret and of the sensitive Flink configuration keys in the
+// bundle, so that the bundle can be stored and shared. The values are injected from environment variables when the
+// bundle is imported.
+func redactEnvironmentBundleSecrets(bundle *environmentBundle) {
+	for _, secret := range bundle.Secrets {
+		if secret.Spec.Data == nil {
+			continue
+		}
+		for key := range *secret.Spec.Data {
+			(*secret.Spec.Data)[key] = redactedSecretValue
+		}
+	}
+
+	redactFlinkConfiguration := func(key, value string) string {
+		if isSensitiveFlinkConfigurationKey(key) {
+			return redactedSecretValue
+		}
+		return value
+	}
+	mapEnvironmentFlinkConfiguration(bundle.Environment, redactFlinkConfiguration)
+	for _, application := range bundle.Applications {
+		mapFlinkConfiguration(application.Spec, redactFlinkConfiguration)
+	}
+}
+
+func isSensitiveFlinkConfigurationKey(key string) bool {
+	key = strings.ToLower(key)
+	return slices.ContainsFunc(sensitiveFlinkConfigurationKeys, func(sensitive string) bool { return strings.Contains(key, sensitive) })
+}
+
+// mapEnvironmentFlinkConfiguration replaces every value of the Flink configuration of the environment defaults with the
+// value returned by fn.
+func mapEnvironmentFlinkConfiguration(environment LocalEnvironment, fn func(key, value string) string) {
+	if environment.FlinkApplicationDefaults != nil {
+		mapFlinkConfiguration(*environment.FlinkApplicationDefaults, fn)
+	}
+	if environment.ComputePoolDefaults != nil {
+		mapFlinkConfiguration(*environment.ComputePoolDefaults, fn)
+	}
+	if environment.StatementDefaults != nil {
+		for _, defaults := range []*LocalStatementDefaults{environment.StatementDefaults.Detached, environment.StatementDefaults.Interactive} {
+			if defaults == nil || defaults.FlinkConfiguration == nil {
+				continue
+			}
+			for key, value := range *defaults.FlinkConfiguration {
+				(*defaults.FlinkConfiguration)[key] = fn(key, value)
+			}
+		}
+	}
+}
+
+// mapFlinkConfiguration replaces every string value of the "flinkConfiguration" objects nested in a resource with the
+// value returned by fn.
+func mapFlinkConfiguration(resource any, fn func(key, value string) string) {
+	switch resource := resource.(type) {
+	case map[string]any:
+		for key, value := range resource {
+			if configuration, ok := value.(map[string]any); ok && key == "flinkConfiguration" {
+				for configurationKey, configurationValue := range configuration {
+					if str, ok := configurationValue.(string); ok {
+						configuration[configurationKey] = fn(configurationKey, str)
+					}
+				}
+				continue
+			}
+			mapFlinkConfiguration(value, fn)
+		}
+	case []any:
+		for _, value := range resource {
+			mapFlinkConfiguration(value, fn)
+		}
+	}
+}
+
+// getSecretValueEnvVar returns the name of the environment variable from which a redacted secret value is read on
+// import, such as "CONFLUENT_FLINK_SECRET_KAFKA_CREDENTIALS_SASL_JAAS_CONFIG" for the key "sasl.jaas.config" of
+// the secret "kafka-credentials".
+func getSecretValueEnvVar(secret, key string) string {
+	return getRedactedValueEnvVar("CONFLUENT_FLINK_SECRET_", secret, key)
+}
+
+// getFlinkConfigurationValueEnvVar returns the name of the environment variable from which a redacted Flink
+// configuration value of an application or environment is read on import, such as
+// "CONFLUENT_FLINK_CONFIG_ORDERS_APP_S3_SECRET_KEY" for the key "s3.secret-key" of the application "orders-app".
+func getFlinkConfigurationValueEnvVar(resource, key string) string {
+	return getRedactedValueEnvVar("CONFLUENT_FLINK_CONFIG_", resource, key)
+}
+
+func getRedactedValueEnvVar(prefix, resource, key string) string {
+	name := strings.ToUpper(fmt.Sprintf("%s_%s", resource, key))
+	return prefix + strings.Trim(secretEnvVarReplacer.ReplaceAllString(name, "_"), "_")
+}
+
+func readEnvironmentBundle(path string) (*environmentBundle, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	bundle := new(environmentBundle)
+	switch ext := filepath.Ext(path); ext {
+	case ".json":
+		err = json.Unmarshal(data, bundle)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, bundle)
+	default:
+		return nil, errors.NewErrorWithSuggestions(fmt.Sprintf("unsupported file format: %s", ext), "Supported file formats are .json, .yaml, and .yml.")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse input file: %w", err)
+	}
+
+	if bundle.Kind != environmentBundleKind {
+		return nil, errors.NewErrorWithSuggestions(
+			fmt.Sprintf(`"%s" is not a Flink environment bundle`, path),
+			"Create a bundle with `confluent flink environment export`.",
+		)
+	}
+	if bundle.Environment.Name == "" {
+		return nil, fmt.Errorf(`bundle "%s" has no environment name`, path)
+	}
+
+	return bundle, nil
+}
+
+func writeEnvironmentBundle(path string, bundle *environmentBundle) error {
+	var data []byte
+	var err error
+	switch ext := filepath.Ext(path); ext {
+	case ".json":
+		data, err = json.MarshalIndent(bundle, "", "  ")
+	case ".yaml", ".yml":
+		data, err = yaml.Marshal(bundle)
+	default:
+		return errors.NewErrorWithSuggestions(fmt.Sprintf("unsupported file format: %s", ext), "Supported file formats are .json, .yaml, and .yml.")
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0600)
+}
+
+// convertLocalToSdk binds a resource from a bundle to its SDK model, in the same way that resource files are read.
+func convertLocalToSdk[T any](local any, label string) (T, error) {
+	var sdkResource T
+
+	jsonBytes, err := json.Marshal(local)
+	if err != nil {
+		return sdkResource, fmt.Errorf("failed to marshal intermediate data: %w", err)
+	}
+
+	if err := json.Unmarshal(jsonBytes, &sdkResource); err != nil {
+		return sdkResource, fmt.Errorf("failed to bind data to %s model: %w", label, err)
+	}
+
+	return sdkResource, nil
+}
+
+func getApplicationName(application cmfsdk.FlinkApplication) string {
+	name, _ := application.Metadata["name"].(string)
+	return name
+}
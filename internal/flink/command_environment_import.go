@@ -0,0 +1,414 @@
+package flink
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	cmfsdk "github.com/confluentinc/cmf-sdk-go/v1"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/flink"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+const (
+	environmentImportActionCreate = "create"
+	environmentImportActionSkip   = "skip"
+)
+
+type environmentImportOut struct {
+	Kind   string `human:"Kind" serialized:"kind"`
+	Name   string `human:"Name" serialized:"name"`
+	Action string `human:"Action" serialized:"action"`
+	Reason string `human:"Reason,omitempty" serialized:"reason,omitempty"`
+}
+
+// environmentImportStep is a single resource of a bundle, in the order in which it must be created.
+type environmentImportStep struct {
+	kind     string
+	name     string
+	action   string
+	reason   string
+	catalog  string
+	resource any
+}
+
+// environmentImportExisting records the resources which already exist in the target deployment.
+type environmentImportExisting struct {
+	environment    bool
+	secrets        []string
+	catalogs       []string
+	databases      map[string][]string
+	secretMappings []string
+	computePools   []string
+	applications   []string
+}
+
+func (c *command) newEnvironmentImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import a Flink environment and its resources from a bundle.",
+		Long: "Import a Flink environment from a bundle created with `confluent flink environment export`, creating its secrets, catalogs, databases, environment, secret mappings, compute pools, and applications in that order.\n\n" +
+			"Resources which already exist are skipped, so an interrupted import can be run again. " +
+			`Redacted secret values are read from environment variables named "CONFLUENT_FLINK_SECRET_<SECRET>_<KEY>", and redacted Flink configuration values from environment variables named "CONFLUENT_FLINK_CONFIG_<RESOURCE>_<KEY>", where the resource is the application or the imported environment. ` +
+			`The names are in upper case with every other character replaced by "_". ` +
+			"A dry run reports the missing environment variables instead of failing.",
+		Args: cobra.ExactArgs(1),
+		RunE: c.environmentImport,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Preview the import of "bundle.yaml" as environment "staging".`,
+				Code: "confluent flink environment import bundle.yaml --target-env staging --dry-run",
+			},
+			examples.Example{
+				Text: `Import "bundle.yaml" as environment "staging", injecting the value of key "sasl.jaas.config" of secret "kafka-credentials".`,
+				Code: `CONFLUENT_FLINK_SECRET_KAFKA_CREDENTIALS_SASL_JAAS_CONFIG="..." confluent flink environment import bundle.yaml --target-env staging`,
+			},
+		),
+	}
+
+	cmd.Flags().String("target-env", "", "Name of the Flink environment to create. Defaults to the name of the exported environment.")
+	cmd.Flags().String("kubernetes-namespace", "", "Kubernetes namespace of the Flink environment to create. Defaults to the namespace of the exported environment.")
+	pcmd.AddDryRunFlag(cmd)
+	addPageSizeFlag(cmd)
+	addCmfFlagSet(cmd)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) environmentImport(cmd *cobra.Command, args []string) error {
+	bundle, err := readEnvironmentBundle(args[0])
+	if err != nil {
+		return err
+	}
+
+	targetEnvironment, err := cmd.Flags().GetString("target-env")
+	if err != nil {
+		return err
+	}
+	if targetEnvironment == "" {
+		targetEnvironment = bundle.Environment.Name
+	}
+
+	kubernetesNamespace, err := cmd.Flags().GetString("kubernetes-namespace")
+	if err != nil {
+		return err
+	}
+	if kubernetesNamespace != "" {
+		bundle.Environment.KubernetesNamespace = kubernetesNamespace
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	pageSize, err := getPageSize(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.GetCmfClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.getEnvironmentImportExisting(client, bundle, targetEnvironment, pageSize)
+	if err != nil {
+		return err
+	}
+
+	steps := planEnvironmentImport(bundle, targetEnvironment, existing)
+
+	if err := injectEnvironmentBundleSecrets(steps, os.LookupEnv); err != nil && !dryRun {
+		return err
+	}
+
+	if !dryRun {
+		for _, step := range steps {
+			if step.action != environmentImportActionCreate {
+				continue
+			}
+			if err := c.createEnvironmentImportStep(client, targetEnvironment, step); err != nil {
+				return fmt.Errorf(`failed to create %s "%s": %w`, step.kind, step.name, err)
+			}
+		}
+	}
+
+	list := output.NewList(cmd)
+	list.Sort(false)
+	for _, step := range steps {
+		list.Add(&environmentImportOut{
+			Kind:   step.kind,
+			Name:   step.name,
+			Action: step.action,
+			Reason: step.reason,
+		})
+	}
+	return list.Print()
+}
+
+func (c *command) getEnvironmentImportExisting(client *flink.CmfRestClient, bundle *environmentBundle, environment string, pageSize int32) (environmentImportExisting, error) {
+	existing := environmentImportExisting{databases: map[string][]string{}}
+
+	environments, err := client.ListEnvironments(c.createContext(), pageSize)
+	if err != nil {
+		return existing, err
+	}
+	existing.environment = slices.ContainsFunc(environments, func(sdkEnvironment cmfsdk.Environment) bool { return sdkEnvironment.Name == environment })
+
+	secrets, err := client.ListSecrets(c.createContext(), pageSize)
+	if err != nil {
+		return existing, err
+	}
+	for _, secret := range secrets {
+		existing.secrets = append(existing.secrets, secret.Metadata.Name)
+	}
+
+	catalogs, err := client.ListCatalog(c.createContext(), pageSize)
+	if err != nil {
+		return existing, err
+	}
+	for _, catalog := range catalogs {
+		existing.catalogs = append(existing.catalogs, catalog.Metadata.Name)
+	}
+
+	for _, bundleCatalog := range bundle.Catalogs {
+		name := bundleCatalog.Catalog.Metadata.Name
+		if !slices.Contains(existing.catalogs, name) {
+			continue
+		}
+		databases, err := client.ListDatabases(c.createContext(), name, pageSize)
+		if err != nil {
+			return existing, err
+		}
+		for _, database := range databases {
+			existing.databases[name] = append(existing.databases[name], database.Metadata.Name)
+		}
+	}
+
+	if !existing.environment {
+		return existing, nil
+	}
+
+	secretMappings, err := client.ListSecretMappings(c.createContext(), environment, pageSize)
+	if err != nil {
+		return existing, err
+	}
+	for _, secretMapping := range secretMappings {
+		existing.secretMappings = append(existing.secretMappings, secretMapping.Metadata.GetName())
+	}
+
+	computePools, err := client.ListComputePools(c.createContext(), environment, pageSize)
+	if err != nil {
+		return existing, err
+	}
+	for _, computePool := range computePools {
+		existing.computePools = append(existing.computePools, computePool.Metadata.Name)
+	}
+
+	applications, err := client.ListApplications(c.createContext(), environment, pageSize)
+	if err != nil {
+		return existing, err
+	}
+	for _, application := range applications {
+		existing.applications = append(existing.applications, getApplicationName(application))
+	}
+
+	return existing, nil
+}
+
+// planEnvironmentImport orders the resources of a bundle so that every resource is created after the resources it
+// references: secrets, then catalogs and their databases, the environment, its secret mappings, compute pools, and
+// finally applications. Resources which already exist are skipped.
+func planEnvironmentImport(bundle *environmentBundle, environment string, existing environmentImportExisting) []environmentImportStep {
+	var steps []environmentImportStep
+	addStep := func(step environmentImportStep, exists bool) {
+		step.action = environmentImportActionCreate
+		if exists {
+			step.action = environmentImportActionSkip
+			step.reason = "already exists"
+		}
+		steps = append(steps, step)
+	}
+
+	for _, secret := range bundle.Secrets {
+		addStep(environmentImportStep{kind: "secret", name: secret.Metadata.Name, resource: secret}, slices.Contains(existing.secrets, secret.Metadata.Name))
+	}
+
+	for _, bundleCatalog := range bundle.Catalogs {
+		catalog := bundleCatalog.Catalog.Metadata.Name
+		addStep(environmentImportStep{kind: "catalog", name: catalog, resource: bundleCatalog.Catalog}, slices.Contains(existing.catalogs, catalog))
+
+		for _, database := range bundleCatalog.Databases {
+			// Databases which allowed DDL statements from the exported environment allow them from the target environment.
+			if database.Spec.DdlEnvironments != nil {
+				ddlEnvironments := slices.Clone(*database.Spec.DdlEnvironments)
+				for i, ddlEnvironment := range ddlEnvironments {
+					if ddlEnvironment == bundle.Environment.Name {
+						ddlEnvironments[i] = environment
+					}
+				}
+				database.Spec.DdlEnvironments = &ddlEnvironments
+			}
+			step := environmentImportStep{kind: "database", name: fmt.Sprintf("%s.%s", catalog, database.Metadata.Name), catalog: catalog, resource: database}
+			addStep(step, slices.Contains(existing.databases[catalog], database.Metadata.Name))
+		}
+	}
+
+	addStep(environmentImportStep{kind: "environment", name: environment, resource: bundle.Environment}, existing.environment)
+
+	for _, secretMapping := range bundle.SecretMappings {
+		addStep(environmentImportStep{kind: "secret mapping", name: secretMapping.Metadata.Name, resource: secretMapping}, slices.Contains(existing.secretMappings, secretMapping.Metadata.Name))
+	}
+
+	for _, computePool := range bundle.ComputePools {
+		addStep(environmentImportStep{kind: "compute pool", name: computePool.Metadata.Name, resource: computePool}, slices.Contains(existing.computePools, computePool.Metadata.Name))
+	}
+
+	for _, application := range bundle.Applications {
+		name, _ := application.Metadata["name"].(string)
+		addStep(environmentImportStep{kind: "application", name: name, resource: application}, slices.Contains(existing.applications, name))
+	}
+
+	return steps
+}
+
+// injectEnvironmentBundleSecrets replaces the redacted values of the secrets, environment, and applications to be created
+// with the values of their environment variables. The missing environment variables of each resource are set as the
+// reason of its step, and all of them are reported before any resource is created.
+func injectEnvironmentBundleSecrets(steps []environmentImportStep, lookupEnv func(string) (string, bool)) error {
+	var missing []string
+	for i, step := range steps {
+		if step.action != environmentImportActionCreate {
+			continue
+		}
+
+		var stepMissing []string
+		inject := func(envVar, value string) string {
+			if value != redactedSecretValue {
+				return value
+			}
+			if injected, ok := lookupEnv(envVar); ok {
+				return injected
+			}
+			stepMissing = append(stepMissing, envVar)
+			return value
+		}
+
+		switch resource := step.resource.(type) {
+		case LocalSecret:
+			if resource.Spec.Data != nil {
+				for key, value := range *resource.Spec.Data {
+					(*resource.Spec.Data)[key] = inject(getSecretValueEnvVar(resource.Metadata.Name, key), value)
+				}
+			}
+		case LocalEnvironment:
+			mapEnvironmentFlinkConfiguration(resource, func(key, value string) string {
+				return inject(getFlinkConfigurationValueEnvVar(step.name, key), value)
+			})
+		case LocalFlinkApplication:
+			mapFlinkConfiguration(resource.Spec, func(key, value string) string {
+				return inject(getFlinkConfigurationValueEnvVar(step.name, key), value)
+			})
+		}
+
+		if len(stepMissing) > 0 {
+			slices.Sort(stepMissing)
+			stepMissing = slices.Compact(stepMissing)
+			steps[i].reason = fmt.Sprintf("missing %s", strings.Join(stepMissing, ", "))
+			missing = append(missing, stepMissing...)
+		}
+	}
+
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		return errors.NewErrorWithSuggestions(
+			"the bundle contains redacted secret values",
+			fmt.Sprintf("Set the following environment variables to the redacted values: %s.", strings.Join(missing, ", ")),
+		)
+	}
+
+	return nil
+}
+
+func (c *command) createEnvironmentImportStep(client *flink.CmfRestClient, environment string, step environmentImportStep) error {
+	switch resource := step.resource.(type) {
+	case LocalSecret:
+		sdkSecret, err := convertLocalToSdk[cmfsdk.Secret](resource, "Secret")
+		if err != nil {
+			return err
+		}
+		_, err = client.CreateSecret(c.createContext(), sdkSecret)
+		return err
+	case LocalKafkaCatalog:
+		sdkCatalog, err := convertLocalToSdk[cmfsdk.KafkaCatalog](resource, "KafkaCatalog")
+		if err != nil {
+			return err
+		}
+		_, err = client.CreateCatalog(c.createContext(), sdkCatalog)
+		return err
+	case LocalKafkaDatabase:
+		sdkDatabase, err := convertLocalToSdk[cmfsdk.KafkaDatabase](resource, "KafkaDatabase")
+		if err != nil {
+			return err
+		}
+		_, err = client.CreateDatabase(c.createContext(), step.catalog, sdkDatabase)
+		return err
+	case LocalEnvironment:
+		_, err := client.CreateEnvironment(c.createContext(), convertLocalEnvironmentToSdkPostEnvironment(resource, environment))
+		return err
+	case LocalSecretMapping:
+		sdkMapping, err := convertLocalToSdk[cmfsdk.EnvironmentSecretMapping](resource, "EnvironmentSecretMapping")
+		if err != nil {
+			return err
+		}
+		_, err = client.CreateSecretMapping(c.createContext(), environment, sdkMapping)
+		return err
+	case LocalComputePool:
+		sdkComputePool, err := convertLocalToSdk[cmfsdk.ComputePool](resource, "ComputePool")
+		if err != nil {
+			return err
+		}
+		_, err = client.CreateComputePool(c.createContext(), environment, sdkComputePool)
+		return err
+	case LocalFlinkApplication:
+		sdkApplication, err := convertLocalToSdk[cmfsdk.FlinkApplication](resource, "FlinkApplication")
+		if err != nil {
+			return err
+		}
+		_, err = client.CreateApplication(c.createContext(), environment, sdkApplication)
+		return err
+	default:
+		return fmt.Errorf("unsupported resource kind: %s", step.kind)
+	}
+}
+
+func convertLocalEnvironmentToSdkPostEnvironment(localEnvironment LocalEnvironment, name string) cmfsdk.PostEnvironment {
+	postEnvironment := cmfsdk.PostEnvironment{
+		Name:                     &name,
+		KubernetesNamespace:      &localEnvironment.KubernetesNamespace,
+		FlinkApplicationDefaults: localEnvironment.FlinkApplicationDefaults,
+		ComputePoolDefaults:      localEnvironment.ComputePoolDefaults,
+	}
+
+	if localEnvironment.StatementDefaults != nil {
+		var statementDefaults cmfsdk.AllStatementDefaults1
+		if localEnvironment.StatementDefaults.Detached != nil {
+			statementDefaults.SetDetached(cmfsdk.StatementDefaults{FlinkConfiguration: localEnvironment.StatementDefaults.Detached.FlinkConfiguration})
+		}
+		if localEnvironment.StatementDefaults.Interactive != nil {
+			statementDefaults.SetInteractive(cmfsdk.StatementDefaults{FlinkConfiguration: localEnvironment.StatementDefaults.Interactive.FlinkConfiguration})
+		}
+		postEnvironment.StatementDefaults = &statementDefaults
+	}
+
+	return postEnvironment
+}
@@ -0,0 +1,138 @@
+package flink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSecretValueEnvVar(t *testing.T) {
+	require.Equal(t, "CONFLUENT_FLINK_SECRET_KAFKA_CREDENTIALS_SASL_JAAS_CONFIG", getSecretValueEnvVar("kafka-credentials", "sasl.jaas.config"))
+	require.Equal(t, "CONFLUENT_FLINK_SECRET_SR_BASIC_AUTH_USER_INFO", getSecretValueEnvVar("sr", "basic.auth.user.info"))
+}
+
+func TestPlanEnvironmentImport(t *testing.T) {
+	ddlEnvironments := []string{"prod", "dev"}
+	bundle := &environmentBundle{
+		Kind:        environmentBundleKind,
+		Environment: LocalEnvironment{Name: "prod", KubernetesNamespace: "flink"},
+		Secrets: []LocalSecret{
+			{Metadata: LocalSecretMetadata{Name: "kafka-credentials"}},
+		},
+		Catalogs: []environmentBundleCatalog{
+			{
+				Catalog: LocalKafkaCatalog{Metadata: LocalCatalogMetadata{Name: "kafka-cat"}},
+				Databases: []LocalKafkaDatabase{
+					{Metadata: LocalDatabaseMetadata{Name: "orders"}, Spec: LocalKafkaDatabaseSpec{DdlEnvironments: &ddlEnvironments}},
+					{Metadata: LocalDatabaseMetadata{Name: "payments"}},
+				},
+			},
+		},
+		SecretMappings: []LocalSecretMapping{{Metadata: LocalSecretMappingMetadata{Name: "kafka-connection"}}},
+		ComputePools:   []LocalComputePool{{Metadata: LocalComputePoolMetadata{Name: "pool"}}},
+		Applications:   []LocalFlinkApplication{{Metadata: map[string]any{"name": "orders-app"}}},
+	}
+	existing := environmentImportExisting{
+		catalogs:  []string{"kafka-cat"},
+		databases: map[string][]string{"kafka-cat": {"payments"}},
+	}
+
+	steps := planEnvironmentImport(bundle, "staging", existing)
+
+	type stepSummary struct{ kind, name, action string }
+	var summaries []stepSummary
+	for _, step := range steps {
+		summaries = append(summaries, stepSummary{step.kind, step.name, step.action})
+	}
+	require.Equal(t, []stepSummary{
+		{"secret", "kafka-credentials", environmentImportActionCreate},
+		{"catalog", "kafka-cat", environmentImportActionSkip},
+		{"database", "kafka-cat.orders", environmentImportActionCreate},
+		{"database", "kafka-cat.payments", environmentImportActionSkip},
+		{"environment", "staging", environmentImportActionCreate},
+		{"secret mapping", "kafka-connection", environmentImportActionCreate},
+		{"compute pool", "pool", environmentImportActionCreate},
+		{"application", "orders-app", environmentImportActionCreate},
+	}, summaries)
+
+	database := steps[2].resource.(LocalKafkaDatabase)
+	require.Equal(t, []string{"staging", "dev"}, *database.Spec.DdlEnvironments)
+	require.Equal(t, []string{"prod", "dev"}, ddlEnvironments)
+}
+
+func TestInjectEnvironmentBundleSecrets(t *testing.T) {
+	newSecretStep := func(name, action string, data map[string]string) environmentImportStep {
+		return environmentImportStep{
+			kind:     "secret",
+			name:     name,
+			action:   action,
+			resource: LocalSecret{Metadata: LocalSecretMetadata{Name: name}, Spec: LocalSecretSpec{Data: &data}},
+		}
+	}
+
+	bundle := &environmentBundle{Secrets: []LocalSecret{
+		{Metadata: LocalSecretMetadata{Name: "kafka-credentials"}, Spec: LocalSecretSpec{Data: &map[string]string{"sasl.jaas.config": "value"}}},
+	}}
+	redactEnvironmentBundleSecrets(bundle)
+	require.Equal(t, map[string]string{"sasl.jaas.config": redactedSecretValue}, *bundle.Secrets[0].Spec.Data)
+
+	env := map[string]string{"CONFLUENT_FLINK_SECRET_KAFKA_CREDENTIALS_SASL_JAAS_CONFIG": "injected"}
+	lookupEnv := func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+
+	steps := []environmentImportStep{
+		newSecretStep("kafka-credentials", environmentImportActionCreate, map[string]string{"sasl.jaas.config": redactedSecretValue, "security.protocol": "SASL_SSL"}),
+		newSecretStep("existing", environmentImportActionSkip, map[string]string{"password": redactedSecretValue}),
+	}
+	require.NoError(t, injectEnvironmentBundleSecrets(steps, lookupEnv))
+	require.Equal(t, map[string]string{"sasl.jaas.config": "injected", "security.protocol": "SASL_SSL"}, *steps[0].resource.(LocalSecret).Spec.Data)
+
+	steps = []environmentImportStep{
+		newSecretStep("sr", environmentImportActionCreate, map[string]string{"basic.auth.user.info": redactedSecretValue}),
+	}
+	err := injectEnvironmentBundleSecrets(steps, lookupEnv)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "the bundle contains redacted secret values")
+	require.Equal(t, "missing CONFLUENT_FLINK_SECRET_SR_BASIC_AUTH_USER_INFO", steps[0].reason)
+}
+
+func TestRedactAndInjectEnvironmentBundleFlinkConfiguration(t *testing.T) {
+	detached := map[string]string{"table.exec.source.idle-timeout": "10s", "kafka.sasl.jaas.config": "jaas"}
+	bundle := &environmentBundle{
+		Environment: LocalEnvironment{
+			Name:                     "prod",
+			FlinkApplicationDefaults: &map[string]any{"spec": map[string]any{"flinkConfiguration": map[string]any{"s3.secret-key": "key", "taskmanager.numberOfTaskSlots": "2"}}},
+			StatementDefaults:        &LocalAllStatementDefaults1{Detached: &LocalStatementDefaults{FlinkConfiguration: &detached}},
+		},
+		Applications: []LocalFlinkApplication{{
+			Metadata: map[string]any{"name": "orders-app"},
+			Spec:     map[string]any{"flinkConfiguration": map[string]any{"s3.access-key": "access", "metrics.reporter.password": "password"}},
+		}},
+	}
+
+	redactEnvironmentBundleSecrets(bundle)
+	require.Equal(t, map[string]any{"spec": map[string]any{"flinkConfiguration": map[string]any{"s3.secret-key": redactedSecretValue, "taskmanager.numberOfTaskSlots": "2"}}}, *bundle.Environment.FlinkApplicationDefaults)
+	require.Equal(t, map[string]string{"table.exec.source.idle-timeout": "10s", "kafka.sasl.jaas.config": redactedSecretValue}, detached)
+	require.Equal(t, map[string]any{"s3.access-key": "access", "metrics.reporter.password": redactedSecretValue}, bundle.Applications[0].Spec["flinkConfiguration"])
+
+	env := map[string]string{
+		"CONFLUENT_FLINK_CONFIG_STAGING_S3_SECRET_KEY":          "injected-key",
+		"CONFLUENT_FLINK_CONFIG_STAGING_KAFKA_SASL_JAAS_CONFIG": "injected-jaas",
+	}
+	lookupEnv := func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+
+	steps := planEnvironmentImport(bundle, "staging", environmentImportExisting{})
+	err := injectEnvironmentBundleSecrets(steps, lookupEnv)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "CONFLUENT_FLINK_CONFIG_ORDERS_APP_METRICS_REPORTER_PASSWORD")
+
+	require.Equal(t, map[string]any{"spec": map[string]any{"flinkConfiguration": map[string]any{"s3.secret-key": "injected-key", "taskmanager.numberOfTaskSlots": "2"}}}, *bundle.Environment.FlinkApplicationDefaults)
+	require.Equal(t, "injected-jaas", detached["kafka.sasl.jaas.config"])
+	require.Empty(t, steps[0].reason)
+	require.Equal(t, "missing CONFLUENT_FLINK_CONFIG_ORDERS_APP_METRICS_REPORTER_PASSWORD", steps[1].reason)
+}
@@ -8,6 +8,11 @@ import (
 	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
 )
 
+const (
+	savepointDefaultFormat       = "CANONICAL"
+	savepointDefaultBackoffLimit = 0
+)
+
 type savepointOut struct {
 	Name         string `human:"Name" serialized:"name"`
 	Application  string `human:"Application,omitempty" serialized:"application,omitempty"`
@@ -31,10 +36,35 @@ func (c *command) newSavepointCommand() *cobra.Command {
 	cmd.AddCommand(c.newSavepointDescribeCommand())
 	cmd.AddCommand(c.newSavepointDetachCommand())
 	cmd.AddCommand(c.newSavepointListCommand())
+	cmd.AddCommand(c.newSavepointPruneCommand())
+	cmd.AddCommand(c.newSavepointScheduleCommand())
 
 	return cmd
 }
 
+// newSavepoint returns the savepoint to create for an application or statement. An empty name or path is left to the
+// server.
+func newSavepoint(name, path, format string, backoffLimit int32) cmfsdk.Savepoint {
+	savepoint := cmfsdk.Savepoint{
+		ApiVersion: "cmf.confluent.io/v1",
+		Kind:       "Savepoint",
+		Spec: cmfsdk.SavepointSpec{
+			BackoffLimit: &backoffLimit,
+			FormatType:   &format,
+		},
+		Status: &cmfsdk.SavepointStatus{
+			Path: &path,
+		},
+	}
+	if path != "" {
+		savepoint.Spec.SetPath(path)
+	}
+	if name != "" {
+		savepoint.Metadata.SetName(name)
+	}
+	return savepoint
+}
+
 func convertSdkSavepointToLocalSavepoint(sdkSavepoint cmfsdk.Savepoint) LocalSavepoint {
 	localSavepoint := LocalSavepoint{
 		ApiVersion: sdkSavepoint.ApiVersion,
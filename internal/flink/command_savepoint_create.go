@@ -28,8 +28,8 @@ func (c *command) newSavepointCreateCommand() *cobra.Command {
 	cmd.Flags().String("application", "", "The name of the Flink application to create the savepoint for.")
 	cmd.Flags().String("statement", "", "The name of the Flink statement to create the savepoint for.")
 	cmd.Flags().String("path", "", "The directory where the savepoint should be stored.")
-	cmd.Flags().String("format", "CANONICAL", "The format of the savepoint. Defaults to CANONICAL.")
-	cmd.Flags().Int("backoff-limit", 0, "Maximum number of retries before the snapshot is considered failed. Set to -1 for unlimited or 0 for no retries.")
+	cmd.Flags().String("format", savepointDefaultFormat, "The format of the savepoint. Defaults to CANONICAL.")
+	cmd.Flags().Int("backoff-limit", savepointDefaultBackoffLimit, "Maximum number of retries before the snapshot is considered failed. Set to -1 for unlimited or 0 for no retries.")
 	pcmd.AddOutputFlag(cmd)
 	addCmfFlagSet(cmd)
 
@@ -81,24 +81,7 @@ func (c *command) savepointCreate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	limit32 := int32(limit)
-	savepoint := cmfsdk.Savepoint{
-		ApiVersion: "cmf.confluent.io/v1",
-		Kind:       "Savepoint",
-		Spec: cmfsdk.SavepointSpec{
-			BackoffLimit: &limit32,
-			FormatType:   &format,
-		},
-		Status: &cmfsdk.SavepointStatus{
-			Path: &path,
-		},
-	}
-	if path != "" {
-		savepoint.Spec.SetPath(path)
-	}
-	if name != "" {
-		savepoint.Metadata.SetName(name)
-	}
+	savepoint := newSavepoint(name, path, format, int32(limit))
 	var savepointCreated cmfsdk.Savepoint
 
 	if application != "" {
@@ -0,0 +1,427 @@
+package flink
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+
+	cmfsdk "github.com/confluentinc/cmf-sdk-go/v1"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/deletion"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/flink"
+	"github.com/confluentinc/cli/v4/pkg/output"
+	"github.com/confluentinc/cli/v4/pkg/plural"
+	"github.com/confluentinc/cli/v4/pkg/resource"
+)
+
+const (
+	savepointPruneActionKeep   = "keep"
+	savepointPruneActionDelete = "delete"
+)
+
+// Savepoints in these states are still being taken, and are never pruned. A savepoint without a state has not been
+// picked up yet.
+var savepointInProgressStates = []string{"", "IN_PROGRESS", "TRIGGER_PENDING"}
+
+// savepointRetentionPolicy keeps the last savepoints of an application or statement, and the savepoints taken within a
+// period of time. A zero value disables either rule.
+type savepointRetentionPolicy struct {
+	KeepLast  int
+	OlderThan time.Duration
+}
+
+type savepointTarget struct {
+	Application string
+	Statement   string
+}
+
+type savepointPrunePlan struct {
+	target    savepointTarget
+	savepoint cmfsdk.Savepoint
+	action    string
+	reason    string
+}
+
+type savepointPruneOut struct {
+	Name        string `human:"Name" serialized:"name"`
+	Application string `human:"Application,omitempty" serialized:"application,omitempty"`
+	Statement   string `human:"Statement,omitempty" serialized:"statement,omitempty"`
+	Created     string `human:"Created,omitempty" serialized:"created,omitempty"`
+	State       string `human:"State,omitempty" serialized:"state,omitempty"`
+	Action      string `human:"Action" serialized:"action"`
+	Reason      string `human:"Reason" serialized:"reason"`
+}
+
+func (c *command) newSavepointPruneCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Delete old Flink savepoints according to retention rules.",
+		Long: "Delete the savepoints of Flink applications and statements which are not kept by any retention rule.\n\n" +
+			`"--keep-last" keeps the most recent completed savepoints of each application or statement, and "--older-than" keeps the savepoints taken more recently than the given age. ` +
+			"When both are set, a savepoint is deleted only if neither rule keeps it. " +
+			"Savepoints which are still being taken, and the savepoint an application or statement was started from, are always kept. " +
+			"Without --application or --statement, the savepoints of every application and statement of the environment are pruned.",
+		Args: cobra.NoArgs,
+		RunE: c.savepointPrune,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Preview which savepoints of application "orders" would be deleted when keeping the last 5 savepoints and those taken in the last 14 days.`,
+				Code: "confluent flink savepoint prune --environment prod --application orders --keep-last 5 --older-than 14d --dry-run",
+			},
+			examples.Example{
+				Text: `Delete the savepoints older than 30 days of every application and statement of environment "prod".`,
+				Code: "confluent flink savepoint prune --environment prod --older-than 30d --force",
+			},
+		),
+	}
+
+	cmd.Flags().String("environment", "", "Name of the Flink environment.")
+	cmd.Flags().String("application", "", "The name of the Flink application to prune the savepoints of.")
+	cmd.Flags().String("statement", "", "The name of the Flink statement to prune the savepoints of.")
+	cmd.Flags().Int("keep-last", 0, "Number of most recent completed savepoints to keep, at least 1.")
+	cmd.Flags().String("older-than", "", `Only delete savepoints older than this age, such as "12h" or "14d".`)
+	pcmd.AddDryRunFlag(cmd)
+	pcmd.AddForceFlag(cmd)
+	addPageSizeFlag(cmd)
+	addCmfFlagSet(cmd)
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("environment"))
+	cmd.MarkFlagsMutuallyExclusive("application", "statement")
+	cmd.MarkFlagsOneRequired("keep-last", "older-than")
+
+	return cmd
+}
+
+func (c *command) savepointPrune(cmd *cobra.Command, _ []string) error {
+	environment, err := cmd.Flags().GetString("environment")
+	if err != nil {
+		return err
+	}
+
+	application, err := cmd.Flags().GetString("application")
+	if err != nil {
+		return err
+	}
+
+	statement, err := cmd.Flags().GetString("statement")
+	if err != nil {
+		return err
+	}
+
+	policy, err := getSavepointRetentionPolicy(cmd)
+	if err != nil {
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	pageSize, err := getPageSize(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.GetCmfClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	var targets []savepointTarget
+	switch {
+	case application != "":
+		targets = []savepointTarget{{Application: application}}
+	case statement != "":
+		targets = []savepointTarget{{Statement: statement}}
+	default:
+		if targets, err = c.listSavepointTargets(client, environment, pageSize); err != nil {
+			return err
+		}
+	}
+
+	var plans []savepointPrunePlan
+	for _, target := range targets {
+		targetPlans, err := c.planSavepointPruneForTarget(client, environment, target, policy, pageSize, time.Now())
+		if err != nil {
+			return err
+		}
+		plans = append(plans, targetPlans...)
+	}
+
+	list := output.NewList(cmd)
+	for _, plan := range plans {
+		list.Add(plan.toOut())
+	}
+	list.Sort(false)
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	deletions := slices.DeleteFunc(slices.Clone(plans), func(plan savepointPrunePlan) bool { return plan.action != savepointPruneActionDelete })
+	if dryRun || len(deletions) == 0 {
+		return nil
+	}
+
+	promptMsg := fmt.Sprintf("Are you sure you want to delete %d %s?", len(deletions), plural.Plural(resource.FlinkSavepoint))
+	if len(deletions) == 1 {
+		promptMsg = fmt.Sprintf("Are you sure you want to delete 1 %s?", resource.FlinkSavepoint)
+	}
+	if err := deletion.ConfirmPrompt(cmd, promptMsg); err != nil {
+		return err
+	}
+
+	deleted, err := c.deletePrunedSavepoints(client, environment, deletions)
+	if output.GetFormat(cmd) == output.Human && deleted > 0 {
+		output.Printf(c.Config.EnableColor, "Deleted %d of %d %s.\n", deleted, len(deletions), plural.Plural(resource.FlinkSavepoint))
+	}
+	return err
+}
+
+func getSavepointRetentionPolicy(cmd *cobra.Command) (savepointRetentionPolicy, error) {
+	keepLast, err := cmd.Flags().GetInt("keep-last")
+	if err != nil {
+		return savepointRetentionPolicy{}, err
+	}
+	// Keeping none of the last savepoints would delete every savepoint which is not in use
+	if cmd.Flags().Changed("keep-last") && keepLast < 1 {
+		return savepointRetentionPolicy{}, fmt.Errorf("--keep-last must be at least 1")
+	}
+
+	olderThan, err := cmd.Flags().GetString("older-than")
+	if err != nil {
+		return savepointRetentionPolicy{}, err
+	}
+
+	policy := savepointRetentionPolicy{KeepLast: keepLast}
+	if olderThan != "" {
+		if policy.OlderThan, err = parseSavepointAge(olderThan); err != nil {
+			return savepointRetentionPolicy{}, err
+		}
+	}
+	return policy, nil
+}
+
+// parseSavepointAge parses a duration which may also be given in days, such as "14d".
+func parseSavepointAge(age string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(age, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return 0, errors.NewErrorWithSuggestions(fmt.Sprintf(`invalid age "%s"`, age), `Specify a positive number of days such as "14d", or a duration such as "12h".`)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	duration, err := time.ParseDuration(age)
+	if err != nil || duration <= 0 {
+		return 0, errors.NewErrorWithSuggestions(fmt.Sprintf(`invalid age "%s"`, age), `Specify a positive number of days such as "14d", or a duration such as "12h".`)
+	}
+	return duration, nil
+}
+
+// listSavepointTargets returns every application and statement of the environment.
+func (c *command) listSavepointTargets(client *flink.CmfRestClient, environment string, pageSize int32) ([]savepointTarget, error) {
+	applications, err := client.ListApplications(c.createContext(), environment, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	statements, err := client.ListStatements(c.createContext(), environment, "", "", pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]savepointTarget, 0, len(applications)+len(statements))
+	for _, application := range applications {
+		if name, ok := application.Metadata["name"].(string); ok {
+			targets = append(targets, savepointTarget{Application: name})
+		}
+	}
+	for _, statement := range statements {
+		targets = append(targets, savepointTarget{Statement: statement.Metadata.Name})
+	}
+	return targets, nil
+}
+
+func (c *command) planSavepointPruneForTarget(client *flink.CmfRestClient, environment string, target savepointTarget, policy savepointRetentionPolicy, pageSize int32, now time.Time) ([]savepointPrunePlan, error) {
+	savepoints, err := client.ListSavepoint(c.createContext(), environment, target.Statement, target.Application, target.Statement != "", pageSize)
+	if err != nil {
+		return nil, err
+	}
+	if len(savepoints) == 0 {
+		return nil, nil
+	}
+
+	inUse, err := c.getSavepointPathsInUse(client, environment, target, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	plans := planSavepointPrune(savepoints, inUse, policy, now)
+	for i := range plans {
+		plans[i].target = target
+	}
+	return plans, nil
+}
+
+// getSavepointPathsInUse returns the paths of the savepoints an application or statement was started from, which must
+// be kept to restart it.
+func (c *command) getSavepointPathsInUse(client *flink.CmfRestClient, environment string, target savepointTarget, pageSize int32) ([]string, error) {
+	if target.Statement != "" {
+		statement, err := client.GetStatement(c.createContext(), environment, target.Statement)
+		if err != nil {
+			return nil, err
+		}
+		if statement.Spec.StartFromSavepoint == nil {
+			return nil, nil
+		}
+		return []string{statement.Spec.StartFromSavepoint.GetInitialSavepointPath()}, nil
+	}
+
+	application, err := client.DescribeApplication(c.createContext(), environment, target.Application)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := []string{getNestedString(application.Spec, "job", "initialSavepointPath")}
+	if application.Status != nil {
+		paths = append(paths, getNestedString(*application.Status, "jobStatus", "upgradeSavepointPath"))
+	}
+
+	instances, err := client.ListApplicationInstances(c.createContext(), environment, target.Application, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances {
+		if getApplicationInstanceJobState(instance) == "RUNNING" && instance.Status.Spec != nil {
+			paths = append(paths, getNestedString(*instance.Status.Spec, "job", "initialSavepointPath"))
+		}
+	}
+	return paths, nil
+}
+
+func getNestedString(m map[string]any, keys ...string) string {
+	for _, key := range keys[:len(keys)-1] {
+		nested, ok := m[key].(map[string]any)
+		if !ok {
+			return ""
+		}
+		m = nested
+	}
+	value, _ := m[keys[len(keys)-1]].(string)
+	return value
+}
+
+// planSavepointPrune decides which savepoints of an application or statement to keep and which to delete.
+func planSavepointPrune(savepoints []cmfsdk.Savepoint, inUse []string, policy savepointRetentionPolicy, now time.Time) []savepointPrunePlan {
+	sorted := slices.Clone(savepoints)
+	slices.SortStableFunc(sorted, func(a, b cmfsdk.Savepoint) int {
+		return getSavepointCreationTime(b).Compare(getSavepointCreationTime(a))
+	})
+
+	plans := make([]savepointPrunePlan, len(sorted))
+	completed := 0
+	for i, savepoint := range sorted {
+		plan := savepointPrunePlan{savepoint: savepoint, action: savepointPruneActionKeep}
+		state := savepoint.Status.GetState()
+		created := getSavepointCreationTime(savepoint)
+
+		switch {
+		case isSavepointInUse(savepoint, inUse):
+			plan.reason = "in use"
+		case slices.Contains(savepointInProgressStates, state):
+			plan.reason = "in progress"
+		default:
+			if state == "COMPLETED" {
+				completed++
+			}
+
+			switch {
+			case state == "COMPLETED" && completed <= policy.KeepLast:
+				plan.reason = fmt.Sprintf("among the last %d", policy.KeepLast)
+			case policy.OlderThan > 0 && (created.IsZero() || now.Sub(created) < policy.OlderThan):
+				plan.reason = fmt.Sprintf("newer than %s", formatSavepointAge(policy.OlderThan))
+			default:
+				plan.action = savepointPruneActionDelete
+				plan.reason = getSavepointPruneReason(policy, state)
+			}
+		}
+
+		plans[i] = plan
+	}
+	return plans
+}
+
+func getSavepointPruneReason(policy savepointRetentionPolicy, state string) string {
+	var reasons []string
+	if state != "COMPLETED" {
+		reasons = append(reasons, strings.ToLower(state))
+	} else if policy.KeepLast > 0 {
+		reasons = append(reasons, fmt.Sprintf("not among the last %d", policy.KeepLast))
+	}
+	if policy.OlderThan > 0 {
+		reasons = append(reasons, fmt.Sprintf("older than %s", formatSavepointAge(policy.OlderThan)))
+	}
+	return strings.Join(reasons, ", ")
+}
+
+func isSavepointInUse(savepoint cmfsdk.Savepoint, inUse []string) bool {
+	for _, path := range []string{savepoint.Status.GetPath(), savepoint.Spec.GetPath()} {
+		path = strings.TrimSuffix(path, "/")
+		if path != "" && slices.ContainsFunc(inUse, func(inUsePath string) bool { return strings.TrimSuffix(inUsePath, "/") == path }) {
+			return true
+		}
+	}
+	return false
+}
+
+// getSavepointCreationTime returns the creation time of a savepoint, or the zero time if it is unknown.
+func getSavepointCreationTime(savepoint cmfsdk.Savepoint) time.Time {
+	created, err := time.Parse(time.RFC3339, savepoint.Metadata.GetCreationTimestamp())
+	if err != nil {
+		return time.Time{}
+	}
+	return created
+}
+
+func formatSavepointAge(age time.Duration) string {
+	if age%(24*time.Hour) == 0 {
+		return fmt.Sprintf("%dd", age/(24*time.Hour))
+	}
+	return age.String()
+}
+
+func (c *command) deletePrunedSavepoints(client *flink.CmfRestClient, environment string, plans []savepointPrunePlan) (int, error) {
+	errs := &multierror.Error{ErrorFormat: errors.CustomMultierrorList}
+	deleted := 0
+	for _, plan := range plans {
+		name := plan.savepoint.Metadata.GetName()
+		if err := client.DeleteSavepoint(c.createContext(), environment, name, plan.target.Application, plan.target.Statement, false); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf(`failed to delete %s "%s": %w`, resource.FlinkSavepoint, name, err))
+			continue
+		}
+		deleted++
+	}
+	return deleted, errs.ErrorOrNil()
+}
+
+func (p savepointPrunePlan) toOut() *savepointPruneOut {
+	return &savepointPruneOut{
+		Name:        p.savepoint.Metadata.GetName(),
+		Application: p.target.Application,
+		Statement:   p.target.Statement,
+		Created:     p.savepoint.Metadata.GetCreationTimestamp(),
+		State:       p.savepoint.Status.GetState(),
+		Action:      p.action,
+		Reason:      p.reason,
+	}
+}
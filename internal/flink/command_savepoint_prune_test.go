@@ -0,0 +1,146 @@
+package flink
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	cmfsdk "github.com/confluentinc/cmf-sdk-go/v1"
+)
+
+func TestParseSavepointAge(t *testing.T) {
+	age, err := parseSavepointAge("14d")
+	require.NoError(t, err)
+	require.Equal(t, 14*24*time.Hour, age)
+	require.Equal(t, "14d", formatSavepointAge(age))
+
+	age, err = parseSavepointAge("90m")
+	require.NoError(t, err)
+	require.Equal(t, 90*time.Minute, age)
+	require.Equal(t, "1h30m0s", formatSavepointAge(age))
+
+	for _, invalid := range []string{"", "d", "-1d", "0h", "two weeks"} {
+		_, err := parseSavepointAge(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestGetSavepointRetentionPolicy(t *testing.T) {
+	newCommand := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.Flags().Int("keep-last", 0, "")
+		cmd.Flags().String("older-than", "", "")
+		require.NoError(t, cmd.Flags().Parse(args))
+		return cmd
+	}
+
+	policy, err := getSavepointRetentionPolicy(newCommand("--keep-last", "5", "--older-than", "14d"))
+	require.NoError(t, err)
+	require.Equal(t, savepointRetentionPolicy{KeepLast: 5, OlderThan: 14 * 24 * time.Hour}, policy)
+
+	policy, err = getSavepointRetentionPolicy(newCommand("--older-than", "14d"))
+	require.NoError(t, err)
+	require.Equal(t, savepointRetentionPolicy{OlderThan: 14 * 24 * time.Hour}, policy)
+
+	// Keeping none of the last savepoints would delete every savepoint which is not in use
+	for _, keepLast := range []string{"0", "-1"} {
+		_, err := getSavepointRetentionPolicy(newCommand("--keep-last", keepLast))
+		require.Error(t, err, keepLast)
+	}
+}
+
+func newTestSavepoint(name, state, path string, created time.Time) cmfsdk.Savepoint {
+	creationTimestamp := created.Format(time.RFC3339)
+	return cmfsdk.Savepoint{
+		Metadata: cmfsdk.SavepointMetadata{Name: &name, CreationTimestamp: &creationTimestamp},
+		Status:   &cmfsdk.SavepointStatus{State: &state, Path: &path},
+	}
+}
+
+func TestPlanSavepointPrune(t *testing.T) {
+	now := time.Date(2025, time.September, 30, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	savepoints := []cmfsdk.Savepoint{
+		newTestSavepoint("sp-1", "COMPLETED", "s3://savepoints/sp-1", now.Add(-40*day)),
+		newTestSavepoint("sp-2", "COMPLETED", "s3://savepoints/sp-2", now.Add(-30*day)),
+		newTestSavepoint("sp-3", "FAILED", "", now.Add(-20*day)),
+		newTestSavepoint("sp-4", "COMPLETED", "s3://savepoints/sp-4", now.Add(-10*day)),
+		newTestSavepoint("sp-5", "COMPLETED", "s3://savepoints/sp-5", now.Add(-2*day)),
+		newTestSavepoint("sp-6", "IN_PROGRESS", "", now.Add(-time.Hour)),
+	}
+
+	getActions := func(plans []savepointPrunePlan) map[string]string {
+		actions := map[string]string{}
+		for _, plan := range plans {
+			actions[plan.savepoint.Metadata.GetName()] = plan.action
+		}
+		return actions
+	}
+
+	plans := planSavepointPrune(savepoints, []string{"s3://savepoints/sp-1/"}, savepointRetentionPolicy{KeepLast: 2}, now)
+	require.Equal(t, "sp-6", plans[0].savepoint.Metadata.GetName())
+	require.Equal(t, map[string]string{
+		"sp-1": savepointPruneActionKeep,
+		"sp-2": savepointPruneActionDelete,
+		"sp-3": savepointPruneActionDelete,
+		"sp-4": savepointPruneActionKeep,
+		"sp-5": savepointPruneActionKeep,
+		"sp-6": savepointPruneActionKeep,
+	}, getActions(plans))
+
+	plans = planSavepointPrune(savepoints, nil, savepointRetentionPolicy{KeepLast: 1, OlderThan: 14 * day}, now)
+	require.Equal(t, map[string]string{
+		"sp-1": savepointPruneActionDelete,
+		"sp-2": savepointPruneActionDelete,
+		"sp-3": savepointPruneActionDelete,
+		"sp-4": savepointPruneActionKeep,
+		"sp-5": savepointPruneActionKeep,
+		"sp-6": savepointPruneActionKeep,
+	}, getActions(plans))
+	require.Equal(t, "not among the last 1, older than 14d", plans[4].reason)
+	require.Equal(t, "failed, older than 14d", plans[3].reason)
+}
+
+func TestIsSavepointDue(t *testing.T) {
+	now := time.Date(2025, time.September, 30, 12, 0, 0, 0, time.UTC)
+
+	require.True(t, isSavepointDue(nil, 6*time.Hour, now))
+
+	savepoints := []cmfsdk.Savepoint{
+		newTestSavepoint("sp-1", "COMPLETED", "", now.Add(-8*time.Hour)),
+		newTestSavepoint("sp-2", "FAILED", "", now.Add(-time.Hour)),
+	}
+	require.True(t, isSavepointDue(savepoints, 6*time.Hour, now))
+	require.False(t, isSavepointDue(savepoints, 12*time.Hour, now))
+}
+
+func TestSavepointScheduleManifest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "savepoints.yaml")
+
+	manifest, err := readSavepointScheduleManifest(path)
+	require.NoError(t, err)
+	require.Empty(t, manifest.Schedules)
+
+	manifest.Schedules = []savepointSchedule{
+		{Environment: "prod", Application: "orders", Every: "6h", KeepLast: 5, OlderThan: "14d"},
+		{Environment: "prod", Statement: "orders-enriched", Every: "1d"},
+	}
+	require.NoError(t, writeSavepointScheduleManifest(path, manifest))
+
+	manifest, err = readSavepointScheduleManifest(path)
+	require.NoError(t, err)
+	require.Len(t, manifest.Schedules, 2)
+	require.Equal(t, 1, manifest.index("prod", savepointTarget{Statement: "orders-enriched"}))
+	require.Equal(t, -1, manifest.index("dev", savepointTarget{Application: "orders"}))
+
+	policy, err := manifest.Schedules[0].policy()
+	require.NoError(t, err)
+	require.Equal(t, savepointRetentionPolicy{KeepLast: 5, OlderThan: 14 * 24 * time.Hour}, policy)
+
+	require.Error(t, savepointSchedule{Environment: "prod", Application: "orders", Statement: "orders", Every: "1d"}.validate())
+	require.Error(t, savepointSchedule{Environment: "prod", Application: "orders", Every: "sometimes"}.validate())
+}
@@ -0,0 +1,190 @@
+package flink
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+)
+
+const savepointScheduleFilename = "flink_savepoint_schedules.yaml"
+
+// savepointScheduleManifest records how often savepoints of applications and statements should be taken, and how
+// long they should be retained. It is driven by `confluent flink savepoint schedule run`, such as from a cron job.
+type savepointScheduleManifest struct {
+	Schedules []savepointSchedule `json:"schedules" yaml:"schedules"`
+}
+
+type savepointSchedule struct {
+	Environment string `json:"environment" yaml:"environment"`
+	Application string `json:"application,omitempty" yaml:"application,omitempty"`
+	Statement   string `json:"statement,omitempty" yaml:"statement,omitempty"`
+	Every       string `json:"every" yaml:"every"`
+	Path        string `json:"path,omitempty" yaml:"path,omitempty"`
+	KeepLast    int    `json:"keep_last,omitempty" yaml:"keep_last,omitempty"`
+	OlderThan   string `json:"older_than,omitempty" yaml:"older_than,omitempty"`
+}
+
+type savepointScheduleOut struct {
+	Environment string `human:"Environment" serialized:"environment"`
+	Application string `human:"Application,omitempty" serialized:"application,omitempty"`
+	Statement   string `human:"Statement,omitempty" serialized:"statement,omitempty"`
+	Every       string `human:"Every" serialized:"every"`
+	Path        string `human:"Path,omitempty" serialized:"path,omitempty"`
+	KeepLast    int    `human:"Keep Last,omitempty" serialized:"keep_last,omitempty"`
+	OlderThan   string `human:"Older Than,omitempty" serialized:"older_than,omitempty"`
+}
+
+func (c *command) newSavepointScheduleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "Manage the savepoint schedules of Flink applications and statements.",
+		Long: "Manage a manifest of how often savepoints of Flink applications and statements are taken, and how long they are retained.\n\n" +
+			"The manifest is stored next to the CLI configuration, or in the file passed to --file, which can be exported and shared. " +
+			"Savepoints are only taken when `confluent flink savepoint schedule run` is called, such as from a cron job.",
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLogout},
+	}
+
+	cmd.AddCommand(c.newSavepointScheduleDeleteCommand())
+	cmd.AddCommand(c.newSavepointScheduleListCommand())
+	cmd.AddCommand(c.newSavepointScheduleRunCommand())
+	cmd.AddCommand(c.newSavepointScheduleSetCommand())
+
+	return cmd
+}
+
+func addSavepointScheduleFileFlag(cmd *cobra.Command) {
+	cmd.Flags().String("file", "", fmt.Sprintf(`Path to a YAML or JSON savepoint schedule manifest. Defaults to "%s" in the directory of the CLI configuration.`, savepointScheduleFilename))
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "yaml", "yml", "json"))
+}
+
+func (c *command) getSavepointScheduleFile(cmd *cobra.Command) (string, error) {
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return "", err
+	}
+	if file == "" {
+		file = filepath.Join(filepath.Dir(c.Config.GetFilename()), savepointScheduleFilename)
+	}
+	return file, nil
+}
+
+// readSavepointScheduleManifest reads a savepoint schedule manifest, or returns an empty one if the file does not exist.
+func readSavepointScheduleManifest(path string) (*savepointScheduleManifest, error) {
+	manifest := new(savepointScheduleManifest)
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return manifest, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	switch ext := filepath.Ext(path); ext {
+	case ".json":
+		err = json.Unmarshal(data, manifest)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, manifest)
+	default:
+		return nil, errors.NewErrorWithSuggestions(fmt.Sprintf("unsupported file format: %s", ext), "Supported file formats are .json, .yaml, and .yml.")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse input file: %w", err)
+	}
+
+	for _, schedule := range manifest.Schedules {
+		if err := schedule.validate(); err != nil {
+			return nil, fmt.Errorf(`invalid schedule in "%s": %w`, path, err)
+		}
+	}
+
+	return manifest, nil
+}
+
+func writeSavepointScheduleManifest(path string, manifest *savepointScheduleManifest) error {
+	var data []byte
+	var err error
+	switch ext := filepath.Ext(path); ext {
+	case ".json":
+		data, err = json.MarshalIndent(manifest, "", "  ")
+	case ".yaml", ".yml":
+		data, err = yaml.Marshal(manifest)
+	default:
+		return errors.NewErrorWithSuggestions(fmt.Sprintf("unsupported file format: %s", ext), "Supported file formats are .json, .yaml, and .yml.")
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// index returns the index of the schedule of an application or statement, or -1 if it has none.
+func (m *savepointScheduleManifest) index(environment string, target savepointTarget) int {
+	return slices.IndexFunc(m.Schedules, func(schedule savepointSchedule) bool {
+		return schedule.Environment == environment && schedule.target() == target
+	})
+}
+
+func (s savepointSchedule) target() savepointTarget {
+	return savepointTarget{Application: s.Application, Statement: s.Statement}
+}
+
+func (s savepointSchedule) name() string {
+	if s.Statement != "" {
+		return fmt.Sprintf(`statement "%s" in the environment "%s"`, s.Statement, s.Environment)
+	}
+	return fmt.Sprintf(`application "%s" in the environment "%s"`, s.Application, s.Environment)
+}
+
+func (s savepointSchedule) policy() (savepointRetentionPolicy, error) {
+	policy := savepointRetentionPolicy{KeepLast: s.KeepLast}
+	if s.OlderThan != "" {
+		olderThan, err := parseSavepointAge(s.OlderThan)
+		if err != nil {
+			return savepointRetentionPolicy{}, err
+		}
+		policy.OlderThan = olderThan
+	}
+	return policy, nil
+}
+
+func (s savepointSchedule) validate() error {
+	if s.Environment == "" {
+		return fmt.Errorf("schedule has no environment")
+	}
+	if (s.Application == "") == (s.Statement == "") {
+		return fmt.Errorf("schedule must have exactly one of an application or a statement")
+	}
+	if _, err := parseSavepointAge(s.Every); err != nil {
+		return err
+	}
+	if s.KeepLast < 0 {
+		return fmt.Errorf("keep_last must not be negative")
+	}
+	_, err := s.policy()
+	return err
+}
+
+func (s savepointSchedule) toOut() *savepointScheduleOut {
+	return &savepointScheduleOut{
+		Environment: s.Environment,
+		Application: s.Application,
+		Statement:   s.Statement,
+		Every:       s.Every,
+		Path:        s.Path,
+		KeepLast:    s.KeepLast,
+		OlderThan:   s.OlderThan,
+	}
+}
@@ -0,0 +1,76 @@
+package flink
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+func (c *command) newSavepointScheduleDeleteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete the savepoint schedule of a Flink application or statement.",
+		Long:  "Delete the savepoint schedule of a Flink application or statement. Its existing savepoints are not deleted.",
+		Args:  cobra.NoArgs,
+		RunE:  c.savepointScheduleDelete,
+	}
+
+	cmd.Flags().String("environment", "", "Name of the Flink environment.")
+	cmd.Flags().String("application", "", "The name of the Flink application to delete the schedule of.")
+	cmd.Flags().String("statement", "", "The name of the Flink statement to delete the schedule of.")
+	addSavepointScheduleFileFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("environment"))
+	cmd.MarkFlagsOneRequired("application", "statement")
+	cmd.MarkFlagsMutuallyExclusive("application", "statement")
+
+	return cmd
+}
+
+func (c *command) savepointScheduleDelete(cmd *cobra.Command, _ []string) error {
+	environment, err := cmd.Flags().GetString("environment")
+	if err != nil {
+		return err
+	}
+
+	application, err := cmd.Flags().GetString("application")
+	if err != nil {
+		return err
+	}
+
+	statement, err := cmd.Flags().GetString("statement")
+	if err != nil {
+		return err
+	}
+
+	file, err := c.getSavepointScheduleFile(cmd)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := readSavepointScheduleManifest(file)
+	if err != nil {
+		return err
+	}
+
+	schedule := savepointSchedule{Environment: environment, Application: application, Statement: statement}
+	i := manifest.index(environment, schedule.target())
+	if i == -1 {
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf("no savepoint schedule found for %s", schedule.name()),
+			"List the savepoint schedules with `confluent flink savepoint schedule list`.",
+		)
+	}
+
+	manifest.Schedules = slices.Delete(manifest.Schedules, i, i+1)
+	if err := writeSavepointScheduleManifest(file, manifest); err != nil {
+		return err
+	}
+
+	output.Printf(c.Config.EnableColor, "Deleted the savepoint schedule for %s.\n", schedule.name())
+	return nil
+}
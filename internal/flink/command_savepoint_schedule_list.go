@@ -0,0 +1,48 @@
+package flink
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+func (c *command) newSavepointScheduleListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the savepoint schedules of Flink applications and statements.",
+		Args:  cobra.NoArgs,
+		RunE:  c.savepointScheduleList,
+	}
+
+	cmd.Flags().String("environment", "", "Name of the Flink environment to list the schedules of.")
+	addSavepointScheduleFileFlag(cmd)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) savepointScheduleList(cmd *cobra.Command, _ []string) error {
+	environment, err := cmd.Flags().GetString("environment")
+	if err != nil {
+		return err
+	}
+
+	file, err := c.getSavepointScheduleFile(cmd)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := readSavepointScheduleManifest(file)
+	if err != nil {
+		return err
+	}
+
+	list := output.NewList(cmd)
+	for _, schedule := range manifest.Schedules {
+		if environment == "" || schedule.Environment == environment {
+			list.Add(schedule.toOut())
+		}
+	}
+	return list.Print()
+}
@@ -0,0 +1,165 @@
+package flink
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+
+	cmfsdk "github.com/confluentinc/cmf-sdk-go/v1"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/flink"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+type savepointScheduleRunOut struct {
+	Environment string `human:"Environment" serialized:"environment"`
+	Application string `human:"Application,omitempty" serialized:"application,omitempty"`
+	Statement   string `human:"Statement,omitempty" serialized:"statement,omitempty"`
+	Due         bool   `human:"Due" serialized:"due"`
+	Savepoint   string `human:"Savepoint,omitempty" serialized:"savepoint,omitempty"`
+	Deleted     int    `human:"Deleted Savepoints" serialized:"deleted_savepoints"`
+}
+
+func (c *command) newSavepointScheduleRunCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Take and prune the scheduled savepoints which are due.",
+		Long: "Take a savepoint of each scheduled Flink application and statement whose latest savepoint is older than its schedule, and delete the savepoints which are not kept by its retention rules.\n\n" +
+			"Call this command periodically, such as from a cron job, more often than the most frequent schedule. Savepoints are deleted without a confirmation prompt.",
+		Args: cobra.NoArgs,
+		RunE: c.savepointScheduleRun,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Preview the savepoints which are due.",
+				Code: "confluent flink savepoint schedule run --dry-run",
+			},
+			examples.Example{
+				Text: `Run the schedules of "savepoints.yaml", such as from a cron job.`,
+				Code: "confluent flink savepoint schedule run --file savepoints.yaml",
+			},
+		),
+	}
+
+	addSavepointScheduleFileFlag(cmd)
+	pcmd.AddDryRunFlag(cmd)
+	addCmfFlagSet(cmd)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) savepointScheduleRun(cmd *cobra.Command, _ []string) error {
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	file, err := c.getSavepointScheduleFile(cmd)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := readSavepointScheduleManifest(file)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.GetCmfClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	errs := &multierror.Error{ErrorFormat: errors.CustomMultierrorList}
+	list := output.NewList(cmd)
+	list.Sort(false)
+	for _, schedule := range manifest.Schedules {
+		out, err := c.runSavepointSchedule(client, schedule, dryRun, time.Now())
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("failed to run the savepoint schedule for %s: %w", schedule.name(), err))
+		}
+		if out != nil {
+			list.Add(out)
+		}
+	}
+
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func (c *command) runSavepointSchedule(client *flink.CmfRestClient, schedule savepointSchedule, dryRun bool, now time.Time) (*savepointScheduleRunOut, error) {
+	target := schedule.target()
+	out := &savepointScheduleRunOut{
+		Environment: schedule.Environment,
+		Application: schedule.Application,
+		Statement:   schedule.Statement,
+	}
+
+	every, err := parseSavepointAge(schedule.Every)
+	if err != nil {
+		return nil, err
+	}
+
+	savepoints, err := client.ListSavepoint(c.createContext(), schedule.Environment, target.Statement, target.Application, target.Statement != "", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	out.Due = isSavepointDue(savepoints, every, now)
+	if out.Due && !dryRun {
+		savepoint := newSavepoint("", schedule.Path, savepointDefaultFormat, savepointDefaultBackoffLimit)
+
+		var created cmfsdk.Savepoint
+		if target.Statement != "" {
+			created, err = client.CreateSavepointStatement(c.createContext(), savepoint, schedule.Environment, target.Statement)
+		} else {
+			created, err = client.CreateSavepointApplication(c.createContext(), savepoint, schedule.Environment, target.Application)
+		}
+		if err != nil {
+			return nil, err
+		}
+		out.Savepoint = created.Metadata.GetName()
+	}
+
+	policy, err := schedule.policy()
+	if err != nil {
+		return out, err
+	}
+	if policy.KeepLast == 0 && policy.OlderThan == 0 {
+		return out, nil
+	}
+
+	plans, err := c.planSavepointPruneForTarget(client, schedule.Environment, target, policy, 0, now)
+	if err != nil {
+		return out, err
+	}
+	deletions := slices.DeleteFunc(plans, func(plan savepointPrunePlan) bool { return plan.action != savepointPruneActionDelete })
+	if dryRun {
+		out.Deleted = len(deletions)
+		return out, nil
+	}
+
+	out.Deleted, err = c.deletePrunedSavepoints(client, schedule.Environment, deletions)
+	return out, err
+}
+
+// isSavepointDue returns whether no savepoint was taken since the period of a schedule. Failed savepoints are ignored.
+func isSavepointDue(savepoints []cmfsdk.Savepoint, every time.Duration, now time.Time) bool {
+	for _, savepoint := range savepoints {
+		if slices.Contains(savepointFailedStates, savepoint.Status.GetState()) {
+			continue
+		}
+		if created := getSavepointCreationTime(savepoint); !created.IsZero() && now.Sub(created) < every {
+			return false
+		}
+	}
+	return true
+}
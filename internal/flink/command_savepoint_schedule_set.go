@@ -0,0 +1,121 @@
+package flink
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+func (c *command) newSavepointScheduleSetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Set the savepoint schedule of a Flink application or statement.",
+		Long:  "Set how often savepoints of a Flink application or statement are taken, and how long they are retained. An existing schedule of the application or statement is replaced.",
+		Args:  cobra.NoArgs,
+		RunE:  c.savepointScheduleSet,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Take a savepoint of application "orders" every 6 hours, and keep the last 5 savepoints and those taken in the last 14 days.`,
+				Code: "confluent flink savepoint schedule set --environment prod --application orders --every 6h --keep-last 5 --older-than 14d",
+			},
+			examples.Example{
+				Text: `Record the schedule in a manifest shared by a cron job.`,
+				Code: "confluent flink savepoint schedule set --environment prod --statement orders-enriched --every 1d --older-than 30d --file savepoints.yaml",
+			},
+		),
+	}
+
+	cmd.Flags().String("environment", "", "Name of the Flink environment.")
+	cmd.Flags().String("application", "", "The name of the Flink application to take savepoints of.")
+	cmd.Flags().String("statement", "", "The name of the Flink statement to take savepoints of.")
+	cmd.Flags().String("every", "", `How often to take a savepoint, such as "6h" or "1d".`)
+	cmd.Flags().String("path", "", "The directory where the savepoints should be stored.")
+	cmd.Flags().Int("keep-last", 0, "Number of most recent completed savepoints to keep.")
+	cmd.Flags().String("older-than", "", `Only delete savepoints older than this age, such as "12h" or "14d".`)
+	addSavepointScheduleFileFlag(cmd)
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("environment"))
+	cobra.CheckErr(cmd.MarkFlagRequired("every"))
+	cmd.MarkFlagsOneRequired("application", "statement")
+	cmd.MarkFlagsMutuallyExclusive("application", "statement")
+
+	return cmd
+}
+
+func (c *command) savepointScheduleSet(cmd *cobra.Command, _ []string) error {
+	environment, err := cmd.Flags().GetString("environment")
+	if err != nil {
+		return err
+	}
+
+	application, err := cmd.Flags().GetString("application")
+	if err != nil {
+		return err
+	}
+
+	statement, err := cmd.Flags().GetString("statement")
+	if err != nil {
+		return err
+	}
+
+	every, err := cmd.Flags().GetString("every")
+	if err != nil {
+		return err
+	}
+
+	path, err := cmd.Flags().GetString("path")
+	if err != nil {
+		return err
+	}
+
+	keepLast, err := cmd.Flags().GetInt("keep-last")
+	if err != nil {
+		return err
+	}
+
+	olderThan, err := cmd.Flags().GetString("older-than")
+	if err != nil {
+		return err
+	}
+
+	schedule := savepointSchedule{
+		Environment: environment,
+		Application: application,
+		Statement:   statement,
+		Every:       every,
+		Path:        path,
+		KeepLast:    keepLast,
+		OlderThan:   olderThan,
+	}
+
+	if err := schedule.validate(); err != nil {
+		return err
+	}
+
+	file, err := c.getSavepointScheduleFile(cmd)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := readSavepointScheduleManifest(file)
+	if err != nil {
+		return err
+	}
+
+	if i := manifest.index(schedule.Environment, schedule.target()); i != -1 {
+		manifest.Schedules[i] = schedule
+	} else {
+		manifest.Schedules = append(manifest.Schedules, schedule)
+	}
+
+	if err := writeSavepointScheduleManifest(file, manifest); err != nil {
+		return err
+	}
+
+	table := output.NewTable(cmd)
+	table.Add(schedule.toOut())
+	return table.Print()
+}
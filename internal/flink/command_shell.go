@@ -1,7 +1,10 @@
 package flink
 
 import (
+	"fmt"
+	"io"
 	"net/url"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -17,9 +20,21 @@ import (
 	"github.com/confluentinc/cli/v4/pkg/flink/types"
 	"github.com/confluentinc/cli/v4/pkg/jwt"
 	"github.com/confluentinc/cli/v4/pkg/log"
+	"github.com/confluentinc/cli/v4/pkg/output"
 	ppanic "github.com/confluentinc/cli/v4/pkg/panic-recovery"
 )
 
+// Organization ID used for gateways given by URL when not logged in to Confluent Cloud
+const localGatewayOrganizationId = "org-local"
+
+type scriptStatementOut struct {
+	Number    int    `human:"Number" serialized:"number"`
+	Statement string `human:"Statement" serialized:"statement"`
+	Name      string `human:"Name" serialized:"name"`
+	Phase     string `human:"Phase" serialized:"phase"`
+	Error     string `human:"Error" serialized:"error,omitempty"`
+}
+
 func (c *command) newShellCommand(prerunner pcmd.PreRunner, cfg *config.Config) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "shell",
@@ -33,6 +48,26 @@ func (c *command) newShellCommand(prerunner pcmd.PreRunner, cfg *config.Config)
 			examples.Example{
 				Text: "For a Quick Start with examples in context, see https://docs.confluent.io/cloud/current/flink/get-started/quick-start-shell.html.",
 			},
+			examples.Example{
+				Text: `Execute the statements in "migration.sql" and exit.`,
+				Code: "confluent flink shell --file migration.sql",
+			},
+			examples.Example{
+				Text: "Execute statements read from standard input, continuing past failed statements.",
+				Code: "confluent flink shell --file - --continue-on-error < migration.sql",
+			},
+			examples.Example{
+				Text: `Execute the statements in "migration.sql", replacing "${topic}" with "orders" and other variables with the values in "prod.env".`,
+				Code: "confluent flink shell --file migration.sql --var topic=orders --var-file prod.env",
+			},
+			examples.Example{
+				Text: `Execute the statements in "migration.sql" against a local fake gateway, such as one started by package "github.com/confluentinc/cli/v4/pkg/flink/test/gateway".`,
+				Code: "confluent flink shell --gateway-url http://localhost:8080 --file migration.sql",
+			},
+			examples.Example{
+				Text: `Start or resume the session "etl-dev", restoring its properties, compute pool, and statement history.`,
+				Code: "confluent flink shell --session etl-dev",
+			},
 		)
 		cmd.RunE = func(cmd *cobra.Command, args []string) error {
 			return c.startFlinkSqlClient(prerunner, cmd)
@@ -44,6 +79,10 @@ func (c *command) newShellCommand(prerunner pcmd.PreRunner, cfg *config.Config)
 		pcmd.AddContextFlag(cmd, c.CLICommand)
 		pcmd.AddCloudFlag(cmd)
 		pcmd.AddRegionFlagFlink(cmd, c.AuthenticatedCLICommand)
+		cmd.Flags().String("session", "", "Name of the session whose properties, compute pool, and statement history are restored and saved.")
+		addScriptFlags(cmd)
+		addVariableFlags(cmd)
+		addGatewayUrlFlag(cmd)
 
 		if featureflags.Manager.BoolVariation("cli.flink.internal", cfg.Context(), config.CliLaunchDarklyClient, true, false) {
 			cmd.Flags().StringSlice("config-key", []string{}, "App option keys for local mode.")
@@ -55,6 +94,18 @@ func (c *command) newShellCommand(prerunner pcmd.PreRunner, cfg *config.Config)
 			examples.Example{
 				Text: "For a Quick Start with examples in context, see https://docs.confluent.io/cli/current/flink/get-started/quick-start-shell.html.",
 			},
+			examples.Example{
+				Text: `Execute the statements in "migration.sql" and exit.`,
+				Code: "confluent flink shell --environment env1 --file migration.sql",
+			},
+			examples.Example{
+				Text: `Start or resume the session "etl-dev", restoring its properties, compute pool, and statement history.`,
+				Code: "confluent flink shell --environment env1 --session etl-dev",
+			},
+			examples.Example{
+				Text: `Execute the statements in "migration.sql" against a local fake gateway, such as one started by package "github.com/confluentinc/cli/v4/pkg/flink/test/gateway".`,
+				Code: "confluent flink shell --environment env1 --gateway-url http://localhost:8080 --file migration.sql",
+			},
 		)
 		cmd.RunE = func(cmd *cobra.Command, args []string) error {
 			return c.startFlinkSqlClientOnPrem(prerunner, cmd)
@@ -64,15 +115,66 @@ func (c *command) newShellCommand(prerunner pcmd.PreRunner, cfg *config.Config)
 		cmd.Flags().String("catalog", "", "The name of the default catalog.")
 		cmd.Flags().String("database", "", "The name of the default database.")
 		cmd.Flags().String("flink-configuration", "", "The file path to hold the Flink configuration.")
+		cmd.Flags().String("session", "", "Name of the session whose properties, compute pool, and statement history are restored and saved.")
+		addScriptFlags(cmd)
+		addVariableFlags(cmd)
+		addGatewayUrlFlag(cmd)
 		addCmfFlagSet(cmd)
 
+		// A gateway given by URL is used instead of Confluent Manager for Apache Flink, so its flags would be ignored
+		for _, flag := range []string{"url", "client-key-path", "client-cert-path", "certificate-authority-path", "flink-configuration"} {
+			cmd.MarkFlagsMutuallyExclusive("gateway-url", flag)
+		}
+
 		cobra.CheckErr(cmd.MarkFlagRequired("environment"))
 	}
 
 	return cmd
 }
 
-func (c *command) authenticated(authenticated func(*cobra.Command, []string) error, cmd *cobra.Command, jwtValidator jwt.Validator) func() error {
+func addScriptFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("file", "f", "", `Path to a SQL script whose statements are executed in order instead of starting the interactive shell. Use "-" to read the script from standard input.`)
+	cmd.Flags().Bool("continue-on-error", false, "Continue executing the SQL script after a statement fails.")
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "sql"))
+}
+
+func addGatewayUrlFlag(cmd *cobra.Command) {
+	cmd.Flags().String("gateway-url", "", "URL of a Flink SQL gateway to connect to instead of Confluent Cloud or Confluent Platform, such as a local fake gateway for testing. No credentials are sent to the gateway.")
+	cmd.MarkFlagsMutuallyExclusive("gateway-url", "session")
+}
+
+func addVariableFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("var", []string{}, `A comma-separated list of SQL variables in the form "name=value", which replace "${name}" in SQL.`)
+	cmd.Flags().String("var-file", "", `Path to an env file of "name=value" lines defining SQL variables. Variables of "--var" take precedence.`)
+	cobra.CheckErr(cmd.MarkFlagFilename("var-file", "env"))
+}
+
+func getVariables(cmd *cobra.Command) (map[string]string, error) {
+	vars, err := cmd.Flags().GetStringSlice("var")
+	if err != nil {
+		return nil, err
+	}
+
+	varFile, err := cmd.Flags().GetString("var-file")
+	if err != nil {
+		return nil, err
+	}
+
+	return client.LoadSQLVariables(vars, varFile)
+}
+
+// substituteVariables replaces the variables of a SQL statement before it is submitted, so that no statement is
+// submitted with unresolved variables.
+func substituteVariables(cmd *cobra.Command, sql string) (string, error) {
+	vars, err := getVariables(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	return client.SubstituteSQLVariables(sql, vars)
+}
+
+func (c *command) authenticated(authenticated func(*cobra.Command, []string) error, cmd *cobra.Command, flinkGatewayClient *ccloudv2.FlinkGatewayClient, jwtValidator jwt.Validator) func() error {
 	return func() error {
 		authToken := c.Context.GetAuthToken()
 		authRefreshToken := c.Context.GetAuthRefreshToken()
@@ -84,22 +186,6 @@ func (c *command) authenticated(authenticated func(*cobra.Command, []string) err
 			return err
 		}
 
-		var flinkGatewayClient *ccloudv2.FlinkGatewayClient
-		var errClient error
-		computePool := c.Context.GetCurrentFlinkComputePool()
-
-		if computePool == "" {
-			flinkGatewayClient, errClient = c.GetFlinkGatewayClient(false)
-			if errClient != nil {
-				return errClient
-			}
-		} else {
-			flinkGatewayClient, errClient = c.GetFlinkGatewayClient(true)
-			if errClient != nil {
-				return errClient
-			}
-		}
-
 		jwtCtx := &config.Context{State: &config.ContextState{AuthToken: flinkGatewayClient.AuthToken}}
 		if tokenErr := jwtValidator.Validate(jwtCtx); tokenErr != nil {
 			dataplaneToken, err := auth.GetDataplaneToken(c.Context)
@@ -140,6 +226,10 @@ func (c *command) authenticatedOnPrem(authenticated func(*cobra.Command, []strin
 }
 
 func (c *command) startFlinkSqlClient(prerunner pcmd.PreRunner, cmd *cobra.Command) error {
+	if cmd.Flags().Changed("gateway-url") {
+		return c.startWithGatewayUrl(cmd)
+	}
+
 	if featureflags.Manager.BoolVariation("cli.flink.internal", c.Context, config.CliLaunchDarklyClient, true, false) {
 		// get config keys and values from flags
 		configKeys, err := cmd.Flags().GetStringSlice("config-key")
@@ -171,7 +261,19 @@ func (c *command) startFlinkSqlClient(prerunner pcmd.PreRunner, cmd *cobra.Comma
 		environmentId = c.Context.GetCurrentEnvironment()
 	}
 
+	sessionName, err := cmd.Flags().GetString("session")
+	if err != nil {
+		return err
+	}
+	shellSession, err := client.LoadSession(sessionName, c.Context.GetOrganization().GetResourceId(), environmentId, false)
+	if err != nil {
+		return err
+	}
+
 	catalog := c.Context.GetCurrentFlinkCatalog()
+	if shellSession.GetCatalog() != "" {
+		catalog = shellSession.GetCatalog()
+	}
 	if catalog == "" {
 		environment, _, err := c.V2Client.GetOrgEnvironment(environmentId)
 		if err != nil {
@@ -189,7 +291,11 @@ func (c *command) startFlinkSqlClient(prerunner pcmd.PreRunner, cmd *cobra.Comma
 		return err
 	}
 
+	// The compute pool of the session only applies to this shell, not to the current context
 	computePool := c.Context.GetCurrentFlinkComputePool()
+	if !cmd.Flags().Changed("compute-pool") && shellSession.GetComputePoolId() != "" {
+		computePool = shellSession.GetComputePoolId()
+	}
 	if computePool == "" {
 		if cloud == "" || region == "" {
 			return errors.New("Flink cloud and region flags are required when compute pool is not specified.")
@@ -209,7 +315,9 @@ func (c *command) startFlinkSqlClient(prerunner pcmd.PreRunner, cmd *cobra.Comma
 		return err
 	}
 	if database == "" {
-		if c.Context.GetCurrentFlinkDatabase() != "" {
+		if shellSession.GetDatabase() != "" {
+			database = shellSession.GetDatabase()
+		} else if c.Context.GetCurrentFlinkDatabase() != "" {
 			database = c.Context.GetCurrentFlinkDatabase()
 		} else {
 			database = c.Context.KafkaClusterContext.GetActiveKafkaClusterConfig().GetName()
@@ -228,7 +336,7 @@ func (c *command) startFlinkSqlClient(prerunner pcmd.PreRunner, cmd *cobra.Comma
 			return err
 		}
 	} else {
-		flinkGatewayClient, err = c.GetFlinkGatewayClient(true)
+		flinkGatewayClient, err = c.GetFlinkGatewayClientForComputePool(computePool)
 		if err != nil {
 			return err
 		}
@@ -244,6 +352,11 @@ func (c *command) startFlinkSqlClient(prerunner pcmd.PreRunner, cmd *cobra.Comma
 
 	verbose, _ := cmd.Flags().GetCount("verbose")
 
+	variables, err := getVariables(cmd)
+	if err != nil {
+		return err
+	}
+
 	opts := types.ApplicationOptions{
 		Cloud:            true,
 		Context:          c.Context,
@@ -257,12 +370,30 @@ func (c *command) startFlinkSqlClient(prerunner pcmd.PreRunner, cmd *cobra.Comma
 		ServiceAccountId: serviceAccount,
 		Verbose:          verbose > 0,
 		LSPBaseUrl:       lspBaseUrl,
+		Session:          shellSession,
+		Variables:        variables,
+	}
+	if shellSession != nil {
+		shellSession.ComputePoolId = computePool
+	}
+	opts.ValidateComputePool = func(computePoolId string) error {
+		return c.validateShellComputePool(flinkGatewayClient, computePoolId)
+	}
+
+	if cmd.Flags().Changed("file") {
+		return c.runSqlScript(cmd, func(script string, continueOnError bool) ([]client.ScriptStatementResult, error) {
+			return client.RunScript(flinkGatewayClient, c.authenticated(prerunner.Authenticated(c.AuthenticatedCLICommand), cmd, flinkGatewayClient, jwtValidator), opts, script, continueOnError)
+		})
 	}
 
-	return client.StartApp(flinkGatewayClient, c.authenticated(prerunner.Authenticated(c.AuthenticatedCLICommand), cmd, jwtValidator), opts, reportUsage(cmd, c.Config, unsafeTrace))
+	return client.StartApp(flinkGatewayClient, c.authenticated(prerunner.Authenticated(c.AuthenticatedCLICommand), cmd, flinkGatewayClient, jwtValidator), opts, reportUsage(cmd, c.Config, unsafeTrace))
 }
 
 func (c *command) startFlinkSqlClientOnPrem(prerunner pcmd.PreRunner, cmd *cobra.Command) error {
+	if cmd.Flags().Changed("gateway-url") {
+		return c.startWithGatewayUrl(cmd)
+	}
+
 	environment, err := cmd.Flags().GetString("environment")
 	if err != nil {
 		return err
@@ -283,6 +414,24 @@ func (c *command) startFlinkSqlClientOnPrem(prerunner pcmd.PreRunner, cmd *cobra
 		return err
 	}
 
+	sessionName, err := cmd.Flags().GetString("session")
+	if err != nil {
+		return err
+	}
+	shellSession, err := client.LoadSession(sessionName, "", environment, true)
+	if err != nil {
+		return err
+	}
+	if computePool == "" {
+		computePool = shellSession.GetComputePoolId()
+	}
+	if catalog == "" {
+		catalog = shellSession.GetCatalog()
+	}
+	if database == "" {
+		database = shellSession.GetDatabase()
+	}
+
 	unsafeTrace, err := c.Command.Flags().GetBool("unsafe-trace")
 	if err != nil {
 		return err
@@ -301,6 +450,11 @@ func (c *command) startFlinkSqlClientOnPrem(prerunner pcmd.PreRunner, cmd *cobra
 
 	verbose, _ := cmd.Flags().GetCount("verbose")
 
+	variables, err := getVariables(cmd)
+	if err != nil {
+		return err
+	}
+
 	opts := types.ApplicationOptions{
 		Cloud:              false,
 		Context:            c.Context,
@@ -312,6 +466,17 @@ func (c *command) startFlinkSqlClientOnPrem(prerunner pcmd.PreRunner, cmd *cobra
 		ComputePoolId:      computePool,
 		FlinkConfiguration: flinkConfiguration,
 		Verbose:            verbose > 0,
+		Session:            shellSession,
+		Variables:          variables,
+	}
+	if shellSession != nil {
+		shellSession.ComputePoolId = computePool
+	}
+
+	if cmd.Flags().Changed("file") {
+		return c.runSqlScript(cmd, func(script string, continueOnError bool) ([]client.ScriptStatementResult, error) {
+			return client.RunScriptOnPrem(flinkCmfClient, c.authenticatedOnPrem(prerunner.AuthenticatedWithMDS(c.AuthenticatedCLICommand), cmd), opts, script, continueOnError)
+		})
 	}
 
 	return client.StartAppOnPrem(flinkCmfClient, c.authenticatedOnPrem(prerunner.AuthenticatedWithMDS(c.AuthenticatedCLICommand), cmd), opts)
@@ -336,6 +501,156 @@ func (c *command) startWithLocalMode(configKeys, configValues []string) error {
 	return client.StartApp(gatewayClient, func() error { return nil }, *appOptions, func() {})
 }
 
+// startWithGatewayUrl starts the shell against a Flink SQL gateway given by URL rather than one of Confluent Cloud or
+// Confluent Platform. Neither credentials nor the current context are used, so that the shell can be tested offline.
+func (c *command) startWithGatewayUrl(cmd *cobra.Command) error {
+	gatewayUrl, err := cmd.Flags().GetString("gateway-url")
+	if err != nil {
+		return err
+	}
+
+	environmentId, err := cmd.Flags().GetString("environment")
+	if err != nil {
+		return err
+	}
+	if environmentId == "" {
+		environmentId = c.Context.GetCurrentEnvironment()
+	}
+	if environmentId == "" {
+		return errors.NewErrorWithSuggestions("no environment provided", "Provide an environment with `--environment`.")
+	}
+
+	computePool, err := cmd.Flags().GetString("compute-pool")
+	if err != nil {
+		return err
+	}
+
+	database, err := cmd.Flags().GetString("database")
+	if err != nil {
+		return err
+	}
+
+	// Confluent Cloud has no catalog flag, since the catalog is the environment
+	catalog := environmentId
+	if cmd.Flags().Lookup("catalog") != nil {
+		if catalog, err = cmd.Flags().GetString("catalog"); err != nil {
+			return err
+		}
+	}
+
+	organizationId := c.Context.GetOrganization().GetResourceId()
+	if organizationId == "" {
+		organizationId = localGatewayOrganizationId
+	}
+
+	unsafeTrace, err := c.Command.Flags().GetBool("unsafe-trace")
+	if err != nil {
+		return err
+	}
+
+	variables, err := getVariables(cmd)
+	if err != nil {
+		return err
+	}
+
+	verbose, _ := cmd.Flags().GetCount("verbose")
+
+	opts := types.ApplicationOptions{
+		Cloud:           true,
+		UnsafeTrace:     unsafeTrace,
+		UserAgent:       c.Version.UserAgent,
+		EnvironmentName: catalog,
+		EnvironmentId:   environmentId,
+		OrganizationId:  organizationId,
+		Database:        database,
+		ComputePoolId:   computePool,
+		Verbose:         verbose > 0,
+		GatewayUrl:      gatewayUrl,
+		Variables:       variables,
+	}
+
+	gatewayClient := ccloudv2.NewFlinkGatewayClient(gatewayUrl, c.Version.UserAgent, unsafeTrace, "authToken")
+
+	if cmd.Flags().Changed("file") {
+		return c.runSqlScript(cmd, func(script string, continueOnError bool) ([]client.ScriptStatementResult, error) {
+			return client.RunScript(gatewayClient, func() error { return nil }, opts, script, continueOnError)
+		})
+	}
+
+	return client.StartApp(gatewayClient, func() error { return nil }, opts, func() {})
+}
+
+func (c *command) runSqlScript(cmd *cobra.Command, run func(string, bool) ([]client.ScriptStatementResult, error)) error {
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	continueOnError, err := cmd.Flags().GetBool("continue-on-error")
+	if err != nil {
+		return err
+	}
+
+	var script []byte
+	if file == "-" {
+		script, err = io.ReadAll(os.Stdin)
+	} else {
+		script, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return err
+	}
+
+	results, err := run(string(script), continueOnError)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	list := output.NewList(cmd)
+	for i, result := range results {
+		out := &scriptStatementOut{
+			Number:    i + 1,
+			Statement: result.Statement,
+			Name:      result.StatementName,
+			Phase:     string(result.Status),
+		}
+		if result.Error != nil {
+			failed++
+			out.Error = result.Error.Message
+			if result.Error.FailureMessage != "" {
+				out.Error += ": " + result.Error.FailureMessage
+			}
+		}
+		list.Add(out)
+	}
+	list.Sort(false)
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d executed statements failed", failed, len(results))
+	}
+	return nil
+}
+
+// validateShellComputePool returns an error if the statements of a compute pool cannot be sent to the Flink gateway of the shell
+func (c *command) validateShellComputePool(flinkGatewayClient *ccloudv2.FlinkGatewayClient, computePoolId string) error {
+	url, err := c.GetFlinkGatewayUrlForComputePool(computePoolId)
+	if err != nil {
+		return err
+	}
+
+	if cfg := flinkGatewayClient.GetConfig(); cfg != nil && len(cfg.Servers) > 0 && cfg.Servers[0].URL != url {
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf(`compute pool "%s" is in a different cloud provider or region than the current compute pool`, computePoolId),
+			"Exit the shell and start a new one with `confluent flink shell --session`.",
+		)
+	}
+	return nil
+}
+
 func (c *command) getFlinkLanguageServiceUrl(gatewayClient *ccloudv2.FlinkGatewayClient) (string, error) {
 	if cfg := gatewayClient.GetConfig(); cfg != nil && len(cfg.Servers) > 0 {
 		gatewayUrl := cfg.Servers[0].URL
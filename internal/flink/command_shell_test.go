@@ -5,6 +5,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	cmfsdk "github.com/confluentinc/cmf-sdk-go/v1"
 
@@ -71,3 +72,18 @@ func TestAuthenticatedOnPrem_IsOnPremLogin(t *testing.T) {
 
 	assert.Equal(t, c.createContext(), cmfContext)
 }
+
+func TestShellOnPremGatewayUrlExcludesCmfFlags(t *testing.T) {
+	cfg := config.AuthenticatedOnPremConfigMock()
+	c := &command{AuthenticatedCLICommand: &pcmd.AuthenticatedCLICommand{CLICommand: &pcmd.CLICommand{Config: cfg}}}
+
+	for _, flag := range []string{"url", "client-key-path", "client-cert-path", "certificate-authority-path", "flink-configuration"} {
+		cmd := c.newShellCommand(nil, cfg)
+		require.NoError(t, cmd.ParseFlags([]string{"--environment", "env1", "--gateway-url", "http://localhost:8080", "--" + flag, "value"}))
+		assert.Error(t, cmd.ValidateFlagGroups(), flag)
+	}
+
+	cmd := c.newShellCommand(nil, cfg)
+	require.NoError(t, cmd.ParseFlags([]string{"--environment", "env1", "--gateway-url", "http://localhost:8080", "--catalog", "default"}))
+	assert.NoError(t, cmd.ValidateFlagGroups())
+}
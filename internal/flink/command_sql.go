@@ -0,0 +1,40 @@
+package flink
+
+import (
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+)
+
+type sqlCommand struct {
+	*pcmd.CLICommand
+}
+
+func newSqlCommand(prerunner pcmd.PreRunner) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sql",
+		Short: "Format and lint Flink SQL scripts.",
+		Long:  "Format and lint Flink SQL scripts locally. These commands do not require you to log in, so they can be run in pre-commit hooks and CI pipelines.",
+	}
+
+	c := &sqlCommand{pcmd.NewAnonymousCLICommand(cmd, prerunner)}
+
+	cmd.AddCommand(c.newFmtCommand())
+	cmd.AddCommand(c.newLintCommand())
+
+	return cmd
+}
+
+func readSqlScript(file string) (string, error) {
+	var script []byte
+	var err error
+	if file == "-" {
+		script, err = io.ReadAll(os.Stdin)
+	} else {
+		script, err = os.ReadFile(file)
+	}
+	return string(script), err
+}
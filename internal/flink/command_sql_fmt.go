@@ -0,0 +1,99 @@
+package flink
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	client "github.com/confluentinc/cli/v4/pkg/flink/app"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+func (c *sqlCommand) newFmtCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fmt <file-1> [file-2] ... [file-n]",
+		Short: "Format Flink SQL scripts.",
+		Long:  `Format Flink SQL scripts canonically: keywords are uppercased, each clause starts on a new line, subqueries and the column and option lists of CREATE TABLE statements are indented, and statements are separated by a blank line. Use "-" to read a script from standard input.`,
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  c.format,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Print the formatted contents of "pipeline.sql".`,
+				Code: "confluent flink sql fmt pipeline.sql",
+			},
+			examples.Example{
+				Text: "Format all SQL scripts in the current directory in place.",
+				Code: "confluent flink sql fmt --write *.sql",
+			},
+			examples.Example{
+				Text: "Fail if any SQL script in the current directory is not formatted, such as in a pre-commit hook.",
+				Code: "confluent flink sql fmt --check *.sql",
+			},
+		),
+	}
+
+	cmd.Flags().Bool("write", false, "Write the formatted SQL back to each file instead of printing it.")
+	cmd.Flags().Bool("check", false, "List the files which are not formatted instead of printing them, and fail if there are any.")
+
+	cmd.MarkFlagsMutuallyExclusive("write", "check")
+
+	return cmd
+}
+
+func (c *sqlCommand) format(cmd *cobra.Command, args []string) error {
+	write, err := cmd.Flags().GetBool("write")
+	if err != nil {
+		return err
+	}
+
+	check, err := cmd.Flags().GetBool("check")
+	if err != nil {
+		return err
+	}
+
+	var unformatted []string
+	for _, file := range args {
+		if write && file == "-" {
+			return fmt.Errorf("cannot write the formatted SQL from standard input back to a file")
+		}
+
+		script, err := readSqlScript(file)
+		if err != nil {
+			return err
+		}
+
+		formatted := client.FormatSQL(script)
+		switch {
+		case check:
+			if formatted != script {
+				unformatted = append(unformatted, file)
+				output.Println(false, file)
+			}
+		case write:
+			if formatted != script {
+				info, err := os.Stat(file)
+				if err != nil {
+					return err
+				}
+				if err := os.WriteFile(file, []byte(formatted), info.Mode()); err != nil {
+					return err
+				}
+				output.Printf(c.Config.EnableColor, "Formatted \"%s\".\n", file)
+			}
+		default:
+			output.Print(false, formatted)
+		}
+	}
+
+	if len(unformatted) > 0 {
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf("%d of %d files are not formatted", len(unformatted), len(args)),
+			"Format them with `confluent flink sql fmt --write`.",
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package flink
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	client "github.com/confluentinc/cli/v4/pkg/flink/app"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+type lintIssueOut struct {
+	File    string `human:"File" serialized:"file"`
+	Line    int    `human:"Line" serialized:"line"`
+	Column  int    `human:"Column" serialized:"column"`
+	Rule    string `human:"Rule" serialized:"rule"`
+	Message string `human:"Message" serialized:"message"`
+}
+
+func (c *sqlCommand) newLintCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint <file-1> [file-2] ... [file-n]",
+		Short: "Check Flink SQL scripts for common mistakes.",
+		Long: "Check Flink SQL scripts for common mistakes, and fail if any are found:\n\n" +
+			"- unterminated-string, unterminated-identifier, unterminated-comment: A string literal, quoted identifier, or comment is not closed.\n" +
+			"- missing-semicolon: The last statement is not terminated by a semicolon.\n" +
+			"- select-star-insert: An INSERT INTO statement uses `SELECT *`, which breaks when the schema of its source changes.\n" +
+			"- unbounded-join: A join is not bounded by time on inputs with watermarks, so its state grows without limit. Tables created in the same script are checked for watermarks.\n\n" +
+			`Use "-" to read a script from standard input.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: c.lint,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Check all SQL scripts in the current directory.",
+				Code: "confluent flink sql lint *.sql",
+			},
+		),
+	}
+
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *sqlCommand) lint(cmd *cobra.Command, args []string) error {
+	var scripts []string
+	for _, file := range args {
+		script, err := readSqlScript(file)
+		if err != nil {
+			return err
+		}
+		scripts = append(scripts, script)
+	}
+
+	failed := 0
+	list := output.NewList(cmd)
+	for i, script := range scripts {
+		issues := client.LintSQL(script)
+		if len(issues) > 0 {
+			failed++
+		}
+		for _, issue := range issues {
+			list.Add(&lintIssueOut{
+				File:    args[i],
+				Line:    issue.Line,
+				Column:  issue.Column,
+				Rule:    issue.Rule,
+				Message: issue.Message,
+			})
+		}
+	}
+	list.Sort(false)
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d files have issues", failed, len(args))
+	}
+	return nil
+}
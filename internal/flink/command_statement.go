@@ -23,14 +23,18 @@ func (c *command) newStatementCommand() *cobra.Command {
 		Short: "Manage Flink SQL statements in Confluent Cloud.",
 	}
 
+	cmd.AddCommand(c.newStatementApplyCommand())
 	cmd.AddCommand(c.newStatementCreateCommand())
 	cmd.AddCommand(c.newStatementDeleteCommand())
 	cmd.AddCommand(c.newStatementDescribeCommand())
 	cmd.AddCommand(c.newStatementExceptionCommand())
+	cmd.AddCommand(c.newStatementLineageCommand())
 	cmd.AddCommand(c.newStatementListCommand())
+	cmd.AddCommand(c.newStatementResultsCommand())
 	cmd.AddCommand(c.newStatementResumeCommand())
 	cmd.AddCommand(c.newStatementStopCommand())
 	cmd.AddCommand(c.newStatementUpdateCommand())
+	cmd.AddCommand(c.newStatementWatchCommand())
 
 	return cmd
 }
@@ -0,0 +1,464 @@
+package flink
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	flinkgatewayv1 "github.com/confluentinc/ccloud-sdk-go-v2/flink-gateway/v1"
+
+	"github.com/confluentinc/cli/v4/pkg/ccloudv2"
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/flink/config"
+	"github.com/confluentinc/cli/v4/pkg/output"
+	"github.com/confluentinc/cli/v4/pkg/resource"
+	"github.com/confluentinc/cli/v4/pkg/retry"
+)
+
+const (
+	statementApplyActionCreate  = "create"
+	statementApplyActionUpdate  = "update"
+	statementApplyActionReplace = "replace"
+	statementApplyActionNone    = "none"
+)
+
+var statementManifestNameRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+type statementManifest struct {
+	Statements []statementManifestEntry `json:"statements" yaml:"statements"`
+}
+
+type statementManifestEntry struct {
+	Name        string            `json:"name" yaml:"name"`
+	Sql         string            `json:"sql" yaml:"sql"`
+	ComputePool string            `json:"compute_pool" yaml:"compute_pool"`
+	Principal   string            `json:"principal" yaml:"principal"`
+	Properties  map[string]string `json:"properties" yaml:"properties"`
+	Stopped     bool              `json:"stopped" yaml:"stopped"`
+}
+
+type statementApplyPlan struct {
+	entry    statementManifestEntry
+	action   string
+	name     string
+	previous *flinkgatewayv1.SqlV1Statement
+	changes  []string
+}
+
+type statementApplyOut struct {
+	Name              string   `human:"Name" serialized:"name"`
+	Action            string   `human:"Action" serialized:"action"`
+	Statement         string   `human:"Statement" serialized:"statement"`
+	PreviousStatement string   `human:"Previous Statement,omitempty" serialized:"previous_statement,omitempty"`
+	Changes           []string `human:"Changes,omitempty" serialized:"changes,omitempty"`
+}
+
+func (c *command) newStatementApplyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Deploy Flink SQL statements from a manifest.",
+		Long: "Deploy the Flink SQL statements described in a YAML or JSON manifest, and bring existing statements in line with it.\n\n" +
+			`Each statement in the manifest has a "name", "sql", and optionally a "compute_pool", "principal", "properties", and "stopped". ` +
+			`The statement is deployed as "<name>-<hash>", where the hash is derived from its SQL and properties, so applying the same manifest again is a no-op. ` +
+			"A statement whose compute pool, principal, or stopped state differs from the manifest is updated in place. " +
+			"A statement whose SQL or properties differ is replaced: the previous statement is stopped, a new statement is started from its latest offsets, and the previous statement is deleted once the new one is running. If the new statement fails to start, it is deleted and the previous statement is resumed. A statement without a catalog or database in its properties keeps those of the statement it replaces.",
+		Args: cobra.NoArgs,
+		RunE: c.statementApply,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Preview the changes needed to deploy the statements in "pipelines.yaml".`,
+				Code: "confluent flink statement apply --file pipelines.yaml --dry-run",
+			},
+			examples.Example{
+				Text: `Deploy the statements in "pipelines.yaml".`,
+				Code: "confluent flink statement apply --file pipelines.yaml",
+			},
+		),
+	}
+
+	cmd.Flags().StringP("file", "f", "", "Path to a YAML or JSON manifest of Flink SQL statements.")
+	pcmd.AddDryRunFlag(cmd)
+	pcmd.AddComputePoolFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddCloudFlag(cmd)
+	pcmd.AddRegionFlagFlink(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("file"))
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "yaml", "yml", "json"))
+
+	return cmd
+}
+
+func (c *command) statementApply(cmd *cobra.Command, _ []string) error {
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	manifest, err := readStatementManifest(file)
+	if err != nil {
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	environmentId, err := c.Context.EnvironmentId()
+	if err != nil {
+		return err
+	}
+
+	environment, _, err := c.V2Client.GetOrgEnvironment(environmentId)
+	if err != nil {
+		return errors.NewErrorWithSuggestions(err.Error(), "List available environments with `confluent environment list`.")
+	}
+
+	client, err := c.GetFlinkGatewayClient(c.Context.GetCurrentFlinkComputePool() != "")
+	if err != nil {
+		return err
+	}
+
+	statements, err := client.ListStatements(environmentId, c.Context.GetCurrentOrganization(), "")
+	if err != nil {
+		return err
+	}
+
+	for i := range manifest.Statements {
+		if manifest.Statements[i].ComputePool == "" {
+			manifest.Statements[i].ComputePool = c.Context.GetCurrentFlinkComputePool()
+		}
+	}
+
+	plans := planStatementApply(manifest, statements, environment.GetDisplayName())
+
+	errs := &multierror.Error{ErrorFormat: errors.CustomMultierrorList}
+	list := output.NewList(cmd)
+	for _, plan := range plans {
+		if !dryRun {
+			if err := c.executeStatementApplyPlan(client, environmentId, plan); err != nil {
+				errs = multierror.Append(errs, fmt.Errorf(`failed to %s %s "%s": %w`, plan.action, resource.FlinkStatement, plan.entry.Name, err))
+				continue
+			}
+		}
+
+		out := &statementApplyOut{
+			Name:      plan.entry.Name,
+			Action:    plan.action,
+			Statement: plan.name,
+			Changes:   plan.changes,
+		}
+		if plan.action == statementApplyActionReplace {
+			out.PreviousStatement = plan.previous.GetName()
+		}
+		list.Add(out)
+	}
+
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func readStatementManifest(path string) (*statementManifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	manifest := new(statementManifest)
+	ext := filepath.Ext(path)
+	switch ext {
+	case ".json":
+		err = json.Unmarshal(data, manifest)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, manifest)
+	default:
+		return nil, errors.NewErrorWithSuggestions(fmt.Sprintf("unsupported file format: %s", ext), "Supported file formats are .json, .yaml, and .yml.")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse input file: %w", err)
+	}
+
+	if len(manifest.Statements) == 0 {
+		return nil, fmt.Errorf(`no statements found in "%s"`, path)
+	}
+
+	var names []string
+	for i, entry := range manifest.Statements {
+		if !statementManifestNameRegex.MatchString(entry.Name) {
+			return nil, fmt.Errorf(`invalid statement name "%s": names must consist of lowercase alphanumeric characters and hyphens`, entry.Name)
+		}
+		if slices.Contains(names, entry.Name) {
+			return nil, fmt.Errorf(`statement "%s" is defined more than once`, entry.Name)
+		}
+		if entry.Sql == "" {
+			return nil, fmt.Errorf(`statement "%s" has no SQL`, entry.Name)
+		}
+		if entry.Properties == nil {
+			manifest.Statements[i].Properties = map[string]string{}
+		}
+		names = append(names, entry.Name)
+	}
+
+	return manifest, nil
+}
+
+// getStatementApplyName returns the name a manifest entry is deployed as. The suffix only depends on the SQL and
+// properties, which cannot be updated in place, so a change to either results in a new statement.
+func getStatementApplyName(entry statementManifestEntry) string {
+	hash := sha256.New()
+	hash.Write([]byte(entry.Sql))
+
+	keys := make([]string, 0, len(entry.Properties))
+	for key := range entry.Properties {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		_, _ = fmt.Fprintf(hash, "\x00%s=%s", key, entry.Properties[key])
+	}
+
+	return fmt.Sprintf("%s-%x", entry.Name, hash.Sum(nil)[:4])
+}
+
+// planStatementApply plans the action for each statement in the manifest. A statement without a catalog or database
+// keeps those of the latest statement deployed for it, and otherwise defaults to the catalog of the environment.
+func planStatementApply(manifest *statementManifest, statements []flinkgatewayv1.SqlV1Statement, defaultCatalog string) []statementApplyPlan {
+	plans := make([]statementApplyPlan, len(manifest.Statements))
+	for i, entry := range manifest.Statements {
+		deployed := regexp.MustCompile(fmt.Sprintf(`^%s-[0-9a-f]{8}$`, regexp.QuoteMeta(entry.Name)))
+		var deployedStatements []flinkgatewayv1.SqlV1Statement
+		var latest *flinkgatewayv1.SqlV1Statement
+		for _, statement := range statements {
+			if !deployed.MatchString(statement.GetName()) {
+				continue
+			}
+			deployedStatements = append(deployedStatements, statement)
+			if latest == nil || statement.Metadata.GetCreatedAt().After(latest.Metadata.GetCreatedAt()) {
+				latest = &statement
+			}
+		}
+
+		for _, key := range []string{config.KeyCatalog, config.KeyDatabase} {
+			if _, ok := entry.Properties[key]; ok || latest == nil {
+				continue
+			}
+			if value, ok := latest.Spec.GetProperties()[key]; ok {
+				entry.Properties[key] = value
+			}
+		}
+		if _, ok := entry.Properties[config.KeyCatalog]; !ok && defaultCatalog != "" {
+			entry.Properties[config.KeyCatalog] = defaultCatalog
+		}
+
+		plan := statementApplyPlan{entry: entry, name: getStatementApplyName(entry), previous: latest}
+		for _, statement := range deployedStatements {
+			if statement.GetName() == plan.name {
+				plan.previous = &statement
+				break
+			}
+		}
+
+		switch {
+		case plan.previous == nil:
+			plan.action = statementApplyActionCreate
+		case plan.previous.GetName() != plan.name:
+			plan.action = statementApplyActionReplace
+		default:
+			if entry.ComputePool != "" && entry.ComputePool != plan.previous.Spec.GetComputePoolId() {
+				plan.changes = append(plan.changes, "compute_pool")
+			}
+			if entry.Principal != "" && entry.Principal != plan.previous.Spec.GetPrincipal() {
+				plan.changes = append(plan.changes, "principal")
+			}
+			if entry.Stopped != plan.previous.Spec.GetStopped() {
+				plan.changes = append(plan.changes, "stopped")
+			}
+
+			plan.action = statementApplyActionNone
+			if len(plan.changes) > 0 {
+				plan.action = statementApplyActionUpdate
+			}
+		}
+
+		plans[i] = plan
+	}
+	return plans
+}
+
+func (c *command) executeStatementApplyPlan(client *ccloudv2.FlinkGatewayClient, environmentId string, plan statementApplyPlan) error {
+	switch plan.action {
+	case statementApplyActionCreate:
+		_, err := c.createApplyStatement(client, environmentId, plan.entry, plan.name, "")
+		return err
+	case statementApplyActionUpdate:
+		return c.updateApplyStatement(client, environmentId, plan.entry, plan.name)
+	case statementApplyActionReplace:
+		return c.replaceApplyStatement(client, environmentId, plan)
+	}
+	return nil
+}
+
+func (c *command) createApplyStatement(client *ccloudv2.FlinkGatewayClient, environmentId string, entry statementManifestEntry, name, initialOffsetFrom string) (flinkgatewayv1.SqlV1Statement, error) {
+	properties := make(map[string]string, len(entry.Properties)+1)
+	for key, value := range entry.Properties {
+		properties[key] = value
+	}
+	if _, ok := properties[config.KeyInitialOffsetFrom]; !ok && initialOffsetFrom != "" {
+		properties[config.KeyInitialOffsetFrom] = initialOffsetFrom
+	}
+
+	statement := flinkgatewayv1.SqlV1Statement{
+		Name: flinkgatewayv1.PtrString(name),
+		Spec: &flinkgatewayv1.SqlV1StatementSpec{
+			Statement:  flinkgatewayv1.PtrString(entry.Sql),
+			Properties: &properties,
+			Stopped:    flinkgatewayv1.PtrBool(entry.Stopped),
+		},
+	}
+	if entry.ComputePool != "" {
+		statement.Spec.ComputePoolId = flinkgatewayv1.PtrString(entry.ComputePool)
+	}
+
+	principal := entry.Principal
+	if principal == "" {
+		principal = c.Context.GetUser().GetResourceId()
+	}
+
+	return client.CreateStatement(statement, principal, environmentId, c.Context.GetCurrentOrganization())
+}
+
+func (c *command) updateApplyStatement(client *ccloudv2.FlinkGatewayClient, environmentId string, entry statementManifestEntry, name string) error {
+	statement, err := client.GetStatement(environmentId, name, c.Context.GetCurrentOrganization())
+	if err != nil {
+		return err
+	}
+
+	// The compute pool and principal of a statement can only be changed while it is stopped
+	if !statement.Spec.GetStopped() && (entry.ComputePool != "" && entry.ComputePool != statement.Spec.GetComputePoolId() || entry.Principal != "" && entry.Principal != statement.Spec.GetPrincipal()) {
+		if statement, err = c.stopApplyStatement(client, environmentId, statement); err != nil {
+			return err
+		}
+	}
+
+	if entry.ComputePool != "" {
+		statement.Spec.SetComputePoolId(entry.ComputePool)
+	}
+	if entry.Principal != "" {
+		statement.Spec.SetPrincipal(entry.Principal)
+	}
+	statement.Spec.SetStopped(entry.Stopped)
+
+	return client.UpdateStatement(environmentId, name, c.Context.GetCurrentOrganization(), statement)
+}
+
+func (c *command) replaceApplyStatement(client *ccloudv2.FlinkGatewayClient, environmentId string, plan statementApplyPlan) error {
+	previous, err := client.GetStatement(environmentId, plan.previous.GetName(), c.Context.GetCurrentOrganization())
+	if err != nil {
+		return err
+	}
+
+	// Stop the previous statement first so that its latest offsets are final when the new statement starts from them
+	wasStopped := previous.Spec.GetStopped()
+	if !wasStopped {
+		if previous, err = c.stopApplyStatement(client, environmentId, previous); err != nil {
+			return err
+		}
+	}
+
+	if _, err := c.createApplyStatement(client, environmentId, plan.entry, plan.name, previous.GetName()); err != nil {
+		return c.resumePreviousApplyStatement(client, environmentId, previous, wasStopped, err)
+	}
+
+	if err := c.waitForReplacementApplyStatement(client, environmentId, plan.name); err != nil {
+		// The replacement is deleted before the previous statement is resumed, so that they never both write to the
+		// same sinks, and so that applying the manifest again retries it
+		if deleteErr := client.DeleteStatement(environmentId, plan.name, c.Context.GetCurrentOrganization()); deleteErr != nil {
+			return fmt.Errorf(`%w; failed to delete %s "%s", so previous %s "%s" was not resumed: %v`, err, resource.FlinkStatement, plan.name, resource.FlinkStatement, previous.GetName(), deleteErr)
+		}
+		return c.resumePreviousApplyStatement(client, environmentId, previous, wasStopped, err)
+	}
+
+	return client.DeleteStatement(environmentId, previous.GetName(), c.Context.GetCurrentOrganization())
+}
+
+// resumePreviousApplyStatement rolls back to the previous statement after its replacement failed, so that the pipeline
+// keeps running until the manifest is fixed.
+func (c *command) resumePreviousApplyStatement(client *ccloudv2.FlinkGatewayClient, environmentId string, previous flinkgatewayv1.SqlV1Statement, wasStopped bool, err error) error {
+	if !wasStopped {
+		if resumeErr := c.resumeApplyStatement(client, environmentId, previous); resumeErr != nil {
+			return fmt.Errorf(`%w; failed to resume previous %s "%s": %v`, err, resource.FlinkStatement, previous.GetName(), resumeErr)
+		}
+	}
+	return err
+}
+
+// waitForReplacementApplyStatement waits for the statement which replaces the previous one to start.
+func (c *command) waitForReplacementApplyStatement(client *ccloudv2.FlinkGatewayClient, environmentId, name string) error {
+	var statement flinkgatewayv1.SqlV1Statement
+	err := retry.Retry(time.Second, time.Minute, func() error {
+		var err error
+		statement, err = client.GetStatement(environmentId, name, c.Context.GetCurrentOrganization())
+		if err != nil {
+			return err
+		}
+
+		if statement.Status.GetPhase() == "PENDING" {
+			return fmt.Errorf(`statement phase is "%s"`, statement.Status.GetPhase())
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf(`%s "%s" did not start: %w`, resource.FlinkStatement, name, err)
+	}
+
+	if statement.Status.GetPhase() == "FAILED" {
+		return fmt.Errorf(`%s "%s" failed: %s`, resource.FlinkStatement, name, statement.Status.GetDetail())
+	}
+
+	return nil
+}
+
+func (c *command) resumeApplyStatement(client *ccloudv2.FlinkGatewayClient, environmentId string, statement flinkgatewayv1.SqlV1Statement) error {
+	statement.Spec.SetStopped(false)
+	return client.UpdateStatement(environmentId, statement.GetName(), c.Context.GetCurrentOrganization(), statement)
+}
+
+func (c *command) stopApplyStatement(client *ccloudv2.FlinkGatewayClient, environmentId string, statement flinkgatewayv1.SqlV1Statement) (flinkgatewayv1.SqlV1Statement, error) {
+	statement.Spec.SetStopped(true)
+	if err := client.UpdateStatement(environmentId, statement.GetName(), c.Context.GetCurrentOrganization(), statement); err != nil {
+		return flinkgatewayv1.SqlV1Statement{}, err
+	}
+
+	err := retry.Retry(time.Second, time.Minute, func() error {
+		var err error
+		statement, err = client.GetStatement(environmentId, statement.GetName(), c.Context.GetCurrentOrganization())
+		if err != nil {
+			return err
+		}
+
+		if phase := statement.Status.GetPhase(); !slices.Contains([]string{"STOPPED", "COMPLETED", "FAILED"}, phase) {
+			return fmt.Errorf(`statement phase is "%s"`, phase)
+		}
+
+		return nil
+	})
+	return statement, err
+}
@@ -0,0 +1,156 @@
+package flink
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	flinkgatewayv1 "github.com/confluentinc/ccloud-sdk-go-v2/flink-gateway/v1"
+
+	"github.com/confluentinc/cli/v4/pkg/ccloudv2"
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/config"
+	flinkconfig "github.com/confluentinc/cli/v4/pkg/flink/config"
+	"github.com/confluentinc/cli/v4/pkg/flink/test/gateway"
+)
+
+func TestReadStatementManifest(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "pipelines.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("statements:\n  - name: orders\n    sql: INSERT INTO a SELECT * FROM b;\n"), 0600))
+	manifest, err := readStatementManifest(path)
+	require.NoError(t, err)
+	require.Len(t, manifest.Statements, 1)
+	require.Equal(t, "orders", manifest.Statements[0].Name)
+	require.NotNil(t, manifest.Statements[0].Properties)
+
+	path = filepath.Join(dir, "duplicate.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("statements:\n  - name: orders\n    sql: a\n  - name: orders\n    sql: b\n"), 0600))
+	_, err = readStatementManifest(path)
+	require.EqualError(t, err, `statement "orders" is defined more than once`)
+
+	path = filepath.Join(dir, "invalid.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"statements": [{"name": "Orders", "sql": "a"}]}`), 0600))
+	_, err = readStatementManifest(path)
+	require.EqualError(t, err, `invalid statement name "Orders": names must consist of lowercase alphanumeric characters and hyphens`)
+}
+
+func TestGetStatementApplyName(t *testing.T) {
+	entry := statementManifestEntry{Name: "orders", Sql: "SELECT 1;", Properties: map[string]string{"a": "1", "b": "2"}}
+	name := getStatementApplyName(entry)
+	require.Regexp(t, `^orders-[0-9a-f]{8}$`, name)
+
+	entry.ComputePool = "lfcp-123456"
+	entry.Stopped = true
+	require.Equal(t, name, getStatementApplyName(entry))
+
+	entry.Properties = map[string]string{"a": "1", "b": "3"}
+	require.NotEqual(t, name, getStatementApplyName(entry))
+}
+
+func TestPlanStatementApply(t *testing.T) {
+	entries := []statementManifestEntry{
+		{Name: "orders", Sql: "SELECT 1;", Properties: map[string]string{}},
+		{Name: "payments", Sql: "SELECT 2;", ComputePool: "lfcp-222222", Properties: map[string]string{}},
+		{Name: "refunds", Sql: "SELECT 3;", Properties: map[string]string{}},
+		{Name: "shipments", Sql: "SELECT 4;", Properties: map[string]string{}},
+	}
+
+	newStatement := func(name, computePool string, createdAt time.Time) flinkgatewayv1.SqlV1Statement {
+		return flinkgatewayv1.SqlV1Statement{
+			Name:     flinkgatewayv1.PtrString(name),
+			Spec:     &flinkgatewayv1.SqlV1StatementSpec{ComputePoolId: flinkgatewayv1.PtrString(computePool)},
+			Metadata: &flinkgatewayv1.StatementObjectMeta{CreatedAt: flinkgatewayv1.PtrTime(createdAt)},
+		}
+	}
+
+	statements := []flinkgatewayv1.SqlV1Statement{
+		newStatement(getStatementApplyName(entries[1]), "lfcp-111111", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		newStatement(getStatementApplyName(entries[2]), "lfcp-111111", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		newStatement("shipments-00000000", "lfcp-111111", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		newStatement("shipments-11111111", "lfcp-111111", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)),
+		newStatement("shipments-backfill", "lfcp-111111", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	plans := planStatementApply(&statementManifest{Statements: entries}, statements, "")
+	require.Len(t, plans, 4)
+
+	require.Equal(t, statementApplyActionCreate, plans[0].action)
+	require.Nil(t, plans[0].previous)
+
+	require.Equal(t, statementApplyActionUpdate, plans[1].action)
+	require.Equal(t, []string{"compute_pool"}, plans[1].changes)
+
+	require.Equal(t, statementApplyActionNone, plans[2].action)
+
+	require.Equal(t, statementApplyActionReplace, plans[3].action)
+	require.Equal(t, "shipments-11111111", plans[3].previous.GetName())
+}
+
+func TestPlanStatementApplyKeepsCatalogAndDatabase(t *testing.T) {
+	deployedEntry := statementManifestEntry{Name: "orders", Sql: "SELECT 1;", Properties: map[string]string{flinkconfig.KeyCatalog: "my-environment", flinkconfig.KeyDatabase: "my-cluster"}}
+	previous := flinkgatewayv1.SqlV1Statement{
+		Name:     flinkgatewayv1.PtrString(getStatementApplyName(deployedEntry)),
+		Spec:     &flinkgatewayv1.SqlV1StatementSpec{Properties: &map[string]string{flinkconfig.KeyCatalog: "my-environment", flinkconfig.KeyDatabase: "my-cluster"}},
+		Metadata: &flinkgatewayv1.StatementObjectMeta{CreatedAt: flinkgatewayv1.PtrTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))},
+	}
+
+	entries := []statementManifestEntry{
+		{Name: "orders", Sql: "SELECT 1;", Properties: map[string]string{}},
+		{Name: "payments", Sql: "SELECT 2;", Properties: map[string]string{}},
+	}
+	plans := planStatementApply(&statementManifest{Statements: entries}, []flinkgatewayv1.SqlV1Statement{previous}, "default-environment")
+
+	require.Equal(t, statementApplyActionNone, plans[0].action)
+	require.Equal(t, previous.GetName(), plans[0].name)
+
+	require.Equal(t, statementApplyActionCreate, plans[1].action)
+	require.Equal(t, map[string]string{flinkconfig.KeyCatalog: "default-environment"}, plans[1].entry.Properties)
+}
+
+func TestReplaceApplyStatementResumesPreviousStatement(t *testing.T) {
+	server := gateway.NewServer()
+	defer server.Close()
+
+	previousSql := "INSERT INTO orders SELECT * FROM source;"
+	sql := "INSERT INTO orders SELECT * FROM missing;"
+	server.Respond(previousSql, gateway.Response{Phase: "RUNNING"})
+	server.Respond(sql, gateway.Response{Phase: "FAILED", StatusDetail: "Table 'missing' not found."})
+
+	cfg := config.AuthenticatedCloudConfigMock()
+	cfg.Context().LastOrgId = "org-local"
+	c := &command{AuthenticatedCLICommand: &pcmd.AuthenticatedCLICommand{
+		CLICommand: &pcmd.CLICommand{Config: cfg},
+		Context:    cfg.Context(),
+	}}
+	environmentId := "env-123456"
+	organizationId := c.Context.GetCurrentOrganization()
+	client := ccloudv2.NewFlinkGatewayClient(server.URL, "", false, "authToken")
+
+	previous, err := client.CreateStatement(flinkgatewayv1.SqlV1Statement{
+		Name: flinkgatewayv1.PtrString("orders-00000000"),
+		Spec: &flinkgatewayv1.SqlV1StatementSpec{Statement: flinkgatewayv1.PtrString(previousSql)},
+	}, "sa-123456", environmentId, organizationId)
+	require.NoError(t, err)
+	previous, err = client.GetStatement(environmentId, previous.GetName(), organizationId)
+	require.NoError(t, err)
+	require.Equal(t, "RUNNING", previous.Status.GetPhase())
+
+	entry := statementManifestEntry{Name: "orders", Sql: sql, Principal: "sa-123456", Properties: map[string]string{}}
+	plan := statementApplyPlan{entry: entry, action: statementApplyActionReplace, name: getStatementApplyName(entry), previous: &previous}
+	err = c.replaceApplyStatement(client, environmentId, plan)
+	require.EqualError(t, err, fmt.Sprintf(`Flink SQL statement "%s" failed: Table 'missing' not found.`, plan.name))
+
+	previous, err = client.GetStatement(environmentId, previous.GetName(), organizationId)
+	require.NoError(t, err)
+	require.False(t, previous.Spec.GetStopped())
+	require.Equal(t, "RUNNING", previous.Status.GetPhase())
+
+	_, err = client.GetStatement(environmentId, plan.name, organizationId)
+	require.Error(t, err)
+}
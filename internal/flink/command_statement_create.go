@@ -34,10 +34,15 @@ func (c *command) newStatementCreateCommand() *cobra.Command {
 				Text: `Create a Flink SQL statement named "my-statement" in compute pool "lfcp-123456" with service account "sa-123456", using Kafka cluster "my-cluster" as the default database, and with additional properties.`,
 				Code: `confluent flink statement create my-statement --sql "SELECT * FROM my-topic;" --compute-pool lfcp-123456 --service-account sa-123456 --database my-cluster --property property1=value1,property2=value2`,
 			},
+			examples.Example{
+				Text: `Create a Flink SQL statement which reads from the topic given by variable "topic".`,
+				Code: `confluent flink statement create --sql 'SELECT * FROM ${topic};' --var topic=orders`,
+			},
 		),
 	}
 
 	cmd.Flags().String("sql", "", "The Flink SQL statement.")
+	addVariableFlags(cmd)
 	pcmd.AddComputePoolFlag(cmd, c.AuthenticatedCLICommand)
 	pcmd.AddServiceAccountFlag(cmd, c.AuthenticatedCLICommand)
 	c.addDatabaseFlag(cmd)
@@ -90,6 +95,10 @@ func (c *command) statementCreate(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	sql, err = substituteVariables(cmd, sql)
+	if err != nil {
+		return err
+	}
 
 	database, err := cmd.Flags().GetString("database")
 	if err != nil {
@@ -29,6 +29,7 @@ func (c *command) newStatementCreateCommandOnPrem() *cobra.Command {
 	}
 
 	cmd.Flags().String("sql", "", "The Flink SQL statement.")
+	addVariableFlags(cmd)
 	cmd.Flags().String("environment", "", "Name of the Flink environment.")
 	cmd.Flags().String("compute-pool", "", "The compute pool name to execute the Flink SQL statement.")
 	cmd.Flags().Uint16("parallelism", 1, "The parallelism the statement, default value is 1.")
@@ -62,6 +63,10 @@ func (c *command) statementCreateOnPrem(cmd *cobra.Command, args []string) error
 	if err != nil {
 		return err
 	}
+	sql, err = substituteVariables(cmd, sql)
+	if err != nil {
+		return err
+	}
 
 	environment, err := cmd.Flags().GetString("environment")
 	if err != nil {
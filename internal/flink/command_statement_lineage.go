@@ -0,0 +1,444 @@
+package flink
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	flinkgatewayv1 "github.com/confluentinc/ccloud-sdk-go-v2/flink-gateway/v1"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	client "github.com/confluentinc/cli/v4/pkg/flink/app"
+	"github.com/confluentinc/cli/v4/pkg/output"
+	"github.com/confluentinc/cli/v4/pkg/utils"
+)
+
+const (
+	lineageFormatAscii   = "ascii"
+	lineageFormatDot     = "dot"
+	lineageFormatMermaid = "mermaid"
+)
+
+var allowedLineageFormats = []string{lineageFormatAscii, lineageFormatDot, lineageFormatMermaid}
+
+// Statements in these phases no longer move data and are highlighted in the lineage graph
+var inactiveStatementPhases = []string{"STOPPED", "FAILED", "FAILING"}
+
+type lineageImpactOut struct {
+	Type   string `human:"Type" serialized:"type"`
+	Name   string `human:"Name" serialized:"name"`
+	Status string `human:"Status,omitempty" serialized:"status,omitempty"`
+	Reason string `human:"Reason" serialized:"reason"`
+}
+
+type lineageStatement struct {
+	name    string
+	phase   string
+	sources []string
+	sinks   []string
+}
+
+type lineageGraph struct {
+	statements map[string]lineageStatement
+	// readers and writers map each table to the names of the statements which read from and write to it
+	readers map[string][]string
+	writers map[string][]string
+}
+
+func (c *command) newStatementLineageCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lineage",
+		Short: "Show how Flink SQL statements are connected through the tables they read and write.",
+		Long:  "Show how Flink SQL statements are connected through the tables they read and write. The SQL of each statement is parsed to find its source and sink tables, which are matched by their unqualified names. Stopped and failed statements are highlighted.",
+		Args:  cobra.NoArgs,
+		RunE:  c.statementLineage,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Render the lineage of all statements as a Mermaid flowchart.",
+				Code: "confluent flink statement lineage --format mermaid",
+			},
+			examples.Example{
+				Text: `List the statements and tables affected by stopping statement "enrich-orders".`,
+				Code: "confluent flink statement lineage --stop-statement enrich-orders",
+			},
+			examples.Example{
+				Text: `List the statements and tables affected by dropping table "orders".`,
+				Code: "confluent flink statement lineage --drop-table orders",
+			},
+		),
+	}
+
+	cmd.Flags().String("format", lineageFormatAscii, fmt.Sprintf("Specify the format of the lineage graph as %s.", utils.ArrayToCommaDelimitedString(allowedLineageFormats, "or")))
+	cmd.Flags().String("stop-statement", "", "List the statements and tables affected by stopping this statement instead of rendering the lineage graph.")
+	cmd.Flags().String("drop-table", "", "List the statements and tables affected by dropping this table instead of rendering the lineage graph.")
+	pcmd.AddCloudFlag(cmd)
+	pcmd.AddRegionFlagFlink(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddComputePoolFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	pcmd.RegisterFlagCompletionFunc(cmd, "format", func(*cobra.Command, []string) []string { return allowedLineageFormats })
+
+	cmd.MarkFlagsMutuallyExclusive("format", "stop-statement", "drop-table")
+
+	return cmd
+}
+
+func (c *command) statementLineage(cmd *cobra.Command, _ []string) error {
+	format, err := cmd.Flags().GetString("format")
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(allowedLineageFormats, format) {
+		return fmt.Errorf("invalid format %q, must be one of %s", format, utils.ArrayToCommaDelimitedString(allowedLineageFormats, "or"))
+	}
+
+	stopStatement, err := cmd.Flags().GetString("stop-statement")
+	if err != nil {
+		return err
+	}
+
+	dropTable, err := cmd.Flags().GetString("drop-table")
+	if err != nil {
+		return err
+	}
+
+	flinkClient, err := c.GetFlinkGatewayClient(false)
+	if err != nil {
+		return err
+	}
+
+	environmentId, err := c.Context.EnvironmentId()
+	if err != nil {
+		return err
+	}
+
+	computePoolId := c.Context.GetCurrentFlinkComputePool()
+	if err := c.validateProvidedComputePool(environmentId, computePoolId); err != nil {
+		return err
+	}
+
+	statements, err := flinkClient.ListStatements(environmentId, c.Context.GetCurrentOrganization(), computePoolId)
+	if err != nil {
+		return err
+	}
+
+	graph := newLineageGraph(getLineageStatements(statements))
+
+	if stopStatement != "" || dropTable != "" {
+		impact, err := graph.getImpact(stopStatement, dropTable)
+		if err != nil {
+			return err
+		}
+
+		list := output.NewList(cmd)
+		for _, out := range impact {
+			list.Add(out)
+		}
+		list.Sort(false)
+		return list.Print()
+	}
+
+	switch format {
+	case lineageFormatDot:
+		output.Print(false, graph.renderDot())
+	case lineageFormatMermaid:
+		output.Print(false, graph.renderMermaid())
+	default:
+		output.Print(false, graph.renderAscii())
+	}
+	return nil
+}
+
+// getLineageStatements parses the SQL of each statement, leaving out statements which neither read nor write tables.
+func getLineageStatements(statements []flinkgatewayv1.SqlV1Statement) []lineageStatement {
+	var lineageStatements []lineageStatement
+	for _, statement := range statements {
+		sources, sinks := client.GetSQLTables(statement.Spec.GetStatement())
+		if len(sources) == 0 && len(sinks) == 0 {
+			continue
+		}
+		lineageStatements = append(lineageStatements, lineageStatement{
+			name:    statement.GetName(),
+			phase:   statement.Status.GetPhase(),
+			sources: sources,
+			sinks:   sinks,
+		})
+	}
+	return lineageStatements
+}
+
+func newLineageGraph(statements []lineageStatement) *lineageGraph {
+	graph := &lineageGraph{
+		statements: map[string]lineageStatement{},
+		readers:    map[string][]string{},
+		writers:    map[string][]string{},
+	}
+
+	for _, statement := range statements {
+		graph.statements[statement.name] = statement
+		for _, table := range statement.sources {
+			graph.readers[table] = append(graph.readers[table], statement.name)
+		}
+		for _, table := range statement.sinks {
+			graph.writers[table] = append(graph.writers[table], statement.name)
+		}
+	}
+
+	for _, names := range graph.readers {
+		slices.Sort(names)
+	}
+	for _, names := range graph.writers {
+		slices.Sort(names)
+	}
+
+	return graph
+}
+
+func (g *lineageGraph) getStatementNames() []string {
+	var names []string
+	for name := range g.statements {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func (g *lineageGraph) getTables() []string {
+	var tables []string
+	for table := range g.readers {
+		tables = append(tables, table)
+	}
+	for table := range g.writers {
+		if _, ok := g.readers[table]; !ok {
+			tables = append(tables, table)
+		}
+	}
+	slices.Sort(tables)
+	return tables
+}
+
+func isInactiveStatement(phase string) bool {
+	return slices.Contains(inactiveStatementPhases, strings.ToUpper(phase))
+}
+
+// renderAscii renders the graph as trees which start at the tables that no statement writes to. Nodes which were
+// already rendered are referred to instead of being expanded again.
+func (g *lineageGraph) renderAscii() string {
+	if len(g.statements) == 0 {
+		return "No statements read from or write to tables.\n"
+	}
+
+	var roots []string
+	for _, table := range g.getTables() {
+		if len(g.writers[table]) == 0 {
+			roots = append(roots, "table:"+table)
+		}
+	}
+	for _, name := range g.getStatementNames() {
+		if len(g.statements[name].sources) == 0 {
+			roots = append(roots, "statement:"+name)
+		}
+	}
+	// Nodes in cycles are not reachable from any root
+	for _, name := range g.getStatementNames() {
+		roots = append(roots, "statement:"+name)
+	}
+
+	var sb strings.Builder
+	visited := map[string]bool{}
+	for _, root := range roots {
+		if visited[root] {
+			continue
+		}
+		g.renderAsciiNode(&sb, root, "", "", visited)
+	}
+	return sb.String()
+}
+
+func (g *lineageGraph) renderAsciiNode(sb *strings.Builder, node, prefix, childPrefix string, visited map[string]bool) {
+	kind, name, _ := strings.Cut(node, ":")
+
+	var children []string
+	label := name
+	if kind == "statement" {
+		statement := g.statements[name]
+		label = fmt.Sprintf("%s [%s]", name, statement.phase)
+		if isInactiveStatement(statement.phase) {
+			label += " (!)"
+		}
+		for _, table := range statement.sinks {
+			children = append(children, "table:"+table)
+		}
+	} else {
+		for _, reader := range g.readers[name] {
+			children = append(children, "statement:"+reader)
+		}
+	}
+
+	if visited[node] {
+		if len(children) > 0 {
+			label += " (see above)"
+		}
+		sb.WriteString(prefix + label + "\n")
+		return
+	}
+	visited[node] = true
+	sb.WriteString(prefix + label + "\n")
+
+	for i, child := range children {
+		if i == len(children)-1 {
+			g.renderAsciiNode(sb, child, childPrefix+"└── ", childPrefix+"    ", visited)
+		} else {
+			g.renderAsciiNode(sb, child, childPrefix+"├── ", childPrefix+"│   ", visited)
+		}
+	}
+}
+
+// renderDot renders the graph in the Graphviz DOT language.
+func (g *lineageGraph) renderDot() string {
+	var sb strings.Builder
+	sb.WriteString("digraph lineage {\n")
+	sb.WriteString("  rankdir=LR;\n")
+
+	for _, table := range g.getTables() {
+		fmt.Fprintf(&sb, "  %q [label=%q, shape=box];\n", "table:"+table, table)
+	}
+	for _, name := range g.getStatementNames() {
+		statement := g.statements[name]
+		attributes := fmt.Sprintf("label=%q, shape=ellipse", name+"\n"+statement.phase)
+		if isInactiveStatement(statement.phase) {
+			attributes += `, style=filled, fillcolor="#f8d7da", color="#d00000"`
+		}
+		fmt.Fprintf(&sb, "  %q [%s];\n", "statement:"+name, attributes)
+	}
+
+	for _, name := range g.getStatementNames() {
+		statement := g.statements[name]
+		for _, table := range statement.sources {
+			fmt.Fprintf(&sb, "  %q -> %q;\n", "table:"+table, "statement:"+name)
+		}
+		for _, table := range statement.sinks {
+			fmt.Fprintf(&sb, "  %q -> %q;\n", "statement:"+name, "table:"+table)
+		}
+	}
+
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// renderMermaid renders the graph as a Mermaid flowchart.
+func (g *lineageGraph) renderMermaid() string {
+	ids := map[string]string{}
+	for i, table := range g.getTables() {
+		ids["table:"+table] = fmt.Sprintf("t%d", i+1)
+	}
+	for i, name := range g.getStatementNames() {
+		ids["statement:"+name] = fmt.Sprintf("s%d", i+1)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("flowchart LR\n")
+
+	for _, table := range g.getTables() {
+		fmt.Fprintf(&sb, "  %s[(\"%s\")]\n", ids["table:"+table], escapeMermaidLabel(table))
+	}
+
+	var inactive []string
+	for _, name := range g.getStatementNames() {
+		statement := g.statements[name]
+		fmt.Fprintf(&sb, "  %s[\"%s<br/>%s\"]\n", ids["statement:"+name], escapeMermaidLabel(name), statement.phase)
+		if isInactiveStatement(statement.phase) {
+			inactive = append(inactive, ids["statement:"+name])
+		}
+	}
+
+	for _, name := range g.getStatementNames() {
+		statement := g.statements[name]
+		for _, table := range statement.sources {
+			fmt.Fprintf(&sb, "  %s --> %s\n", ids["table:"+table], ids["statement:"+name])
+		}
+		for _, table := range statement.sinks {
+			fmt.Fprintf(&sb, "  %s --> %s\n", ids["statement:"+name], ids["table:"+table])
+		}
+	}
+
+	if len(inactive) > 0 {
+		sb.WriteString("  classDef inactive fill:#f8d7da,stroke:#d00000\n")
+		fmt.Fprintf(&sb, "  class %s inactive\n", strings.Join(inactive, ","))
+	}
+
+	return sb.String()
+}
+
+func escapeMermaidLabel(label string) string {
+	return strings.ReplaceAll(label, `"`, "#quot;")
+}
+
+// getImpact lists what breaks when a statement is stopped or a table is dropped: the tables which are no longer
+// written to, and the statements downstream of them, in the order in which the impact spreads.
+func (g *lineageGraph) getImpact(stopStatement, dropTable string) ([]*lineageImpactOut, error) {
+	var impact []*lineageImpactOut
+	visited := map[string]bool{}
+	var queue []string
+
+	addStatement := func(name, reason string) {
+		if visited["statement:"+name] {
+			return
+		}
+		visited["statement:"+name] = true
+		impact = append(impact, &lineageImpactOut{Type: "statement", Name: name, Status: g.statements[name].phase, Reason: reason})
+		queue = append(queue, name)
+	}
+
+	if stopStatement != "" {
+		if _, ok := g.statements[stopStatement]; !ok {
+			return nil, errors.NewErrorWithSuggestions(
+				fmt.Sprintf(`statement "%s" does not read from or write to any table`, stopStatement),
+				"List statements with `confluent flink statement list`.",
+			)
+		}
+		visited["statement:"+stopStatement] = true
+		queue = append(queue, stopStatement)
+	} else {
+		if len(g.readers[dropTable]) == 0 && len(g.writers[dropTable]) == 0 {
+			return nil, fmt.Errorf(`no statement reads from or writes to table "%s"`, dropTable)
+		}
+		visited["table:"+dropTable] = true
+		for _, name := range g.readers[dropTable] {
+			addStatement(name, fmt.Sprintf(`Reads from dropped table "%s".`, dropTable))
+		}
+		for _, name := range g.writers[dropTable] {
+			addStatement(name, fmt.Sprintf(`Writes to dropped table "%s".`, dropTable))
+		}
+	}
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		for _, table := range g.statements[name].sinks {
+			if visited["table:"+table] {
+				continue
+			}
+			visited["table:"+table] = true
+
+			reason := fmt.Sprintf(`No longer written to by "%s".`, name)
+			if writers := len(g.writers[table]); writers > 1 {
+				reason = fmt.Sprintf(`No longer written to by "%s", but still by %d other statement(s).`, name, writers-1)
+			}
+			impact = append(impact, &lineageImpactOut{Type: "table", Name: table, Reason: reason})
+
+			for _, reader := range g.readers[table] {
+				addStatement(reader, fmt.Sprintf(`Reads from "%s".`, table))
+			}
+		}
+	}
+
+	return impact, nil
+}
@@ -0,0 +1,108 @@
+package flink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLineageGraph() *lineageGraph {
+	return newLineageGraph([]lineageStatement{
+		{name: "enrich-orders", phase: "RUNNING", sources: []string{"customers", "orders"}, sinks: []string{"enriched_orders"}},
+		{name: "order-totals", phase: "FAILED", sources: []string{"enriched_orders"}, sinks: []string{"totals"}},
+		{name: "order-alerts", phase: "RUNNING", sources: []string{"enriched_orders", "totals"}, sinks: []string{"alerts"}},
+	})
+}
+
+func TestLineageGraphRenderAscii(t *testing.T) {
+	expected := "customers\n" +
+		"└── enrich-orders [RUNNING]\n" +
+		"    └── enriched_orders\n" +
+		"        ├── order-alerts [RUNNING]\n" +
+		"        │   └── alerts\n" +
+		"        └── order-totals [FAILED] (!)\n" +
+		"            └── totals\n" +
+		"                └── order-alerts [RUNNING] (see above)\n" +
+		"orders\n" +
+		"└── enrich-orders [RUNNING] (see above)\n"
+	require.Equal(t, expected, newTestLineageGraph().renderAscii())
+
+	require.Equal(t, "No statements read from or write to tables.\n", newLineageGraph(nil).renderAscii())
+}
+
+func TestLineageGraphRenderDot(t *testing.T) {
+	expected := `digraph lineage {
+  rankdir=LR;
+  "table:alerts" [label="alerts", shape=box];
+  "table:customers" [label="customers", shape=box];
+  "table:enriched_orders" [label="enriched_orders", shape=box];
+  "table:orders" [label="orders", shape=box];
+  "table:totals" [label="totals", shape=box];
+  "statement:enrich-orders" [label="enrich-orders\nRUNNING", shape=ellipse];
+  "statement:order-alerts" [label="order-alerts\nRUNNING", shape=ellipse];
+  "statement:order-totals" [label="order-totals\nFAILED", shape=ellipse, style=filled, fillcolor="#f8d7da", color="#d00000"];
+  "table:customers" -> "statement:enrich-orders";
+  "table:orders" -> "statement:enrich-orders";
+  "statement:enrich-orders" -> "table:enriched_orders";
+  "table:enriched_orders" -> "statement:order-alerts";
+  "table:totals" -> "statement:order-alerts";
+  "statement:order-alerts" -> "table:alerts";
+  "table:enriched_orders" -> "statement:order-totals";
+  "statement:order-totals" -> "table:totals";
+}
+`
+	require.Equal(t, expected, newTestLineageGraph().renderDot())
+}
+
+func TestLineageGraphRenderMermaid(t *testing.T) {
+	expected := `flowchart LR
+  t1[("alerts")]
+  t2[("customers")]
+  t3[("enriched_orders")]
+  t4[("orders")]
+  t5[("totals")]
+  s1["enrich-orders<br/>RUNNING"]
+  s2["order-alerts<br/>RUNNING"]
+  s3["order-totals<br/>FAILED"]
+  t2 --> s1
+  t4 --> s1
+  s1 --> t3
+  t3 --> s2
+  t5 --> s2
+  s2 --> t1
+  t3 --> s3
+  s3 --> t5
+  classDef inactive fill:#f8d7da,stroke:#d00000
+  class s3 inactive
+`
+	require.Equal(t, expected, newTestLineageGraph().renderMermaid())
+}
+
+func TestLineageGraphGetImpact(t *testing.T) {
+	graph := newTestLineageGraph()
+
+	impact, err := graph.getImpact("order-totals", "")
+	require.NoError(t, err)
+	require.Equal(t, []*lineageImpactOut{
+		{Type: "table", Name: "totals", Reason: `No longer written to by "order-totals".`},
+		{Type: "statement", Name: "order-alerts", Status: "RUNNING", Reason: `Reads from "totals".`},
+		{Type: "table", Name: "alerts", Reason: `No longer written to by "order-alerts".`},
+	}, impact)
+
+	impact, err = graph.getImpact("", "orders")
+	require.NoError(t, err)
+	require.Equal(t, []*lineageImpactOut{
+		{Type: "statement", Name: "enrich-orders", Status: "RUNNING", Reason: `Reads from dropped table "orders".`},
+		{Type: "table", Name: "enriched_orders", Reason: `No longer written to by "enrich-orders".`},
+		{Type: "statement", Name: "order-alerts", Status: "RUNNING", Reason: `Reads from "enriched_orders".`},
+		{Type: "statement", Name: "order-totals", Status: "FAILED", Reason: `Reads from "enriched_orders".`},
+		{Type: "table", Name: "alerts", Reason: `No longer written to by "order-alerts".`},
+		{Type: "table", Name: "totals", Reason: `No longer written to by "order-totals".`},
+	}, impact)
+
+	_, err = graph.getImpact("unknown", "")
+	require.EqualError(t, err, `statement "unknown" does not read from or write to any table`)
+
+	_, err = graph.getImpact("", "unknown")
+	require.EqualError(t, err, `no statement reads from or writes to table "unknown"`)
+}
@@ -39,6 +39,7 @@ func (c *command) newStatementCommandOnPrem() *cobra.Command {
 	cmd.AddCommand(c.newStatementRescaleCommandOnPrem())
 	cmd.AddCommand(c.newStatementResumeCommandOnPrem())
 	cmd.AddCommand(c.newStatementStopCommandOnPrem())
+	cmd.AddCommand(c.newStatementWatchCommandOnPrem())
 	cmd.AddCommand(c.newStatementWebUiForwardCommand())
 
 	return cmd
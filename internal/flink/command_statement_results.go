@@ -0,0 +1,136 @@
+package flink
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	client "github.com/confluentinc/cli/v4/pkg/flink/app"
+	"github.com/confluentinc/cli/v4/pkg/flink/types"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+type statementResultOut struct {
+	Operation string         `human:"Operation" serialized:"operation"`
+	Row       []string       `human:"Row" serialized:"row_text"`
+	Values    map[string]any `human:"Values" serialized:"row"`
+}
+
+func (c *command) newStatementResultsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "results <name>",
+		Short:             "Fetch the results of a Flink SQL statement.",
+		Long:              "Fetch the results of a Flink SQL statement, including the changelog operation of each row. Results are fetched page by page until the last page is reached, the maximum number of rows is fetched, or a running statement has no more results available.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validStatementArgs),
+		RunE:              c.statementResults,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Print the results of statement "my-statement".`,
+				Code: "confluent flink statement results my-statement",
+			},
+			examples.Example{
+				Text: `Export the first 1000 rows of the results of statement "my-statement" to a Parquet file.`,
+				Code: "confluent flink statement results my-statement --max-rows 1000 --output-file results.parquet",
+			},
+		),
+	}
+
+	cmd.Flags().String("output-file", "", `Export the results to a CSV, JSON Lines, or Parquet file, based on the ".csv", ".jsonl", or ".parquet" file extension.`)
+	cmd.Flags().Int("max-rows", client.MaxStatementResults, "Stop fetching results once this many rows have been fetched.")
+	cmd.Flags().Bool("force", false, "Overwrite the output file if it already exists.")
+	pcmd.AddCloudFlag(cmd)
+	pcmd.AddRegionFlagFlink(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagFilename("output-file", "csv", "jsonl", "parquet"))
+
+	return cmd
+}
+
+func (c *command) statementResults(cmd *cobra.Command, args []string) error {
+	outputFile, err := cmd.Flags().GetString("output-file")
+	if err != nil {
+		return err
+	}
+	force, err := cmd.Flags().GetBool("force")
+	if err != nil {
+		return err
+	}
+	if outputFile != "" {
+		if err := client.ValidateExportFile(outputFile); err != nil {
+			return err
+		}
+		if _, err := os.Stat(outputFile); err == nil && !force {
+			return errors.NewErrorWithSuggestions(fmt.Sprintf(`file "%s" already exists`, outputFile), "Use `--force` to overwrite the file.")
+		}
+	}
+
+	maxRows, err := cmd.Flags().GetInt("max-rows")
+	if err != nil {
+		return err
+	}
+	if maxRows < 1 || maxRows > client.MaxStatementResults {
+		return fmt.Errorf("`--max-rows` must be between 1 and %d", client.MaxStatementResults)
+	}
+
+	environmentId, err := c.Context.EnvironmentId()
+	if err != nil {
+		return err
+	}
+
+	flinkGatewayClient, err := c.GetFlinkGatewayClient(false)
+	if err != nil {
+		return err
+	}
+
+	opts := types.ApplicationOptions{
+		Cloud:          true,
+		Context:        c.Context,
+		UserAgent:      c.Version.UserAgent,
+		EnvironmentId:  environmentId,
+		OrganizationId: c.Context.GetCurrentOrganization(),
+	}
+
+	materializedResults, err := client.FetchStatementResults(flinkGatewayClient, opts, args[0], maxRows)
+	if err != nil {
+		return err
+	}
+
+	if outputFile != "" {
+		rowCount, err := client.ExportStatementResults(outputFile, materializedResults, force)
+		if err != nil {
+			return err
+		}
+		output.Printf(c.Config.EnableColor, client.ExportedResultsMsg, rowCount, outputFile)
+		return nil
+	}
+
+	columns := client.GetUniqueColumnNames(materializedResults.GetColumnNames())
+	list := output.NewList(cmd)
+	materializedResults.ForEachChangelogRow(func(_ int, row types.StatementResultRow) {
+		out := &statementResultOut{
+			Operation: row.Operation.String(),
+			Row:       make([]string, len(row.Fields)),
+			Values:    make(map[string]any, len(row.Fields)),
+		}
+		for idx, field := range row.Fields {
+			out.Row[idx] = fmt.Sprintf("%s=%s", columns[idx], field.ToString())
+			out.Values[columns[idx]] = field.ToSDKType()
+		}
+		list.Add(out)
+	})
+	list.Sort(false)
+	if output.GetFormat(cmd).IsSerialized() {
+		list.Filter([]string{"Operation", "Values"})
+	} else {
+		list.Filter([]string{"Operation", "Row"})
+	}
+	return list.Print()
+}
@@ -0,0 +1,627 @@
+package flink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	metricsv2 "github.com/confluentinc/ccloud-sdk-go-v2/metrics/v2"
+
+	"github.com/confluentinc/cli/v4/pkg/auth"
+	"github.com/confluentinc/cli/v4/pkg/ccloudv2"
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/config"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	client "github.com/confluentinc/cli/v4/pkg/flink/app"
+	"github.com/confluentinc/cli/v4/pkg/flink/types"
+	"github.com/confluentinc/cli/v4/pkg/jwt"
+	"github.com/confluentinc/cli/v4/pkg/output"
+	"github.com/confluentinc/cli/v4/pkg/properties"
+)
+
+const (
+	statementWatchDefaultInterval = 10 * time.Second
+	statementWatchMinInterval     = time.Second
+	statementWatchRecentEvents    = 10
+	statementWatchHookTimeout     = time.Minute
+
+	statementStatusChangedEvent = "status_changed"
+	statementNewExceptionEvent  = "new_exception"
+
+	flinkNumRecordsInMetric  = "io.confluent.flink/num_records_in"
+	flinkNumRecordsOutMetric = "io.confluent.flink/num_records_out"
+	flinkWatermarkMetric     = "io.confluent.flink/current_input_watermark_milliseconds"
+	flinkMetricsLookback     = "PT10M/now"
+	flinkMetricsLimit        = 1000
+
+	flinkMetricsGranularity metricsv2.Granularity = "PT1M"
+)
+
+// Statement phases which raise an event when a statement enters them
+var statementWatchFailurePhases = []types.PHASE{types.FAILED, types.DEGRADED}
+
+type statementWatchOut struct {
+	Name         string `human:"Name" serialized:"name"`
+	Status       string `human:"Status" serialized:"status"`
+	Exceptions   int    `human:"Exceptions" serialized:"exceptions"`
+	RecordsIn    string `human:"Records In/Min,omitempty" serialized:"records_in_per_minute,omitempty"`
+	RecordsOut   string `human:"Records Out/Min,omitempty" serialized:"records_out_per_minute,omitempty"`
+	WatermarkLag string `human:"Watermark Lag,omitempty" serialized:"watermark_lag,omitempty"`
+	StatusDetail string `human:"Status Detail,omitempty" serialized:"status_detail,omitempty"`
+}
+
+type statementWatchEvent struct {
+	Time           time.Time                `json:"time" yaml:"time"`
+	Type           string                   `json:"type" yaml:"type"`
+	Statement      string                   `json:"statement" yaml:"statement"`
+	Status         string                   `json:"status" yaml:"status"`
+	PreviousStatus string                   `json:"previous_status,omitempty" yaml:"previous_status,omitempty"`
+	StatusDetail   string                   `json:"status_detail,omitempty" yaml:"status_detail,omitempty"`
+	Exception      *statementWatchException `json:"exception,omitempty" yaml:"exception,omitempty"`
+}
+
+type statementWatchException struct {
+	Name      string    `json:"name" yaml:"name"`
+	Message   string    `json:"message" yaml:"message"`
+	Timestamp time.Time `json:"timestamp" yaml:"timestamp"`
+}
+
+// watchedStatement is a statement returned by a list request, whose labels are known before its health is polled.
+type watchedStatement struct {
+	Name   string
+	Labels map[string]string
+}
+
+type statementMetrics struct {
+	RecordsIn    *float32
+	RecordsOut   *float32
+	WatermarkLag *time.Duration
+}
+
+// statementWatcher remembers the status and the most recent exception of each watched statement between polls, so
+// that it only raises events for changes.
+type statementWatcher struct {
+	getHealth  func(string) (*types.StatementHealth, error)
+	getMetrics func([]*types.StatementHealth) (map[string]*statementMetrics, error)
+	labels     map[string]string
+	statuses   map[string]types.PHASE
+	exceptions map[string]time.Time
+	events     []statementWatchEvent
+	hooks      sync.WaitGroup
+}
+
+func newStatementWatcher(getHealth func(string) (*types.StatementHealth, error), labels map[string]string) *statementWatcher {
+	return &statementWatcher{
+		getHealth:  getHealth,
+		labels:     labels,
+		statuses:   map[string]types.PHASE{},
+		exceptions: map[string]time.Time{},
+	}
+}
+
+func (c *command) newStatementWatchCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "watch [name-1] [name-2] ... [name-n]",
+		Short:             "Watch the health of Flink SQL statements.",
+		Long:              "Poll the status, exceptions, and metrics of Flink SQL statements until interrupted. Statements are selected by name, by label, or both; without either, all statements are watched. An event is raised when a statement becomes failed or degraded, or reports a new exception.",
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validStatementArgsMultiple),
+		RunE:              c.statementWatch,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Watch statements "my-statement-1" and "my-statement-2".`,
+				Code: "confluent flink statement watch my-statement-1 my-statement-2",
+			},
+			examples.Example{
+				Text: `Watch statements labeled "env=prod", and print an event as a JSON line whenever one of them fails.`,
+				Code: "confluent flink statement watch --label env=prod --output json",
+			},
+			examples.Example{
+				Text: "Run a script whenever a statement fails. The event is passed to the script on standard input.",
+				Code: "confluent flink statement watch my-statement --on-failure ./page-on-call.sh",
+			},
+		),
+	}
+
+	addStatementWatchFlags(cmd)
+	pcmd.AddCloudFlag(cmd)
+	pcmd.AddRegionFlagFlink(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddComputePoolFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func addStatementWatchFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("label", []string{}, `A comma-separated list of labels in the form "key=value" which watched statements must have.`)
+	cmd.Flags().Duration("interval", statementWatchDefaultInterval, "Time to wait between polls (e.g., '5s', '1m').")
+	cmd.Flags().String("on-failure", "", "Command to run when a statement fails, becomes degraded, or reports a new exception. The event is passed to the command as JSON on standard input, and the command is stopped after one minute.")
+}
+
+func (c *command) statementWatch(cmd *cobra.Command, args []string) error {
+	environmentId, err := c.Context.EnvironmentId()
+	if err != nil {
+		return err
+	}
+
+	flinkGatewayClient, err := c.GetFlinkGatewayClient(false)
+	if err != nil {
+		return err
+	}
+
+	computePoolId := c.Context.GetCurrentFlinkComputePool()
+	if err := c.validateProvidedComputePool(environmentId, computePoolId); err != nil {
+		return err
+	}
+
+	opts := types.ApplicationOptions{
+		Cloud:          true,
+		Context:        c.Context,
+		UserAgent:      c.Version.UserAgent,
+		EnvironmentId:  environmentId,
+		OrganizationId: c.Context.GetCurrentOrganization(),
+	}
+	getHealth := client.NewStatementHealthPoller(flinkGatewayClient, c.refreshGatewayToken(flinkGatewayClient, jwt.NewValidator()), opts)
+
+	listStatements := func() ([]watchedStatement, error) {
+		statements, err := flinkGatewayClient.ListStatements(environmentId, c.Context.GetCurrentOrganization(), computePoolId)
+		if err != nil {
+			return nil, err
+		}
+		watched := make([]watchedStatement, len(statements))
+		for i, statement := range statements {
+			watched[i] = watchedStatement{Name: statement.GetName(), Labels: statement.Metadata.GetLabels()}
+		}
+		return watched, nil
+	}
+
+	watcher, err := getStatementWatcher(cmd, getHealth)
+	if err != nil {
+		return err
+	}
+
+	if metricsClient, err := c.GetMetricsClient(); err == nil {
+		watcher.getMetrics = func(statements []*types.StatementHealth) (map[string]*statementMetrics, error) {
+			return getStatementMetrics(metricsClient, statements)
+		}
+	} else {
+		output.ErrPrintf(c.Config.EnableColor, "[WARN] Statement metrics are not available: %v\n", err)
+	}
+
+	return c.watchStatements(cmd, watcher, args, listStatements)
+}
+
+// refreshGatewayToken replaces the token of the Flink gateway client once it has expired, so that statements can be
+// watched for longer than the lifetime of a single token.
+func (c *command) refreshGatewayToken(flinkGatewayClient *ccloudv2.FlinkGatewayClient, jwtValidator jwt.Validator) func() error {
+	return func() error {
+		jwtCtx := &config.Context{State: &config.ContextState{AuthToken: flinkGatewayClient.AuthToken}}
+		if err := jwtValidator.Validate(jwtCtx); err == nil {
+			return nil
+		}
+
+		dataplaneToken, err := auth.GetDataplaneToken(c.Context)
+		if err != nil {
+			return err
+		}
+		flinkGatewayClient.AuthToken = dataplaneToken
+		return nil
+	}
+}
+
+func getStatementWatcher(cmd *cobra.Command, getHealth func(string) (*types.StatementHealth, error)) (*statementWatcher, error) {
+	labelSlice, err := cmd.Flags().GetStringSlice("label")
+	if err != nil {
+		return nil, err
+	}
+	labels, err := properties.ConfigSliceToMap(labelSlice)
+	if err != nil {
+		return nil, err
+	}
+
+	return newStatementWatcher(getHealth, labels), nil
+}
+
+// watchStatements polls the named statements, or all statements if none are named, until interrupted. The human
+// output is a table which is redrawn after every poll; the serialized output is a stream of events.
+func (c *command) watchStatements(cmd *cobra.Command, watcher *statementWatcher, names []string, listStatements func() ([]watchedStatement, error)) error {
+	interval, err := cmd.Flags().GetDuration("interval")
+	if err != nil {
+		return err
+	}
+	if interval < statementWatchMinInterval {
+		return fmt.Errorf("`--interval` must be at least %s", statementWatchMinInterval)
+	}
+
+	onFailure, err := cmd.Flags().GetString("on-failure")
+	if err != nil {
+		return err
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	defer signal.Stop(signals)
+	defer watcher.hooks.Wait()
+
+	for {
+		err := c.pollStatements(cmd, watcher, names, listStatements, onFailure)
+		if err != nil {
+			output.ErrPrintln(c.Config.EnableColor, err.Error())
+		}
+
+		select {
+		case <-signals:
+			return err
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (c *command) pollStatements(cmd *cobra.Command, watcher *statementWatcher, names []string, listStatements func() ([]watchedStatement, error), onFailure string) error {
+	if len(names) == 0 {
+		statements, err := listStatements()
+		if err != nil {
+			return err
+		}
+		// Filter on the labels returned by the list request, so that only the health of matching statements is polled
+		for _, statement := range statements {
+			if watcher.matchesLabels(statement.Labels) {
+				names = append(names, statement.Name)
+			}
+		}
+	}
+
+	var healths []*types.StatementHealth
+	var events []statementWatchEvent
+	var errs []string
+	for _, name := range names {
+		health, err := watcher.getHealth(name)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		if !watcher.matchesLabels(health.Labels) {
+			continue
+		}
+
+		healths = append(healths, health)
+		events = append(events, watcher.update(health, time.Now())...)
+	}
+
+	if output.GetFormat(cmd).IsSerialized() {
+		for _, event := range events {
+			if err := printStatementWatchEvent(cmd, event); err != nil {
+				return err
+			}
+		}
+	} else {
+		metrics := watcher.getRunningStatementMetrics(healths, func(err error) {
+			output.ErrPrintf(c.Config.EnableColor, "[WARN] Failed to get the metrics of statements: %v\n", err)
+		})
+
+		output.Print(false, "\033[H\033[2J")
+		list := output.NewList(cmd)
+		for _, health := range healths {
+			list.Add(getStatementWatchRow(health, metrics[health.Name]))
+		}
+		if err := list.Print(); err != nil {
+			return err
+		}
+		if len(watcher.events) > 0 {
+			output.Println(c.Config.EnableColor, "\nRecent events:")
+			for _, event := range watcher.events {
+				output.Println(c.Config.EnableColor, event.String())
+			}
+		}
+	}
+
+	if onFailure != "" && len(events) > 0 {
+		watcher.runHooks(onFailure, events, func(event statementWatchEvent, err error) {
+			output.ErrPrintf(c.Config.EnableColor, "[WARN] Failed to run `--on-failure` command for statement \"%s\": %v\n", event.Statement, err)
+		})
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to poll statements: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (w *statementWatcher) matchesLabels(labels map[string]string) bool {
+	for key, value := range w.labels {
+		if actual, ok := labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+// update records the latest health of a statement and returns the events raised since it was last polled. The first
+// poll of a statement only records its status and exceptions.
+func (w *statementWatcher) update(health *types.StatementHealth, now time.Time) []statementWatchEvent {
+	previousStatus, seen := w.statuses[health.Name]
+	latestException := w.exceptions[health.Name]
+
+	w.statuses[health.Name] = health.Phase
+	if len(health.Exceptions) > 0 && health.Exceptions[0].Timestamp.After(latestException) {
+		w.exceptions[health.Name] = health.Exceptions[0].Timestamp
+	}
+	if !seen {
+		return nil
+	}
+
+	var events []statementWatchEvent
+	if health.Phase != previousStatus && slices.Contains(statementWatchFailurePhases, health.Phase) {
+		events = append(events, statementWatchEvent{
+			Time:           now,
+			Type:           statementStatusChangedEvent,
+			Statement:      health.Name,
+			Status:         string(health.Phase),
+			PreviousStatus: string(previousStatus),
+			StatusDetail:   health.StatusDetail,
+		})
+	}
+
+	// Exceptions are ordered from the most recent to the oldest
+	for i := len(health.Exceptions) - 1; i >= 0; i-- {
+		exception := health.Exceptions[i]
+		if !exception.Timestamp.After(latestException) {
+			continue
+		}
+		events = append(events, statementWatchEvent{
+			Time:      now,
+			Type:      statementNewExceptionEvent,
+			Statement: health.Name,
+			Status:    string(health.Phase),
+			Exception: &statementWatchException{
+				Name:      exception.Name,
+				Message:   exception.Message,
+				Timestamp: exception.Timestamp,
+			},
+		})
+	}
+
+	w.events = append(w.events, events...)
+	if len(w.events) > statementWatchRecentEvents {
+		w.events = w.events[len(w.events)-statementWatchRecentEvents:]
+	}
+
+	return events
+}
+
+// getRunningStatementMetrics returns the metrics of the running statements by name, from a single batch of queries.
+func (w *statementWatcher) getRunningStatementMetrics(healths []*types.StatementHealth, warn func(error)) map[string]*statementMetrics {
+	if w.getMetrics == nil {
+		return nil
+	}
+
+	var running []*types.StatementHealth
+	for _, health := range healths {
+		if health.Phase == types.RUNNING {
+			running = append(running, health)
+		}
+	}
+	if len(running) == 0 {
+		return nil
+	}
+
+	metrics, err := w.getMetrics(running)
+	if err != nil {
+		warn(err)
+		return nil
+	}
+	return metrics
+}
+
+// runHooks runs the `--on-failure` command for each event in the background, so that a slow command does not delay
+// the next poll. The events of a poll are passed to the command in order.
+func (w *statementWatcher) runHooks(command string, events []statementWatchEvent, warn func(statementWatchEvent, error)) {
+	w.hooks.Add(1)
+	go func() {
+		defer w.hooks.Done()
+		for _, event := range events {
+			if err := runStatementWatchHook(command, event); err != nil {
+				warn(event, err)
+			}
+		}
+	}()
+}
+
+func getStatementWatchRow(health *types.StatementHealth, metrics *statementMetrics) *statementWatchOut {
+	row := &statementWatchOut{
+		Name:         health.Name,
+		Status:       string(health.Phase),
+		Exceptions:   len(health.Exceptions),
+		StatusDetail: health.StatusDetail,
+	}
+
+	if metrics == nil {
+		return row
+	}
+	if metrics.RecordsIn != nil {
+		row.RecordsIn = fmt.Sprintf("%.0f", *metrics.RecordsIn)
+	}
+	if metrics.RecordsOut != nil {
+		row.RecordsOut = fmt.Sprintf("%.0f", *metrics.RecordsOut)
+	}
+	if metrics.WatermarkLag != nil {
+		row.WatermarkLag = metrics.WatermarkLag.String()
+	}
+	return row
+}
+
+func (e statementWatchEvent) String() string {
+	timestamp := e.Time.Local().Format(time.DateTime)
+	switch e.Type {
+	case statementStatusChangedEvent:
+		s := fmt.Sprintf(`%s  Statement "%s" changed from %s to %s.`, timestamp, e.Statement, e.PreviousStatus, e.Status)
+		if e.StatusDetail != "" {
+			s += " " + e.StatusDetail
+		}
+		return s
+	case statementNewExceptionEvent:
+		return fmt.Sprintf(`%s  Statement "%s" reported exception "%s": %s`, timestamp, e.Statement, e.Exception.Name, e.Exception.Message)
+	}
+	return ""
+}
+
+// printStatementWatchEvent prints an event on a single line, so that the stream of events can be read as JSON Lines.
+func printStatementWatchEvent(cmd *cobra.Command, event statementWatchEvent) error {
+	if output.GetFormat(cmd) == output.YAML {
+		output.Println(false, "---")
+		return output.SerializedOutput(cmd, event)
+	}
+
+	out, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	output.Println(false, string(out))
+	return nil
+}
+
+// runStatementWatchHook runs the `--on-failure` command in a shell, with the event as JSON on its standard input. The
+// command is stopped if it does not exit within a minute.
+func runStatementWatchHook(command string, event statementWatchEvent) error {
+	in, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), statementWatchHookTimeout)
+	defer cancel()
+
+	var hook *exec.Cmd
+	if runtime.GOOS == "windows" {
+		hook = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		hook = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	hook.Stdin = strings.NewReader(string(in))
+	hook.Stdout = os.Stderr
+	hook.Stderr = os.Stderr
+	hook.Env = append(os.Environ(),
+		"CONFLUENT_FLINK_EVENT_TYPE="+event.Type,
+		"CONFLUENT_FLINK_STATEMENT_NAME="+event.Statement,
+		"CONFLUENT_FLINK_STATEMENT_STATUS="+event.Status,
+	)
+	return hook.Run()
+}
+
+// getStatementMetrics returns the number of records each statement read and wrote in the last minute, and how far its
+// input watermark lags behind the current time. Each metric is queried once for all statements, grouped by statement.
+func getStatementMetrics(metricsClient *ccloudv2.MetricsClient, statements []*types.StatementHealth) (map[string]*statementMetrics, error) {
+	metrics := make(map[string]*statementMetrics, len(statements))
+	for _, statement := range statements {
+		metrics[statement.Name] = &statementMetrics{}
+	}
+
+	recordsIn, err := getLatestStatementMetrics(metricsClient, flinkNumRecordsInMetric, "SUM", statements)
+	if err != nil {
+		return nil, err
+	}
+	for name, point := range recordsIn {
+		if statement, ok := metrics[name]; ok {
+			statement.RecordsIn = &point.Value
+		}
+	}
+
+	recordsOut, err := getLatestStatementMetrics(metricsClient, flinkNumRecordsOutMetric, "SUM", statements)
+	if err != nil {
+		return nil, err
+	}
+	for name, point := range recordsOut {
+		if statement, ok := metrics[name]; ok {
+			statement.RecordsOut = &point.Value
+		}
+	}
+
+	watermarks, err := getLatestStatementMetrics(metricsClient, flinkWatermarkMetric, "MIN", statements)
+	if err != nil {
+		return nil, err
+	}
+	for name, point := range watermarks {
+		if statement, ok := metrics[name]; ok && point.Value > 0 {
+			// Metric values are single-precision, so a watermark is only accurate to a few minutes
+			lag := max(time.Since(time.UnixMilli(int64(point.Value))), 0).Round(time.Minute)
+			statement.WatermarkLag = &lag
+		}
+	}
+
+	return metrics, nil
+}
+
+// getLatestStatementMetrics returns the latest point of a metric for each statement, since the Metrics API only
+// supports one aggregation per query.
+func getLatestStatementMetrics(metricsClient *ccloudv2.MetricsClient, metricName, agg string, statements []*types.StatementHealth) (map[string]*metricsv2.Point, error) {
+	aggFunc := metricsv2.AggregationFunction(agg)
+	aggregations := []metricsv2.Aggregation{{
+		Metric: metricName,
+		Agg:    *metricsv2.NewNullableAggregationFunction(&aggFunc),
+	}}
+
+	req := metricsv2.NewQueryRequest(aggregations, flinkMetricsGranularity, []string{flinkMetricsLookback})
+	req.SetFilter(getStatementMetricsFilter(statements))
+	req.SetGroupBy([]string{flinkStatementNameLabel})
+	req.SetLimit(flinkMetricsLimit)
+
+	resp, httpResp, err := metricsClient.MetricsDatasetQuery("cloud", *req)
+	if err != nil && !ccloudv2.IsDataMatchesMoreThanOneSchemaError(err) || resp == nil {
+		return nil, fmt.Errorf(`failed to query metric "%s": %w`, metricName, err)
+	}
+	if err := ccloudv2.UnmarshalFlatQueryResponseIfDataSchemaMatchError(err, resp, httpResp); err != nil {
+		return nil, err
+	}
+
+	return getLatestPointsByStatement(resp.FlatQueryResponse.GetData()), nil
+}
+
+// getStatementMetricsFilter matches any of the statements, each within its own compute pool.
+func getStatementMetricsFilter(statements []*types.StatementHealth) metricsv2.Filter {
+	filters := make([]metricsv2.Filter, len(statements))
+	for i, statement := range statements {
+		filter := metricsv2.Filter{
+			FieldFilter: &metricsv2.FieldFilter{
+				Field: metricsv2.PtrString(flinkStatementNameLabel),
+				Op:    "EQ",
+				Value: metricsv2.StringAsFieldFilterValue(metricsv2.PtrString(statement.Name)),
+			},
+		}
+		if statement.ComputePoolId != "" {
+			computePoolFilter := metricsv2.Filter{
+				FieldFilter: &metricsv2.FieldFilter{
+					Field: metricsv2.PtrString("resource.compute_pool.id"),
+					Op:    "EQ",
+					Value: metricsv2.StringAsFieldFilterValue(metricsv2.PtrString(statement.ComputePoolId)),
+				},
+			}
+			filter = metricsv2.Filter{CompoundFilter: &metricsv2.CompoundFilter{Op: "AND", Filters: []metricsv2.Filter{filter, computePoolFilter}}}
+		}
+		filters[i] = filter
+	}
+	return metricsv2.Filter{CompoundFilter: &metricsv2.CompoundFilter{Op: "OR", Filters: filters}}
+}
+
+// getLatestPointsByStatement returns the point with the most recent timestamp of each statement, since the order of
+// the points is not guaranteed.
+func getLatestPointsByStatement(points []metricsv2.Point) map[string]*metricsv2.Point {
+	latest := map[string]*metricsv2.Point{}
+	for i := range points {
+		name, _ := points[i].AdditionalProperties[flinkStatementNameLabel].(string)
+		if latest[name] == nil || points[i].Timestamp.After(latest[name].Timestamp) {
+			latest[name] = &points[i]
+		}
+	}
+	return latest
+}
@@ -0,0 +1,84 @@
+package flink
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	client "github.com/confluentinc/cli/v4/pkg/flink/app"
+	"github.com/confluentinc/cli/v4/pkg/flink/types"
+)
+
+func (c *command) newStatementWatchCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch [name-1] [name-2] ... [name-n]",
+		Short: "Watch the health of Flink SQL statements.",
+		Long:  "Poll the status and exceptions of Flink SQL statements in Confluent Platform until interrupted. Statements are selected by name, by label, or both; without either, all statements are watched. An event is raised when a statement becomes failed or degraded, or reports a new exception.",
+		RunE:  c.statementWatchOnPrem,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Watch statements labeled "env=prod" in environment "my-env", and print an event as a JSON line whenever one of them fails.`,
+				Code: "confluent flink statement watch --environment my-env --label env=prod --output json",
+			},
+		),
+	}
+
+	addStatementWatchFlags(cmd)
+	cmd.Flags().String("environment", "", "Name of the Flink environment.")
+	cmd.Flags().String("compute-pool", "", "Optional flag to only watch the Flink statements of a compute pool.")
+	addPageSizeFlag(cmd)
+	addCmfFlagSet(cmd)
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("environment"))
+
+	return cmd
+}
+
+func (c *command) statementWatchOnPrem(cmd *cobra.Command, args []string) error {
+	environment, err := cmd.Flags().GetString("environment")
+	if err != nil {
+		return err
+	}
+
+	computePool, err := cmd.Flags().GetString("compute-pool")
+	if err != nil {
+		return err
+	}
+
+	pageSize, err := getPageSize(cmd)
+	if err != nil {
+		return err
+	}
+
+	cmfClient, err := c.GetCmfClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	opts := types.ApplicationOptions{
+		Context:       c.Context,
+		UserAgent:     c.Version.UserAgent,
+		EnvironmentId: environment,
+	}
+	getHealth := client.NewStatementHealthPollerOnPrem(cmfClient, func() error { return nil }, opts)
+
+	listStatements := func() ([]watchedStatement, error) {
+		statements, err := cmfClient.ListStatements(c.createContext(), environment, computePool, "", pageSize)
+		if err != nil {
+			return nil, err
+		}
+		watched := make([]watchedStatement, len(statements))
+		for i, statement := range statements {
+			watched[i] = watchedStatement{Name: statement.Metadata.GetName(), Labels: statement.Metadata.GetLabels()}
+		}
+		return watched, nil
+	}
+
+	watcher, err := getStatementWatcher(cmd, getHealth)
+	if err != nil {
+		return err
+	}
+
+	return c.watchStatements(cmd, watcher, args, listStatements)
+}
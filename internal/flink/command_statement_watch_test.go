@@ -0,0 +1,87 @@
+package flink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	metricsv2 "github.com/confluentinc/ccloud-sdk-go-v2/metrics/v2"
+
+	"github.com/confluentinc/cli/v4/pkg/flink/types"
+)
+
+func TestStatementWatcherUpdate(t *testing.T) {
+	now := time.Date(2025, 8, 5, 12, 0, 0, 0, time.UTC)
+	oldException := types.StatementException{Name: "exception-1", Message: "Exception 1", Timestamp: now.Add(-time.Hour)}
+	newException := types.StatementException{Name: "exception-2", Message: "Exception 2", Timestamp: now.Add(-time.Minute)}
+
+	watcher := newStatementWatcher(nil, nil)
+
+	// The first poll only records the status and exceptions of a statement
+	events := watcher.update(&types.StatementHealth{Name: "my-statement", Phase: types.FAILED, Exceptions: []types.StatementException{oldException}}, now)
+	require.Empty(t, events)
+
+	events = watcher.update(&types.StatementHealth{Name: "my-statement", Phase: types.RUNNING, Exceptions: []types.StatementException{oldException}}, now)
+	require.Empty(t, events)
+
+	events = watcher.update(&types.StatementHealth{
+		Name:         "my-statement",
+		Phase:        types.DEGRADED,
+		StatusDetail: "Exception 2",
+		Exceptions:   []types.StatementException{newException, oldException},
+	}, now)
+	require.Equal(t, []statementWatchEvent{
+		{Time: now, Type: statementStatusChangedEvent, Statement: "my-statement", Status: "DEGRADED", PreviousStatus: "RUNNING", StatusDetail: "Exception 2"},
+		{Time: now, Type: statementNewExceptionEvent, Statement: "my-statement", Status: "DEGRADED", Exception: &statementWatchException{Name: "exception-2", Message: "Exception 2", Timestamp: newException.Timestamp}},
+	}, events)
+	require.Equal(t, events, watcher.events)
+
+	// Statements which stay failed do not raise events again
+	events = watcher.update(&types.StatementHealth{Name: "my-statement", Phase: types.DEGRADED, Exceptions: []types.StatementException{newException, oldException}}, now)
+	require.Empty(t, events)
+
+	// Statements which complete do not raise events
+	events = watcher.update(&types.StatementHealth{Name: "my-statement", Phase: types.COMPLETED, Exceptions: []types.StatementException{newException, oldException}}, now)
+	require.Empty(t, events)
+}
+
+func TestGetLatestPointsByStatement(t *testing.T) {
+	now := time.Date(2025, 8, 5, 12, 0, 0, 0, time.UTC)
+	points := []metricsv2.Point{
+		{Value: 1, Timestamp: now.Add(-2 * time.Minute), AdditionalProperties: map[string]any{flinkStatementNameLabel: "statement-1"}},
+		{Value: 3, Timestamp: now, AdditionalProperties: map[string]any{flinkStatementNameLabel: "statement-1"}},
+		{Value: 2, Timestamp: now.Add(-time.Minute), AdditionalProperties: map[string]any{flinkStatementNameLabel: "statement-1"}},
+		{Value: 4, Timestamp: now.Add(-time.Minute), AdditionalProperties: map[string]any{flinkStatementNameLabel: "statement-2"}},
+	}
+	require.Equal(t, map[string]*metricsv2.Point{"statement-1": &points[1], "statement-2": &points[3]}, getLatestPointsByStatement(points))
+	require.Empty(t, getLatestPointsByStatement(nil))
+}
+
+func TestGetStatementWatchRow(t *testing.T) {
+	health := &types.StatementHealth{Name: "my-statement", Phase: types.RUNNING}
+	recordsIn := float32(10)
+	lag := 2 * time.Minute
+
+	require.Equal(t, &statementWatchOut{Name: "my-statement", Status: "RUNNING"}, getStatementWatchRow(health, nil))
+	require.Equal(t, &statementWatchOut{Name: "my-statement", Status: "RUNNING", RecordsIn: "10", WatermarkLag: "2m0s"}, getStatementWatchRow(health, &statementMetrics{RecordsIn: &recordsIn, WatermarkLag: &lag}))
+}
+
+func TestStatementWatcherMatchesLabels(t *testing.T) {
+	watcher := newStatementWatcher(nil, map[string]string{"env": "prod"})
+	require.True(t, watcher.matchesLabels(map[string]string{"env": "prod", "team": "orders"}))
+	require.False(t, watcher.matchesLabels(map[string]string{"env": "dev"}))
+	require.False(t, watcher.matchesLabels(nil))
+
+	require.True(t, newStatementWatcher(nil, nil).matchesLabels(nil))
+}
+
+func TestStatementWatchEventString(t *testing.T) {
+	now := time.Date(2025, 8, 5, 12, 0, 0, 0, time.Local)
+
+	event := statementWatchEvent{Time: now, Type: statementStatusChangedEvent, Statement: "my-statement", Status: "FAILED", PreviousStatus: "RUNNING", StatusDetail: "Out of memory."}
+	require.Equal(t, `2025-08-05 12:00:00  Statement "my-statement" changed from RUNNING to FAILED. Out of memory.`, event.String())
+
+	event = statementWatchEvent{Time: now, Type: statementNewExceptionEvent, Statement: "my-statement", Status: "RUNNING", Exception: &statementWatchException{Name: "exception-1", Message: "Exception 1"}}
+	require.Equal(t, `2025-08-05 12:00:00  Statement "my-statement" reported exception "exception-1": Exception 1`, event.String())
+}
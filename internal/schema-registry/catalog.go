@@ -0,0 +1,180 @@
+package schemaregistry
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/properties"
+	"github.com/confluentinc/cli/v4/pkg/utils"
+)
+
+// entityTypeAliases maps the entity names used on the command line to Stream Catalog entity type names.
+var entityTypeAliases = map[string]string{
+	"connector": "cn_connector",
+	"field":     "sr_field",
+	"record":    "sr_record",
+	"schema":    "sr_schema",
+	"subject":   "sr_subject_version",
+	"topic":     "kafka_topic",
+}
+
+var entityTypes = []string{"connector", "field", "record", "schema", "subject", "topic"}
+
+type catalogFile struct {
+	Tags             []catalogTag              `yaml:"tags"`
+	BusinessMetadata []catalogBusinessMetadata `yaml:"business_metadata"`
+}
+
+type catalogTag struct {
+	Name        string          `yaml:"name"`
+	Description string          `yaml:"description"`
+	EntityTypes []string        `yaml:"entity_types"`
+	Entities    []catalogEntity `yaml:"entities"`
+}
+
+type catalogBusinessMetadata struct {
+	Name           string          `yaml:"name"`
+	Description    string          `yaml:"description"`
+	AttributeNames []string        `yaml:"attribute_names"`
+	Entities       []catalogEntity `yaml:"entities"`
+}
+
+type catalogEntity struct {
+	Type       string            `yaml:"type"`
+	Name       string            `yaml:"name"`
+	Attributes map[string]string `yaml:"attributes"`
+}
+
+func readCatalogFile(path string) (*catalogFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file := new(catalogFile)
+	if err := yaml.Unmarshal(data, file); err != nil {
+		return nil, fmt.Errorf("failed to parse Stream Catalog file: %w", err)
+	}
+
+	for _, tag := range file.Tags {
+		if tag.Name == "" {
+			return nil, fmt.Errorf(`every tag in "%s" must have a name`, path)
+		}
+	}
+	for _, businessMetadata := range file.BusinessMetadata {
+		if businessMetadata.Name == "" {
+			return nil, fmt.Errorf(`every business metadata definition in "%s" must have a name`, path)
+		}
+	}
+
+	return file, nil
+}
+
+func addCatalogFileFlag(cmd *cobra.Command) {
+	cmd.Flags().String("file", "", "YAML file containing tags and business metadata definitions for bulk operations.")
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "yaml", "yml"))
+}
+
+func addEntityFlags(cmd *cobra.Command) {
+	cmd.Flags().String("entity-type", "", fmt.Sprintf("Type of the Stream Catalog entity. Can be %s, or a Stream Catalog entity type name.", utils.ArrayToCommaDelimitedString(entityTypes, "or")))
+	cmd.Flags().String("entity-name", "", "Qualified name of the Stream Catalog entity.")
+	pcmd.RegisterFlagCompletionFunc(cmd, "entity-type", func(_ *cobra.Command, _ []string) []string { return entityTypes })
+}
+
+func getEntityFlags(cmd *cobra.Command) (string, string, error) {
+	entityType, err := cmd.Flags().GetString("entity-type")
+	if err != nil {
+		return "", "", err
+	}
+
+	entityName, err := cmd.Flags().GetString("entity-name")
+	if err != nil {
+		return "", "", err
+	}
+
+	return resolveEntityType(entityType), entityName, nil
+}
+
+func resolveEntityType(entityType string) string {
+	if catalogType, ok := entityTypeAliases[entityType]; ok {
+		return catalogType
+	}
+	return entityType
+}
+
+// catalogAssignment is a tag or business metadata definition paired with an entity it is attached to.
+type catalogAssignment struct {
+	Name   string
+	Entity catalogEntity
+}
+
+// getCatalogAssignments returns the assignments targeted by an attach or detach command, either from the
+// `--entity-type` and `--entity-name` flags or from the entries of a Stream Catalog file.
+func getCatalogAssignments(cmd *cobra.Command, args []string, fromFile func(*catalogFile) []catalogAssignment) ([]catalogAssignment, error) {
+	path, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return nil, err
+	}
+
+	if path != "" {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("cannot specify both a name and `--file`")
+		}
+		file, err := readCatalogFile(path)
+		if err != nil {
+			return nil, err
+		}
+		assignments := fromFile(file)
+		for i := range assignments {
+			assignments[i].Entity.Type = resolveEntityType(assignments[i].Entity.Type)
+		}
+		return assignments, nil
+	}
+
+	if len(args) == 0 {
+		return nil, errors.NewErrorWithSuggestions("missing name", "Pass a name as an argument or use the `--file` flag.")
+	}
+
+	entityType, entityName, err := getEntityFlags(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if entityType == "" || entityName == "" {
+		return nil, errors.NewErrorWithSuggestions("missing entity", "Specify the entity with `--entity-type` and `--entity-name`.")
+	}
+
+	attributes, err := getAttributesFlag(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return []catalogAssignment{{Name: args[0], Entity: catalogEntity{Type: entityType, Name: entityName, Attributes: attributes}}}, nil
+}
+
+func getAttributesFlag(cmd *cobra.Command) (map[string]string, error) {
+	if cmd.Flags().Lookup("attributes") == nil {
+		return nil, nil
+	}
+
+	attributes, err := cmd.Flags().GetStringSlice("attributes")
+	if err != nil {
+		return nil, err
+	}
+
+	attributesMap, err := properties.ConfigSliceToMap(attributes)
+	if err != nil {
+		return nil, errors.NewErrorWithSuggestions(err.Error(), "`--attributes` must be formatted as \"<key>=<value>\".")
+	}
+	return attributesMap, nil
+}
+
+type catalogEntityOut struct {
+	Name       string `human:"Name" serialized:"name"`
+	EntityType string `human:"Entity Type" serialized:"entity_type"`
+	EntityName string `human:"Entity Name" serialized:"entity_name"`
+}
@@ -28,14 +28,17 @@ func New(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Command {
 		c.AuthenticatedCLICommand = pcmd.NewAuthenticatedWithMDSCLICommand(cmd, prerunner)
 	}
 
+	cmd.AddCommand(c.newBusinessMetadataCommand())
 	cmd.AddCommand(c.newClusterCommand(cfg))
 	cmd.AddCommand(c.newConfigurationCommand(cfg))
+	cmd.AddCommand(c.newContextCommand(cfg))
 	cmd.AddCommand(c.newDekCommand(cfg))
 	cmd.AddCommand(c.newEndpointsCommand())
 	cmd.AddCommand(c.newExporterCommand(cfg))
 	cmd.AddCommand(c.newKekCommand(cfg))
 	cmd.AddCommand(c.newSchemaCommand(cfg))
 	cmd.AddCommand(c.newSubjectCommand(cfg))
+	cmd.AddCommand(c.newTagCommand())
 
 	return cmd
 }
@@ -0,0 +1,92 @@
+package schemaregistry
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+)
+
+func (c *command) newBusinessMetadataCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "business-metadata",
+		Short:       "Manage Stream Catalog business metadata.",
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLogin},
+	}
+
+	cmd.AddCommand(c.newBusinessMetadataAttachCommand())
+	cmd.AddCommand(c.newBusinessMetadataCreateCommand())
+	cmd.AddCommand(c.newBusinessMetadataDeleteCommand())
+	cmd.AddCommand(c.newBusinessMetadataDescribeCommand())
+	cmd.AddCommand(c.newBusinessMetadataDetachCommand())
+	cmd.AddCommand(c.newBusinessMetadataListCommand())
+
+	return cmd
+}
+
+type businessMetadataOut struct {
+	Name           string   `human:"Name" serialized:"name"`
+	Description    string   `human:"Description,omitempty" serialized:"description,omitempty"`
+	AttributeNames []string `human:"Attribute Names" serialized:"attribute_names"`
+}
+
+type businessMetadataEntityOut struct {
+	Name       string            `human:"Name" serialized:"name"`
+	EntityType string            `human:"Entity Type" serialized:"entity_type"`
+	EntityName string            `human:"Entity Name" serialized:"entity_name"`
+	Attributes map[string]string `human:"Attributes" serialized:"attributes"`
+}
+
+func businessMetadataFromCatalogFile(file *catalogFile) []catalogAssignment {
+	var assignments []catalogAssignment
+	for _, businessMetadata := range file.BusinessMetadata {
+		for _, entity := range businessMetadata.Entities {
+			assignments = append(assignments, catalogAssignment{Name: businessMetadata.Name, Entity: entity})
+		}
+	}
+	return assignments
+}
+
+func newBusinessMetadataDef(name, description string, attributeNames []string) srsdk.AtlasBusinessMetadataDef {
+	attributeDefs := make([]srsdk.AtlasAttributeDef, len(attributeNames))
+	for i, attributeName := range attributeNames {
+		attributeDefs[i] = srsdk.AtlasAttributeDef{
+			Name:        srsdk.PtrString(attributeName),
+			TypeName:    srsdk.PtrString("string"),
+			IsOptional:  srsdk.PtrBool(true),
+			Cardinality: srsdk.PtrString("SINGLE"),
+			Options: &map[string]string{
+				"applicableEntityTypes": `["cf_entity"]`,
+				"maxStrLength":          "5000",
+			},
+		}
+	}
+
+	businessMetadataDef := srsdk.AtlasBusinessMetadataDef{
+		Name:          srsdk.PtrString(name),
+		AttributeDefs: &attributeDefs,
+	}
+	if description != "" {
+		businessMetadataDef.Description = srsdk.PtrString(description)
+	}
+	return businessMetadataDef
+}
+
+func getAttributeNames(attributeDefs []srsdk.AtlasAttributeDef) []string {
+	names := make([]string, len(attributeDefs))
+	for i, attributeDef := range attributeDefs {
+		names[i] = attributeDef.GetName()
+	}
+	return names
+}
+
+func toStringAttributes(attributes map[string]any) map[string]string {
+	stringAttributes := make(map[string]string, len(attributes))
+	for key, value := range attributes {
+		stringAttributes[key] = fmt.Sprint(value)
+	}
+	return stringAttributes
+}
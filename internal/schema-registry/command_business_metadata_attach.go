@@ -0,0 +1,103 @@
+package schemaregistry
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+func (c *command) newBusinessMetadataAttachCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attach [name]",
+		Short: "Attach business metadata to Stream Catalog entities.",
+		Long:  "Attach business metadata to a topic, subject, schema, record, field, or connector, or attach every business metadata definition listed in a YAML file to its entities.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  c.businessMetadataAttach,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Attach business metadata "Owner" to topic "payments".`,
+				Code: "confluent schema-registry business-metadata attach Owner --entity-type topic --entity-name lkc-123456:payments --attributes team=payments,email=payments@example.com",
+			},
+			examples.Example{
+				Text: "Attach the business metadata listed in a YAML file to its entities.",
+				Code: "confluent schema-registry business-metadata attach --file catalog.yaml",
+			},
+		),
+	}
+
+	addEntityFlags(cmd)
+	cmd.Flags().StringSlice("attributes", nil, `A comma-separated list of attribute values in the form "key=value".`)
+	addCatalogFileFlag(cmd)
+	addCatalogFlags(cmd, c)
+	pcmd.AddOutputFlag(cmd)
+
+	cmd.MarkFlagsRequiredTogether("entity-type", "entity-name")
+	cmd.MarkFlagsMutuallyExclusive("file", "entity-type")
+	cmd.MarkFlagsMutuallyExclusive("file", "attributes")
+
+	return cmd
+}
+
+func (c *command) businessMetadataAttach(cmd *cobra.Command, args []string) error {
+	assignments, err := getCatalogAssignments(cmd, args, businessMetadataFromCatalogFile)
+	if err != nil {
+		return err
+	}
+
+	if len(assignments) == 0 {
+		return fmt.Errorf("no entities with business metadata found")
+	}
+
+	businessMetadata := make([]srsdk.BusinessMetadata, len(assignments))
+	for i, assignment := range assignments {
+		attributes := make(map[string]any, len(assignment.Entity.Attributes))
+		for key, value := range assignment.Entity.Attributes {
+			attributes[key] = value
+		}
+		businessMetadata[i] = srsdk.BusinessMetadata{
+			TypeName:   srsdk.PtrString(assignment.Name),
+			EntityType: srsdk.PtrString(assignment.Entity.Type),
+			EntityName: srsdk.PtrString(assignment.Entity.Name),
+			Attributes: &attributes,
+		}
+	}
+
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.CreateBusinessMetadata(businessMetadata)
+	if err != nil {
+		return err
+	}
+
+	errs := &multierror.Error{ErrorFormat: errors.CustomMultierrorList}
+	list := output.NewList(cmd)
+	for _, metadata := range res {
+		if metadata.Error != nil {
+			errs = multierror.Append(errs, fmt.Errorf(`failed to attach business metadata "%s" to %s "%s": %s`, metadata.GetTypeName(), metadata.GetEntityType(), metadata.GetEntityName(), metadata.Error.GetMessage()))
+			continue
+		}
+		list.Add(&businessMetadataEntityOut{
+			Name:       metadata.GetTypeName(),
+			EntityType: metadata.GetEntityType(),
+			EntityName: metadata.GetEntityName(),
+			Attributes: toStringAttributes(metadata.GetAttributes()),
+		})
+	}
+
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,117 @@
+package schemaregistry
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+func (c *command) newBusinessMetadataCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [name]",
+		Short: "Create business metadata definitions.",
+		Long:  "Create a business metadata definition, or create every business metadata definition listed in a YAML file.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  c.businessMetadataCreate,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Create a business metadata definition named "Owner" with attributes "team" and "email".`,
+				Code: "confluent schema-registry business-metadata create Owner --attribute-names team,email",
+			},
+			examples.Example{
+				Text: "Create the business metadata definitions listed in a YAML file.",
+				Code: "confluent schema-registry business-metadata create --file catalog.yaml",
+			},
+		),
+	}
+
+	cmd.Flags().String("description", "", "Description of the business metadata.")
+	cmd.Flags().StringSlice("attribute-names", nil, "A comma-separated list of attribute names.")
+	addCatalogFileFlag(cmd)
+	addCatalogFlags(cmd, c)
+	pcmd.AddOutputFlag(cmd)
+
+	cmd.MarkFlagsMutuallyExclusive("file", "description")
+	cmd.MarkFlagsMutuallyExclusive("file", "attribute-names")
+
+	return cmd
+}
+
+func (c *command) businessMetadataCreate(cmd *cobra.Command, args []string) error {
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	var businessMetadataDefs []srsdk.AtlasBusinessMetadataDef
+	if file != "" {
+		if len(args) > 0 {
+			return fmt.Errorf("cannot specify both a name and `--file`")
+		}
+		catalog, err := readCatalogFile(file)
+		if err != nil {
+			return err
+		}
+		for _, businessMetadata := range catalog.BusinessMetadata {
+			businessMetadataDefs = append(businessMetadataDefs, newBusinessMetadataDef(businessMetadata.Name, businessMetadata.Description, businessMetadata.AttributeNames))
+		}
+	} else {
+		if len(args) == 0 {
+			return errors.NewErrorWithSuggestions("missing business metadata name", "Pass a business metadata name as an argument or use the `--file` flag.")
+		}
+
+		description, err := cmd.Flags().GetString("description")
+		if err != nil {
+			return err
+		}
+
+		attributeNames, err := cmd.Flags().GetStringSlice("attribute-names")
+		if err != nil {
+			return err
+		}
+
+		businessMetadataDefs = append(businessMetadataDefs, newBusinessMetadataDef(args[0], description, attributeNames))
+	}
+
+	if len(businessMetadataDefs) == 0 {
+		return fmt.Errorf(`no business metadata definitions found in "%s"`, file)
+	}
+
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.CreateBusinessMetadataDefs(businessMetadataDefs)
+	if err != nil {
+		return err
+	}
+
+	errs := &multierror.Error{ErrorFormat: errors.CustomMultierrorList}
+	list := output.NewList(cmd)
+	for _, businessMetadataDef := range res {
+		if businessMetadataDef.Error != nil {
+			errs = multierror.Append(errs, fmt.Errorf(`failed to create business metadata "%s": %s`, businessMetadataDef.GetName(), businessMetadataDef.Error.GetMessage()))
+			continue
+		}
+		list.Add(&businessMetadataOut{
+			Name:           businessMetadataDef.GetName(),
+			Description:    businessMetadataDef.GetDescription(),
+			AttributeNames: getAttributeNames(businessMetadataDef.GetAttributeDefs()),
+		})
+	}
+
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	return errs.ErrorOrNil()
+}
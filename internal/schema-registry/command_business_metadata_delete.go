@@ -0,0 +1,42 @@
+package schemaregistry
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/deletion"
+	"github.com/confluentinc/cli/v4/pkg/resource"
+)
+
+func (c *command) newBusinessMetadataDeleteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <name-1> [name-2] ... [name-n]",
+		Short: "Delete one or more business metadata definitions.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  c.businessMetadataDelete,
+	}
+
+	pcmd.AddForceFlag(cmd)
+	addCatalogFlags(cmd, c)
+
+	return cmd
+}
+
+func (c *command) businessMetadataDelete(cmd *cobra.Command, args []string) error {
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	existenceFunc := func(name string) bool {
+		_, err := client.GetBusinessMetadataDefByName(name)
+		return err == nil
+	}
+
+	if err := deletion.ValidateAndConfirm(cmd, args, existenceFunc, resource.BusinessMetadata); err != nil {
+		return err
+	}
+
+	_, err = deletion.Delete(cmd, args, client.DeleteBusinessMetadataDef, resource.BusinessMetadata)
+	return err
+}
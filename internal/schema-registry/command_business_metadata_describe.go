@@ -0,0 +1,42 @@
+package schemaregistry
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+func (c *command) newBusinessMetadataDescribeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe <name>",
+		Short: "Describe a business metadata definition.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  c.businessMetadataDescribe,
+	}
+
+	addCatalogFlags(cmd, c)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) businessMetadataDescribe(cmd *cobra.Command, args []string) error {
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	businessMetadataDef, err := client.GetBusinessMetadataDefByName(args[0])
+	if err != nil {
+		return err
+	}
+
+	table := output.NewTable(cmd)
+	table.Add(&businessMetadataOut{
+		Name:           businessMetadataDef.GetName(),
+		Description:    businessMetadataDef.GetDescription(),
+		AttributeNames: getAttributeNames(businessMetadataDef.GetAttributeDefs()),
+	})
+	return table.Print()
+}
@@ -0,0 +1,64 @@
+package schemaregistry
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+func (c *command) newBusinessMetadataDetachCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "detach [name]",
+		Short: "Detach business metadata from Stream Catalog entities.",
+		Long:  "Detach business metadata from an entity, or detach every business metadata definition listed in a YAML file from its entities.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  c.businessMetadataDetach,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Detach business metadata "Owner" from topic "payments".`,
+				Code: "confluent schema-registry business-metadata detach Owner --entity-type topic --entity-name lkc-123456:payments",
+			},
+			examples.Example{
+				Text: "Detach the business metadata listed in a YAML file from its entities.",
+				Code: "confluent schema-registry business-metadata detach --file catalog.yaml",
+			},
+		),
+	}
+
+	addEntityFlags(cmd)
+	addCatalogFileFlag(cmd)
+	addCatalogFlags(cmd, c)
+
+	cmd.MarkFlagsRequiredTogether("entity-type", "entity-name")
+	cmd.MarkFlagsMutuallyExclusive("file", "entity-type")
+
+	return cmd
+}
+
+func (c *command) businessMetadataDetach(cmd *cobra.Command, args []string) error {
+	assignments, err := getCatalogAssignments(cmd, args, businessMetadataFromCatalogFile)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	errs := &multierror.Error{ErrorFormat: errors.CustomMultierrorList}
+	for _, assignment := range assignments {
+		if err := client.DeleteBusinessMetadata(assignment.Entity.Type, assignment.Entity.Name, assignment.Name); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf(`failed to detach business metadata "%s" from %s "%s": %w`, assignment.Name, assignment.Entity.Type, assignment.Entity.Name, err))
+			continue
+		}
+		output.Printf(c.Config.EnableColor, "Detached business metadata \"%s\" from %s \"%s\".\n", assignment.Name, assignment.Entity.Type, assignment.Entity.Name)
+	}
+
+	return errs.ErrorOrNil()
+}
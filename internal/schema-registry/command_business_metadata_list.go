@@ -0,0 +1,88 @@
+package schemaregistry
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+func (c *command) newBusinessMetadataListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List business metadata definitions or the business metadata attached to an entity.",
+		Args:  cobra.NoArgs,
+		RunE:  c.businessMetadataList,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "List all business metadata definitions.",
+				Code: "confluent schema-registry business-metadata list",
+			},
+			examples.Example{
+				Text: `List the business metadata attached to topic "payments".`,
+				Code: "confluent schema-registry business-metadata list --entity-type topic --entity-name lkc-123456:payments",
+			},
+		),
+	}
+
+	cmd.Flags().String("prefix", "", "Only list business metadata definitions whose names start with this prefix.")
+	addEntityFlags(cmd)
+	addCatalogFlags(cmd, c)
+	pcmd.AddOutputFlag(cmd)
+
+	cmd.MarkFlagsRequiredTogether("entity-type", "entity-name")
+	cmd.MarkFlagsMutuallyExclusive("prefix", "entity-type")
+
+	return cmd
+}
+
+func (c *command) businessMetadataList(cmd *cobra.Command, _ []string) error {
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	entityType, entityName, err := getEntityFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	if entityType != "" {
+		businessMetadata, err := client.GetBusinessMetadata(entityType, entityName)
+		if err != nil {
+			return err
+		}
+
+		list := output.NewList(cmd)
+		for _, metadata := range businessMetadata {
+			list.Add(&businessMetadataEntityOut{
+				Name:       metadata.GetTypeName(),
+				EntityType: metadata.GetEntityType(),
+				EntityName: metadata.GetEntityName(),
+				Attributes: toStringAttributes(metadata.GetAttributes()),
+			})
+		}
+		return list.Print()
+	}
+
+	prefix, err := cmd.Flags().GetString("prefix")
+	if err != nil {
+		return err
+	}
+
+	businessMetadataDefs, err := client.GetAllBusinessMetadataDefs(prefix)
+	if err != nil {
+		return err
+	}
+
+	list := output.NewList(cmd)
+	for _, businessMetadataDef := range businessMetadataDefs {
+		list.Add(&businessMetadataOut{
+			Name:           businessMetadataDef.GetName(),
+			Description:    businessMetadataDef.GetDescription(),
+			AttributeNames: getAttributeNames(businessMetadataDef.GetAttributeDefs()),
+		})
+	}
+	return list.Print()
+}
@@ -0,0 +1,104 @@
+package schemaregistry
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/config"
+	"github.com/confluentinc/cli/v4/pkg/schemaregistry"
+)
+
+const defaultContext = "."
+
+type contextOut struct {
+	Name          string `human:"Name" serialized:"name"`
+	Subjects      int    `human:"Subjects" serialized:"subjects"`
+	Mode          string `human:"Mode" serialized:"mode"`
+	Compatibility string `human:"Compatibility" serialized:"compatibility"`
+}
+
+func (c *command) newContextCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "context",
+		Short: "Manage Schema Registry contexts.",
+		Long:  "Manage Schema Registry contexts, which group subjects under a shared prefix such as \":.tenant-a:\".",
+	}
+
+	cmd.AddCommand(c.newContextCopyCommand(cfg))
+	cmd.AddCommand(c.newContextDeleteCommand(cfg))
+	cmd.AddCommand(c.newContextDescribeCommand(cfg))
+	cmd.AddCommand(c.newContextListCommand(cfg))
+
+	return cmd
+}
+
+func (c *command) addContextFlags(cmd *cobra.Command, cfg *config.Config) {
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	if cfg.IsCloudLogin() {
+		pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	} else {
+		addCaLocationAndClientPathFlags(cmd)
+	}
+	addSchemaRegistryEndpointFlag(cmd)
+}
+
+// normalizeContext returns the context name with a leading period, which is how Schema Registry lists contexts.
+func normalizeContext(name string) string {
+	if name == "" || name == defaultContext {
+		return defaultContext
+	}
+	return "." + strings.TrimPrefix(name, ".")
+}
+
+// contextPrefix returns the prefix of qualified subject names in a context, for example ":.tenant-a:".
+func contextPrefix(name string) string {
+	return fmt.Sprintf(":%s:", normalizeContext(name))
+}
+
+// unqualifySubject strips the context prefix from a subject name.
+func unqualifySubject(subject, name string) string {
+	return strings.TrimPrefix(subject, contextPrefix(name))
+}
+
+func contextExists(client *schemaregistry.Client, name string) (bool, error) {
+	contexts, err := client.ListContexts()
+	if err != nil {
+		return false, err
+	}
+
+	return slices.Contains(contexts, normalizeContext(name)), nil
+}
+
+func getContextSubjects(client *schemaregistry.Client, name string) ([]string, error) {
+	return client.List(contextPrefix(name), false)
+}
+
+func describeContext(client *schemaregistry.Client, name string) (*contextOut, []string, error) {
+	subjects, err := getContextSubjects(client, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mode, err := client.GetContextMode(contextPrefix(name))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contextConfig, err := client.GetContextConfig(contextPrefix(name))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := &contextOut{
+		Name:          normalizeContext(name),
+		Subjects:      len(subjects),
+		Mode:          mode.GetMode(),
+		Compatibility: contextConfig.GetCompatibilityLevel(),
+	}
+
+	return out, subjects, nil
+}
@@ -0,0 +1,173 @@
+package schemaregistry
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/config"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/output"
+	"github.com/confluentinc/cli/v4/pkg/resource"
+	"github.com/confluentinc/cli/v4/pkg/schemaregistry"
+	"github.com/confluentinc/cli/v4/pkg/utils"
+)
+
+type contextCopyOut struct {
+	Source      string `human:"Source" serialized:"source"`
+	Destination string `human:"Destination" serialized:"destination"`
+	Versions    int    `human:"Versions" serialized:"versions"`
+}
+
+func (c *command) newContextCopyCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "copy <source> <destination>",
+		Short: "Copy subjects from one context to another.",
+		Long:  "Copy subjects from one Schema Registry context to another by registering every version of each subject, in order, under the destination context. Subjects are registered in IMPORT mode, so that the copies keep the version numbers and schema IDs of the originals.",
+		Args:  cobra.ExactArgs(2),
+		RunE:  c.contextCopy,
+	}
+
+	example1 := examples.Example{
+		Text: `Copy every subject in context ".tenant-a" to context ".tenant-b".`,
+		Code: "confluent schema-registry context copy .tenant-a .tenant-b",
+	}
+	example2 := examples.Example{
+		Text: `Copy subjects "payments-value" and "orders-value" from the default context to context ".staging".`,
+		Code: "confluent schema-registry context copy . .staging --subjects payments-value,orders-value",
+	}
+	if cfg.IsOnPremLogin() {
+		example1.Code += " " + onPremAuthenticationMsg
+		example2.Code += " " + onPremAuthenticationMsg
+	}
+	cmd.Example = examples.BuildExampleString(example1, example2)
+
+	cmd.Flags().StringSlice("subjects", nil, "A comma-separated list of subjects to copy. Defaults to all subjects in the source context.")
+	c.addContextFlags(cmd, cfg)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) contextCopy(cmd *cobra.Command, args []string) error {
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	source, destination := args[0], args[1]
+	exists, err := contextExists(client, source)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return resource.ResourcesNotFoundError(cmd, resource.SchemaRegistryContext, source)
+	}
+
+	filter, err := cmd.Flags().GetStringSlice("subjects")
+	if err != nil {
+		return err
+	}
+
+	subjects, err := getContextSubjects(client, source)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, len(subjects))
+	for i, subject := range subjects {
+		names[i] = unqualifySubject(subject, source)
+	}
+
+	var missing []string
+	for _, name := range filter {
+		if !slices.Contains(names, name) {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf(`subject(s) %s not found in context "%s"`, utils.ArrayToCommaDelimitedString(missing, "and"), normalizeContext(source)),
+			fmt.Sprintf("List the subjects in the context with `confluent schema-registry context describe %s`.", source),
+		)
+	}
+
+	list := output.NewList(cmd)
+	for i, subject := range subjects {
+		name := names[i]
+		if len(filter) > 0 && !slices.Contains(filter, name) {
+			continue
+		}
+
+		destinationSubject := contextPrefix(destination) + name
+		versions, err := copySubject(client, subject, destinationSubject)
+		if err != nil {
+			return err
+		}
+
+		list.Add(&contextCopyOut{
+			Source:      subject,
+			Destination: destinationSubject,
+			Versions:    versions,
+		})
+	}
+	return list.Print()
+}
+
+// copySubject registers every version of a subject under the destination subject in IMPORT mode, so that each version
+// keeps its version number and schema ID, and references to the schema IDs remain valid.
+func copySubject(client *schemaregistry.Client, source, destination string) (_ int, err error) {
+	versions, err := client.ListVersions(source, false)
+	if err != nil {
+		return 0, catchSchemaNotFoundError(err, source, "")
+	}
+
+	// The destination subject is returned to its previous subject-level mode, or to inheriting its mode if it had none
+	previousMode, err := client.GetSubjectLevelMode(destination)
+	if err != nil && !schemaregistry.IsNotFoundError(err) {
+		return 0, err
+	}
+
+	if _, err := client.UpdateMode(destination, srsdk.ModeUpdateRequest{Mode: srsdk.PtrString("IMPORT")}); err != nil {
+		return 0, fmt.Errorf(`failed to set subject "%s" to IMPORT mode: %w`, destination, err)
+	}
+	defer func() {
+		var restoreErr error
+		if previousMode.GetMode() != "" {
+			_, restoreErr = client.UpdateMode(destination, srsdk.ModeUpdateRequest{Mode: srsdk.PtrString(previousMode.GetMode())})
+		} else {
+			_, restoreErr = client.DeleteSubjectLevelMode(destination)
+		}
+		if restoreErr != nil && err == nil {
+			err = fmt.Errorf(`failed to restore the mode of subject "%s": %w`, destination, restoreErr)
+		}
+	}()
+
+	for _, version := range versions {
+		schema, err := client.GetSchemaByVersion(source, strconv.Itoa(int(version)), false)
+		if err != nil {
+			return 0, err
+		}
+
+		req := srsdk.RegisterSchemaRequest{
+			Id:         schema.Id,
+			Version:    schema.Version,
+			Schema:     schema.Schema,
+			SchemaType: schema.SchemaType,
+			References: schema.References,
+			Metadata:   schema.Metadata,
+			RuleSet:    schema.RuleSet,
+		}
+		if _, err := client.Register(destination, req, false); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(versions), nil
+}
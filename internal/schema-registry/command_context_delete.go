@@ -0,0 +1,101 @@
+package schemaregistry
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/config"
+	"github.com/confluentinc/cli/v4/pkg/deletion"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/output"
+	"github.com/confluentinc/cli/v4/pkg/resource"
+)
+
+func (c *command) newContextDeleteCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete all subjects in a context.",
+		Long:  "Delete all subjects in a Schema Registry context. Once every subject in a context is permanently deleted, the context is removed.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  c.contextDelete,
+	}
+
+	example := examples.Example{
+		Text: `Soft delete and then permanently delete every subject in context ".tenant-a".`,
+		Code: "confluent schema-registry context delete .tenant-a --permanent",
+	}
+	if cfg.IsOnPremLogin() {
+		example.Code += " " + onPremAuthenticationMsg
+	}
+	cmd.Example = examples.BuildExampleString(example)
+
+	cmd.Flags().Bool("permanent", false, "Permanently delete the subjects after soft deleting them.")
+	pcmd.AddForceFlag(cmd)
+	c.addContextFlags(cmd, cfg)
+
+	return cmd
+}
+
+func (c *command) contextDelete(cmd *cobra.Command, args []string) error {
+	if normalizeContext(args[0]) == defaultContext {
+		return errors.NewErrorWithSuggestions(
+			`cannot delete the default context "."`,
+			"Delete the schemas of subjects in the default context with `confluent schema-registry schema delete`.",
+		)
+	}
+
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	permanent, err := cmd.Flags().GetBool("permanent")
+	if err != nil {
+		return err
+	}
+
+	exists, err := contextExists(client, args[0])
+	if err != nil {
+		return err
+	}
+	existenceFunc := func(_ string) bool {
+		return exists
+	}
+
+	subjects, err := getContextSubjects(client, args[0])
+	if err != nil {
+		return err
+	}
+
+	extraWarning := fmt.Sprintf(" This will delete %d subject(s).", len(subjects))
+	if err := deletion.ValidateAndConfirmWithExtraWarning(cmd, args, existenceFunc, resource.SchemaRegistryContext, extraWarning); err != nil {
+		return err
+	}
+
+	errs := &multierror.Error{ErrorFormat: errors.CustomMultierrorList}
+	for _, subject := range subjects {
+		if _, err := client.DeleteSubject(subject, false); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf(`failed to delete subject "%s": %w`, subject, err))
+			continue
+		}
+		if permanent {
+			if _, err := client.DeleteSubject(subject, true); err != nil {
+				errs = multierror.Append(errs, fmt.Errorf(`failed to permanently delete subject "%s": %w`, subject, err))
+			}
+		}
+	}
+	if err := errs.ErrorOrNil(); err != nil {
+		return err
+	}
+
+	if permanent {
+		output.Printf(c.Config.EnableColor, "Permanently deleted %d subject(s) and %s \"%s\".\n", len(subjects), resource.SchemaRegistryContext, normalizeContext(args[0]))
+	} else {
+		output.Printf(c.Config.EnableColor, "Deleted %d subject(s) in %s \"%s\".\n", len(subjects), resource.SchemaRegistryContext, normalizeContext(args[0]))
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package schemaregistry
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/config"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/output"
+	"github.com/confluentinc/cli/v4/pkg/resource"
+)
+
+type contextDescribeOut struct {
+	Name          string   `human:"Name" serialized:"name"`
+	Mode          string   `human:"Mode" serialized:"mode"`
+	Compatibility string   `human:"Compatibility" serialized:"compatibility"`
+	Subjects      []string `human:"Subjects" serialized:"subjects"`
+}
+
+func (c *command) newContextDescribeCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe <name>",
+		Short: "Describe a context.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  c.contextDescribe,
+	}
+
+	example := examples.Example{
+		Text: `Describe the subjects, mode, and compatibility level of context ".tenant-a".`,
+		Code: "confluent schema-registry context describe .tenant-a",
+	}
+	if cfg.IsOnPremLogin() {
+		example.Code += " " + onPremAuthenticationMsg
+	}
+	cmd.Example = examples.BuildExampleString(example)
+
+	c.addContextFlags(cmd, cfg)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) contextDescribe(cmd *cobra.Command, args []string) error {
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	exists, err := contextExists(client, args[0])
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return resource.ResourcesNotFoundError(cmd, resource.SchemaRegistryContext, args[0])
+	}
+
+	out, subjects, err := describeContext(client, args[0])
+	if err != nil {
+		return err
+	}
+
+	table := output.NewTable(cmd)
+	table.Add(&contextDescribeOut{
+		Name:          out.Name,
+		Mode:          out.Mode,
+		Compatibility: out.Compatibility,
+		Subjects:      subjects,
+	})
+	return table.Print()
+}
@@ -0,0 +1,46 @@
+package schemaregistry
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/config"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+func (c *command) newContextListCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List contexts.",
+		Long:  "List Schema Registry contexts with their subject count, mode, and compatibility level.",
+		Args:  cobra.NoArgs,
+		RunE:  c.contextList,
+	}
+
+	c.addContextFlags(cmd, cfg)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) contextList(cmd *cobra.Command, _ []string) error {
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	contexts, err := client.ListContexts()
+	if err != nil {
+		return err
+	}
+
+	list := output.NewList(cmd)
+	for _, srContext := range contexts {
+		out, _, err := describeContext(client, srContext)
+		if err != nil {
+			return err
+		}
+		list.Add(out)
+	}
+	return list.Print()
+}
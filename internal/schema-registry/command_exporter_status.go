@@ -13,6 +13,7 @@ func (c *command) newExporterStatusCommand(cfg *config.Config) *cobra.Command {
 	}
 
 	cmd.AddCommand(c.newExporterStatusDescribeCommand(cfg))
+	cmd.AddCommand(c.newExporterStatusListCommand(cfg))
 
 	return cmd
 }
@@ -0,0 +1,286 @@
+package schemaregistry
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/config"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/output"
+	"github.com/confluentinc/cli/v4/pkg/schemaregistry"
+)
+
+const exporterStatusWatchInterval = 5 * time.Second
+
+var unhealthyExporterStates = []string{"PAUSED", "ERROR"}
+
+type statusListOut struct {
+	Name            string `human:"Name" serialized:"name"`
+	State           string `human:"State" serialized:"state"`
+	Offset          int64  `human:"Offset" serialized:"offset"`
+	SinceLastChange string `human:"Since Last Change" serialized:"since_last_change"`
+	SubjectsBehind  *int   `human:"Subjects Behind" serialized:"subjects_behind"`
+	VersionsBehind  *int   `human:"Versions Behind" serialized:"versions_behind"`
+	ErrorTrace      string `human:"Error Trace" serialized:"error_trace,omitempty"`
+}
+
+func (c *command) newExporterStatusListCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the status of all schema exporters.",
+		Long:  "List the state, offset, and error trace of all schema exporters, along with an estimate of how many subjects and versions each exporter is behind its destination context. The command exits with a non-zero status if any schema exporter is paused or in error.",
+		Args:  cobra.NoArgs,
+		RunE:  c.exporterStatusList,
+	}
+
+	example1 := examples.Example{
+		Text: "List the status of all schema exporters.",
+		Code: "confluent schema-registry exporter status list",
+	}
+	example2 := examples.Example{
+		Text: "Refresh the status of all schema exporters until interrupted.",
+		Code: "confluent schema-registry exporter status list --watch",
+	}
+	if cfg.IsOnPremLogin() {
+		example1.Code += " " + onPremAuthenticationMsg
+		example2.Code += " " + onPremAuthenticationMsg
+	}
+	cmd.Example = examples.BuildExampleString(example1, example2)
+
+	cmd.Flags().Bool("watch", false, fmt.Sprintf("Refresh the status of the schema exporters every %d seconds until interrupted.", int(exporterStatusWatchInterval.Seconds())))
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	if cfg.IsCloudLogin() {
+		pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	} else {
+		addCaLocationAndClientPathFlags(cmd)
+	}
+	addSchemaRegistryEndpointFlag(cmd)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) exporterStatusList(cmd *cobra.Command, _ []string) error {
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	watch, err := cmd.Flags().GetBool("watch")
+	if err != nil {
+		return err
+	}
+
+	if !watch {
+		return c.printExporterStatuses(cmd, client)
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	defer signal.Stop(signals)
+
+	for {
+		if output.GetFormat(cmd) == output.Human {
+			output.Print(false, "\033[H\033[2J")
+		}
+		err := c.printExporterStatuses(cmd, client)
+		if err != nil {
+			output.ErrPrintln(c.Config.EnableColor, err.Error())
+		}
+
+		select {
+		case <-signals:
+			return err
+		case <-time.After(exporterStatusWatchInterval):
+		}
+	}
+}
+
+func (c *command) printExporterStatuses(cmd *cobra.Command, client *schemaregistry.Client) error {
+	exporters, err := client.GetExporters()
+	if err != nil {
+		return err
+	}
+
+	// The Schema Registry cluster ID names the destination context of exporters with context type "AUTO"
+	clusterId, clusterIdErr := c.getSchemaRegistryClusterId(client)
+
+	statuses := make([]*statusListOut, len(exporters))
+	errs := make([]error, len(exporters))
+
+	var wg sync.WaitGroup
+	for i, exporter := range exporters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			statuses[i], errs[i] = c.getExporterStatus(client, exporter, clusterId, clusterIdErr)
+		}()
+	}
+	wg.Wait()
+
+	var unhealthy []string
+	list := output.NewList(cmd)
+	for i, status := range statuses {
+		if errs[i] != nil {
+			return errs[i]
+		}
+		if slices.Contains(unhealthyExporterStates, status.State) || status.ErrorTrace != "" {
+			unhealthy = append(unhealthy, fmt.Sprintf(`"%s" (%s)`, status.Name, status.State))
+		}
+		list.Add(status)
+	}
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	if len(unhealthy) > 0 {
+		return fmt.Errorf("schema exporters are paused or in error: %s", strings.Join(unhealthy, ", "))
+	}
+	return nil
+}
+
+func (c *command) getExporterStatus(client *schemaregistry.Client, name, clusterId string, clusterIdErr error) (*statusListOut, error) {
+	status, err := client.GetExporterStatus(name)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &statusListOut{
+		Name:       status.GetName(),
+		State:      status.GetState(),
+		Offset:     status.GetOffset(),
+		ErrorTrace: status.GetTrace(),
+	}
+	if status.GetTs() > 0 {
+		out.SinceLastChange = time.Since(time.UnixMilli(status.GetTs())).Round(time.Second).String()
+	}
+
+	info, err := client.GetExporterInfo(name)
+	if err != nil {
+		return nil, err
+	}
+
+	// The lag is left empty, rather than reported as zero, when it cannot be estimated
+	subjectsBehind, versionsBehind, err := c.getExporterLag(client, info, clusterId, clusterIdErr)
+	if err != nil {
+		output.ErrPrintf(c.Config.EnableColor, "[WARN] Failed to estimate the lag of schema exporter \"%s\": %v\n", name, err)
+	} else {
+		out.SubjectsBehind = &subjectsBehind
+		out.VersionsBehind = &versionsBehind
+	}
+
+	return out, nil
+}
+
+// getExporterLag estimates how many subjects and versions an exporter is behind by comparing
+// the subjects it exports with the subjects in its destination context.
+func (c *command) getExporterLag(client *schemaregistry.Client, info srsdk.ExporterInfo, clusterId string, clusterIdErr error) (int, int, error) {
+	destination, err := c.getExporterDestinationClient(client, info.GetConfig())
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var prefix string
+	switch info.GetContextType() {
+	case "", "AUTO":
+		// Subjects are exported to a context named after the source Schema Registry cluster
+		if clusterIdErr != nil {
+			return 0, 0, clusterIdErr
+		}
+		prefix = contextPrefix(clusterId)
+	case "CUSTOM":
+		prefix = contextPrefix(info.GetContext())
+	case "NONE":
+	default:
+		return 0, 0, fmt.Errorf(`the destination context of context type "%s" cannot be determined`, info.GetContextType())
+	}
+
+	subjects := info.GetSubjects()
+	if len(subjects) == 0 || slices.Contains(subjects, "*") {
+		subjects, err = client.List("", false)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	destinationSubjects, err := destination.List(prefix, false)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	subjectFormat := info.GetSubjectRenameFormat()
+	if subjectFormat == "" {
+		subjectFormat = "${subject}"
+	}
+
+	subjectsBehind := 0
+	versionsBehind := 0
+	for _, subject := range subjects {
+		versions, err := client.ListVersions(subject, false)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		destinationSubject := prefix + strings.ReplaceAll(subjectFormat, "${subject}", subject)
+		if !slices.Contains(destinationSubjects, destinationSubject) {
+			subjectsBehind++
+			versionsBehind += len(versions)
+			continue
+		}
+
+		destinationVersions, err := destination.ListVersions(destinationSubject, false)
+		if err != nil {
+			return 0, 0, err
+		}
+		versionsBehind += max(len(versions)-len(destinationVersions), 0)
+	}
+
+	return subjectsBehind, versionsBehind, nil
+}
+
+// getSchemaRegistryClusterId returns the ID of the Schema Registry cluster, such as "lsrc-123456" in Confluent Cloud.
+func (c *command) getSchemaRegistryClusterId(client *schemaregistry.Client) (string, error) {
+	if !c.Config.IsCloudLogin() {
+		return client.GetSchemaRegistryClusterId()
+	}
+
+	clusters, err := c.V2Client.GetSchemaRegistryClustersByEnvironment(c.Context.GetCurrentEnvironment())
+	if err != nil {
+		return "", err
+	}
+	if len(clusters) == 0 {
+		return "", schemaregistry.ErrNotEnabled
+	}
+	return clusters[0].GetId(), nil
+}
+
+// getExporterDestinationClient returns a client for the Schema Registry an exporter writes to,
+// which is the source Schema Registry unless the exporter configuration names another one.
+func (c *command) getExporterDestinationClient(client *schemaregistry.Client, exporterConfig map[string]string) (*schemaregistry.Client, error) {
+	url := exporterConfig["schema.registry.url"]
+	if url == "" {
+		return client, nil
+	}
+
+	userInfo := exporterConfig["basic.auth.user.info"]
+	username, password, ok := strings.Cut(userInfo, ":")
+	if !ok {
+		return nil, fmt.Errorf(`credentials for destination Schema Registry "%s" are not available`, url)
+	}
+
+	configuration := srsdk.NewConfiguration()
+	configuration.UserAgent = c.Config.Version.UserAgent
+	configuration.Servers = srsdk.ServerConfigurations{{URL: url}}
+
+	return schemaregistry.NewClientWithApiKey(configuration, srsdk.BasicAuth{UserName: username, Password: password}), nil
+}
@@ -25,6 +25,7 @@ func (c *command) newKekCommand(cfg *config.Config) *cobra.Command {
 	cmd.AddCommand(c.newKekDeleteCommand(cfg))
 	cmd.AddCommand(c.newKekDescribeCommand(cfg))
 	cmd.AddCommand(c.newKekListCommand(cfg))
+	cmd.AddCommand(c.newKekRotateCommand(cfg))
 	cmd.AddCommand(c.newKekUndeleteCommand(cfg))
 	cmd.AddCommand(c.newKekUpdateCommand(cfg))
 
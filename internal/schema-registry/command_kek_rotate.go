@@ -0,0 +1,418 @@
+package schemaregistry
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+
+	ckgo "github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	"github.com/confluentinc/cli/v4/pkg/auth"
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/config"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	pkafka "github.com/confluentinc/cli/v4/pkg/kafka"
+	"github.com/confluentinc/cli/v4/pkg/log"
+	"github.com/confluentinc/cli/v4/pkg/output"
+	"github.com/confluentinc/cli/v4/pkg/schemaregistry"
+	"github.com/confluentinc/cli/v4/pkg/serdes"
+)
+
+const (
+	kekRotateSampleGroup   = "confluent_cli_kek_rotate"
+	kekRotateSampleTimeout = 10 * time.Second
+)
+
+type kekRotateOut struct {
+	Subject               string  `human:"Subject" serialized:"subject"`
+	Algorithm             string  `human:"Algorithm" serialized:"algorithm"`
+	NewVersion            int32   `human:"New Version" serialized:"new_version"`
+	PreviousVersions      []int32 `human:"Previous Versions" serialized:"previous_versions"`
+	PreviousVersionsInUse []int32 `human:"Previous Versions In Use" serialized:"previous_versions_in_use"`
+}
+
+func (c *command) newKekRotateCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate <name>",
+		Short: "Rotate the DEKs under a Key Encryption Key (KEK).",
+		Long:  "Rotate the Data Encryption Keys (DEKs) under a Key Encryption Key (KEK) by creating a new DEK version, with the same algorithm, for every subject. Previous DEK versions are kept so that existing records can still be decrypted, and are listed so they can be deleted once no clients use them. For a KEK that is not shared with Schema Registry, the new key material is encrypted with the KMS; the local KMS reads its secret from the LOCAL_KMS_SECRET environment variable.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  c.kekRotate,
+	}
+
+	example1 := examples.Example{
+		Text: `Rotate every DEK under KEK "test".`,
+		Code: "confluent schema-registry kek rotate test",
+	}
+	example2 := examples.Example{
+		Text: `Preview the DEKs that would be rotated for subject "payments-value".`,
+		Code: "confluent schema-registry kek rotate test --subjects payments-value --dry-run",
+	}
+	if cfg.IsOnPremLogin() {
+		example1.Code += " " + onPremAuthenticationMsg
+		example2.Code += " " + onPremAuthenticationMsg
+		cmd.Example = examples.BuildExampleString(example1, example2)
+	} else {
+		cmd.Long += "\n\n`--sample-records` reads the most recent records of the topic of each subject to list the previous DEK versions that producers still use. Records carry their DEK version only when the encryption rule sets \"encrypt.dek.expiry.days\". Run it with `--dry-run` after a rotation to check that producers have moved to the new version."
+		cmd.Example = examples.BuildExampleString(
+			example1,
+			example2,
+			examples.Example{
+				Text: `List the subjects under KEK "test" whose producers still use previous DEK versions, sampling the latest 100 records of each partition.`,
+				Code: "confluent schema-registry kek rotate test --dry-run --sample-records 100",
+			},
+		)
+	}
+
+	cmd.Flags().StringSlice("subjects", nil, "A comma-separated list of subjects whose DEKs should be rotated. Defaults to all subjects under the KEK.")
+	pcmd.AddDryRunFlag(cmd)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	if cfg.IsCloudLogin() {
+		cmd.Flags().Int("sample-records", 0, "Number of the most recent records to read from each partition of the topic of every subject, to find the previous DEK versions that producers still use.")
+		pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+		pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	} else {
+		addCaLocationAndClientPathFlags(cmd)
+	}
+	addSchemaRegistryEndpointFlag(cmd)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) kekRotate(cmd *cobra.Command, args []string) error {
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	subjects, err := cmd.Flags().GetStringSlice("subjects")
+	if err != nil {
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	kek, err := client.DescribeKek(args[0], false)
+	if err != nil {
+		return err
+	}
+
+	kekSubjects, err := client.GetDekSubjects(kek.GetName())
+	if err != nil {
+		return err
+	}
+	if len(subjects) == 0 {
+		subjects = kekSubjects
+	} else {
+		for _, subject := range subjects {
+			if !slices.Contains(kekSubjects, subject) {
+				return fmt.Errorf(`subject "%s" does not have a DEK under KEK "%s"`, subject, kek.GetName())
+			}
+		}
+	}
+
+	var sampleRecords int
+	if cmd.Flags().Lookup("sample-records") != nil {
+		sampleRecords, err = cmd.Flags().GetInt("sample-records")
+		if err != nil {
+			return err
+		}
+	}
+
+	var sampler *kekRotateSampler
+	if sampleRecords > 0 {
+		sampler, err = c.newKekRotateSampler(cmd, sampleRecords)
+		if err != nil {
+			return err
+		}
+		defer sampler.close()
+	}
+
+	errs := &multierror.Error{ErrorFormat: errors.CustomMultierrorList}
+	list := output.NewList(cmd)
+	if sampler == nil {
+		list.Filter([]string{"Subject", "Algorithm", "NewVersion", "PreviousVersions"})
+	}
+	for _, subject := range subjects {
+		var versionsInUse map[serdes.DekVersion]bool
+		if sampler != nil {
+			versionsInUse, err = sampler.sample(client, subject)
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf(`failed to sample records of subject "%s": %w`, subject, err))
+			}
+		}
+
+		for _, algorithm := range serdes.DekAlgorithms {
+			versions, err := client.GetDeKVersions(kek.GetName(), subject, algorithm, false)
+			if err != nil && !schemaregistry.IsNotFoundError(err) {
+				errs = multierror.Append(errs, fmt.Errorf(`failed to list DEK versions of subject "%s": %w`, subject, err))
+				continue
+			}
+			if len(versions) == 0 {
+				continue
+			}
+
+			latestVersion := slices.Max(versions)
+			newVersion := latestVersion + 1
+			if !dryRun {
+				if err := rotateDek(client, kek, subject, algorithm, newVersion); err != nil {
+					errs = multierror.Append(errs, fmt.Errorf(`failed to rotate %s DEK of subject "%s": %w`, algorithm, subject, err))
+					continue
+				}
+				latestVersion = newVersion
+			}
+
+			list.Add(&kekRotateOut{
+				Subject:               subject,
+				Algorithm:             algorithm,
+				NewVersion:            newVersion,
+				PreviousVersions:      versions,
+				PreviousVersionsInUse: getPreviousVersionsInUse(versionsInUse, algorithm, latestVersion),
+			})
+		}
+	}
+
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func rotateDek(client *schemaregistry.Client, kek srsdk.Kek, subject, algorithm string, version int32) error {
+	createReq := srsdk.CreateDekRequest{
+		Subject:   srsdk.PtrString(subject),
+		Version:   srsdk.PtrInt32(version),
+		Algorithm: srsdk.PtrString(algorithm),
+	}
+
+	// Schema Registry generates the key material of DEKs under a shared KEK
+	if !kek.GetShared() {
+		encryptedKeyMaterial, err := serdes.NewEncryptedDekKeyMaterial(algorithm, kek.GetKmsType(), kek.GetKmsKeyId(), kek.GetKmsProps())
+		if err != nil {
+			return err
+		}
+		createReq.EncryptedKeyMaterial = srsdk.PtrString(encryptedKeyMaterial)
+	}
+
+	_, err := client.CreateDek(kek.GetName(), createReq)
+	return err
+}
+
+// getPreviousVersionsInUse returns the sampled DEK versions of an algorithm which are older than the latest version.
+func getPreviousVersionsInUse(versionsInUse map[serdes.DekVersion]bool, algorithm string, latestVersion int32) []int32 {
+	var previousVersions []int32
+	for version := range versionsInUse {
+		if version.Algorithm == algorithm && version.Version < latestVersion {
+			previousVersions = append(previousVersions, version.Version)
+		}
+	}
+	slices.Sort(previousVersions)
+	return previousVersions
+}
+
+type kekRotateSampler struct {
+	consumer    *ckgo.Consumer
+	records     int
+	srEndpoint  string
+	srClusterId string
+	srAuth      serdes.SchemaRegistryAuth
+}
+
+func (c *command) newKekRotateSampler(cmd *cobra.Command, records int) (*kekRotateSampler, error) {
+	cluster, err := pkafka.GetClusterForCommand(c.V2Client, c.Context)
+	if err != nil {
+		return nil, err
+	}
+	if err := cluster.DecryptAPIKeys(); err != nil {
+		return nil, err
+	}
+
+	apiKey, apiSecret, err := c.Context.ResolveKafkaAPIKey(cluster)
+	if err != nil {
+		return nil, err
+	}
+	if apiKey == "" {
+		return nil, errors.NewErrorWithSuggestions(
+			"API key not set for the Kafka cluster",
+			fmt.Sprintf("Set an API key pair for the Kafka cluster using `confluent api-key create --resource %s` and `confluent api-key use`, or set an active Global API key with `confluent api-key use`.", cluster.ID),
+		)
+	}
+
+	consumer, err := ckgo.NewConsumer(&ckgo.ConfigMap{
+		"bootstrap.servers":  cluster.Bootstrap,
+		"sasl.mechanisms":    "PLAIN",
+		"security.protocol":  "SASL_SSL",
+		"sasl.username":      apiKey,
+		"sasl.password":      apiSecret,
+		"group.id":           kekRotateSampleGroup,
+		"enable.auto.commit": "false",
+	})
+	if err != nil {
+		return nil, fmt.Errorf(errors.FailedToCreateConsumerErrorMsg, err)
+	}
+
+	sampler := &kekRotateSampler{
+		consumer: consumer,
+		records:  records,
+	}
+
+	sampler.srAuth.Token, err = auth.GetDataplaneToken(c.Context)
+	if err != nil {
+		sampler.close()
+		return nil, err
+	}
+
+	sampler.srEndpoint, err = cmd.Flags().GetString("schema-registry-endpoint")
+	if err != nil {
+		sampler.close()
+		return nil, err
+	}
+	if sampler.srEndpoint == "" {
+		sampler.srClusterId, sampler.srEndpoint, err = c.GetCurrentSchemaRegistryClusterIdAndEndpoint(cmd)
+		if err != nil {
+			sampler.close()
+			return nil, err
+		}
+	}
+
+	return sampler, nil
+}
+
+func (s *kekRotateSampler) close() {
+	if err := s.consumer.Close(); err != nil {
+		log.CliLogger.Warnf("Failed to close consumer: %v", err)
+	}
+}
+
+// sample returns the DEK versions found in the most recent records of the topic of a subject, which is named with
+// the topic name strategy.
+func (s *kekRotateSampler) sample(client *schemaregistry.Client, subject string) (map[serdes.DekVersion]bool, error) {
+	mode := "value"
+	topic, ok := strings.CutSuffix(subject, "-value")
+	if !ok {
+		mode = "key"
+		topic, ok = strings.CutSuffix(subject, "-key")
+	}
+	if !ok {
+		return nil, errors.New("the subject does not follow the topic name strategy, so its topic is unknown")
+	}
+
+	schema, err := client.GetSchemaByVersion(subject, "latest", false)
+	if err != nil {
+		return nil, err
+	}
+
+	versionSampler, err := serdes.NewDekVersionSampler(s.srEndpoint, s.srClusterId, mode, schema.GetSchemaType(), s.srAuth, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := s.readLatestRecords(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	versionsInUse := make(map[serdes.DekVersion]bool)
+	var unversioned bool
+	var sampleErr error
+	for _, message := range messages {
+		payload := message.Value
+		if mode == "key" {
+			payload = message.Key
+		}
+		versions, recordUnversioned, err := versionSampler.Sample(topic, message.Headers, payload)
+		if err != nil {
+			log.CliLogger.Debugf("Failed to read DEK versions from offset %d of partition %d of topic \"%s\": %v", message.TopicPartition.Offset, message.TopicPartition.Partition, topic, err)
+			sampleErr = err
+			continue
+		}
+		for _, version := range versions {
+			versionsInUse[version] = true
+		}
+		unversioned = unversioned || recordUnversioned
+	}
+
+	if len(versionsInUse) == 0 {
+		if sampleErr != nil {
+			return nil, fmt.Errorf("failed to read DEK versions from any of the %d sampled record(s): %w", len(messages), sampleErr)
+		}
+		if unversioned {
+			return nil, errors.New(`records do not carry DEK versions, as the encryption rule does not set "encrypt.dek.expiry.days"`)
+		}
+	}
+
+	return versionsInUse, nil
+}
+
+// readLatestRecords reads up to the configured number of the most recent records from each partition of a topic.
+func (s *kekRotateSampler) readLatestRecords(topic string) ([]*ckgo.Message, error) {
+	timeoutMs := int(kekRotateSampleTimeout.Milliseconds())
+
+	metadata, err := s.consumer.GetMetadata(&topic, false, timeoutMs)
+	if err != nil {
+		return nil, err
+	}
+	topicMetadata, ok := metadata.Topics[topic]
+	if !ok {
+		return nil, fmt.Errorf(`topic "%s" not found`, topic)
+	}
+	if topicMetadata.Error.Code() != ckgo.ErrNoError {
+		return nil, topicMetadata.Error
+	}
+
+	var partitions []ckgo.TopicPartition
+	endOffsets := make(map[int32]int64)
+	for _, partition := range topicMetadata.Partitions {
+		low, high, err := s.consumer.QueryWatermarkOffsets(topic, partition.ID, timeoutMs)
+		if err != nil {
+			return nil, err
+		}
+		start := max(low, high-int64(s.records))
+		if start < high {
+			partitions = append(partitions, ckgo.TopicPartition{Topic: &topic, Partition: partition.ID, Offset: ckgo.Offset(start)})
+			endOffsets[partition.ID] = high
+		}
+	}
+	if len(partitions) == 0 {
+		return nil, nil
+	}
+
+	if err := s.consumer.Assign(partitions); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := s.consumer.Unassign(); err != nil {
+			log.CliLogger.Warnf("Failed to unassign partitions of topic \"%s\": %v", topic, err)
+		}
+	}()
+
+	var messages []*ckgo.Message
+	for len(endOffsets) > 0 {
+		message, err := s.consumer.ReadMessage(kekRotateSampleTimeout)
+		if err != nil {
+			if kafkaErr, ok := err.(ckgo.Error); ok && kafkaErr.IsTimeout() {
+				break
+			}
+			return nil, err
+		}
+		messages = append(messages, message)
+
+		partition := message.TopicPartition.Partition
+		if end, ok := endOffsets[partition]; ok && int64(message.TopicPartition.Offset)+1 >= end {
+			delete(endOffsets, partition)
+		}
+	}
+
+	return messages, nil
+}
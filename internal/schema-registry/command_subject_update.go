@@ -1,9 +1,15 @@
 package schemaregistry
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"path"
+	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/spf13/cobra"
 
 	srsdk "github.com/confluentinc/schema-registry-sdk-go"
@@ -18,9 +24,10 @@ import (
 
 func (c *command) newSubjectUpdateCommand(cfg *config.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "update <subject>",
+		Use:     "update [subject]",
 		Short:   "Update subject compatibility or mode.",
-		Args:    cobra.ExactArgs(1),
+		Long:    "Update the compatibility or mode of a subject, or of every subject matching a glob pattern. The previous subject-level configuration and mode of the matching subjects are saved to a backup file first, which can be rolled back with `--restore`.",
+		Args:    cobra.MaximumNArgs(1),
 		RunE:    c.subjectUpdate,
 		Example: examples.BuildExampleString(),
 	}
@@ -37,12 +44,27 @@ func (c *command) newSubjectUpdateCommand(cfg *config.Config) *cobra.Command {
 		Text: `Update subject-level mode of subject "payments".`,
 		Code: "confluent schema-registry subject update payments --mode readwrite",
 	}
+	example4 := examples.Example{
+		Text: `Preview the subjects matching "payments-*" and their current settings before making them read-only.`,
+		Code: `confluent schema-registry subject update --match "payments-*" --mode readonly --dry-run`,
+	}
+	example5 := examples.Example{
+		Text: `Make every subject matching "payments-*" read-only with full compatibility, saving the previous settings to "backup.json".`,
+		Code: `confluent schema-registry subject update --match "payments-*" --mode readonly --compatibility full --backup backup.json`,
+	}
+	example6 := examples.Example{
+		Text: `Roll back the settings saved to "backup.json".`,
+		Code: "confluent schema-registry subject update --restore backup.json",
+	}
 	if cfg.IsOnPremLogin() {
 		example1.Code += " " + onPremAuthenticationMsg
 		example2.Code += " " + onPremAuthenticationMsg
 		example3.Code += " " + onPremAuthenticationMsg
+		example4.Code += " " + onPremAuthenticationMsg
+		example5.Code += " " + onPremAuthenticationMsg
+		example6.Code += " " + onPremAuthenticationMsg
 	}
-	cmd.Example = examples.BuildExampleString(example1, example2, example3)
+	cmd.Example = examples.BuildExampleString(example1, example2, example3, example4, example5, example6)
 
 	addCompatibilityFlag(cmd)
 	addCompatibilityGroupFlag(cmd)
@@ -51,6 +73,10 @@ func (c *command) newSubjectUpdateCommand(cfg *config.Config) *cobra.Command {
 	addRulesetDefaultsFlag(cmd)
 	addRulesetOverridesFlag(cmd)
 	addModeFlag(cmd)
+	cmd.Flags().String("match", "", `Glob pattern of the subjects to update, for example "payments-*".`)
+	cmd.Flags().String("backup", "", "Path to a JSON file in which to save the previous settings of the matching subjects. Defaults to a timestamped file in the current directory.")
+	cmd.Flags().String("restore", "", "Path to a JSON backup file whose settings should be restored.")
+	pcmd.AddDryRunFlag(cmd)
 	pcmd.AddContextFlag(cmd, c.CLICommand)
 	if cfg.IsCloudLogin() {
 		pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
@@ -59,12 +85,18 @@ func (c *command) newSubjectUpdateCommand(cfg *config.Config) *cobra.Command {
 	}
 	addSchemaRegistryEndpointFlag(cmd)
 
+	cobra.CheckErr(cmd.MarkFlagFilename("backup", "json"))
+	cobra.CheckErr(cmd.MarkFlagFilename("restore", "json"))
+
+	cmd.MarkFlagsMutuallyExclusive("match", "restore")
+	cmd.MarkFlagsMutuallyExclusive("backup", "restore")
+	cmd.MarkFlagsMutuallyExclusive("compatibility", "restore")
+	cmd.MarkFlagsMutuallyExclusive("mode", "restore")
+
 	return cmd
 }
 
 func (c *command) subjectUpdate(cmd *cobra.Command, args []string) error {
-	subject := args[0]
-
 	client, err := c.GetSchemaRegistryClient(cmd)
 	if err != nil {
 		return err
@@ -79,6 +111,33 @@ func (c *command) subjectUpdate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	match, err := cmd.Flags().GetString("match")
+	if err != nil {
+		return err
+	}
+	restore, err := cmd.Flags().GetString("restore")
+	if err != nil {
+		return err
+	}
+
+	if match != "" || restore != "" {
+		if len(args) > 0 {
+			return fmt.Errorf("cannot specify a subject with `--match` or `--restore`")
+		}
+		if restore != "" {
+			return c.restoreSubjectSettings(cmd, restore, client)
+		}
+		return c.batchUpdate(cmd, match, compatibility, mode, client)
+	}
+
+	if len(args) == 0 {
+		return errors.NewErrorWithSuggestions("missing subject", "Pass a subject as an argument, or select subjects with `--match`.")
+	}
+	if cmd.Flags().Changed("backup") || cmd.Flags().Changed("dry-run") {
+		return fmt.Errorf("`--backup` and `--dry-run` can only be used with `--match` or `--restore`")
+	}
+	subject := args[0]
+
 	if compatibility != "" && mode != "" {
 		return fmt.Errorf(errors.CompatibilityOrModeErrorMsg)
 	}
@@ -117,3 +176,266 @@ func (c *command) updateMode(subject, mode string, client *schemaregistry.Client
 	output.Printf(c.Config.EnableColor, "Successfully updated subject-level mode to \"%s\" for subject \"%s\".\n", res.GetMode(), subject)
 	return nil
 }
+
+// subjectSettings is the subject-level configuration and mode of a subject, as saved by `--backup`.
+// A nil field was not changed, and an empty configuration or mode means the subject inherited it.
+type subjectSettings struct {
+	Subject string        `json:"subject"`
+	Config  *srsdk.Config `json:"config,omitempty"`
+	Mode    *string       `json:"mode,omitempty"`
+}
+
+type subjectSettingsOut struct {
+	Subject       string `human:"Subject" serialized:"subject"`
+	Compatibility string `human:"Compatibility" serialized:"compatibility"`
+	Mode          string `human:"Mode" serialized:"mode"`
+}
+
+func (c *command) batchUpdate(cmd *cobra.Command, match, compatibility, mode string, client *schemaregistry.Client) error {
+	if compatibility == "" && mode == "" {
+		return fmt.Errorf(errors.CompatibilityOrModeErrorMsg)
+	}
+
+	subjects, err := matchSubjects(client, match)
+	if err != nil {
+		return err
+	}
+	if len(subjects) == 0 {
+		return fmt.Errorf(`no subjects match "%s"`, match)
+	}
+
+	settings := make([]subjectSettings, len(subjects))
+	for i, subject := range subjects {
+		settings[i], err = getSubjectSettings(client, subject, compatibility != "", mode != "")
+		if err != nil {
+			return err
+		}
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		return printSubjectSettings(cmd, settings)
+	}
+
+	backup, err := cmd.Flags().GetString("backup")
+	if err != nil {
+		return err
+	}
+	if backup == "" {
+		backup = getDefaultSubjectBackupFile(time.Now())
+	}
+	if err := writeSubjectSettings(backup, settings); err != nil {
+		return err
+	}
+	if absBackup, err := filepath.Abs(backup); err == nil {
+		backup = absBackup
+	}
+	output.Printf(c.Config.EnableColor, "Saved the previous settings of %d subject(s) to \"%s\".\n", len(subjects), backup)
+
+	errs := &multierror.Error{ErrorFormat: errors.CustomMultierrorList}
+	for _, subject := range subjects {
+		if compatibility != "" {
+			if err := c.updateCompatibility(cmd, subject, client); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}
+		if mode != "" {
+			if err := c.updateMode(subject, mode, client); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}
+	}
+	output.Printf(c.Config.EnableColor, "Roll back the settings with `confluent schema-registry subject update --restore %s`.\n", backup)
+
+	return errs.ErrorOrNil()
+}
+
+func (c *command) restoreSubjectSettings(cmd *cobra.Command, backupFile string, client *schemaregistry.Client) error {
+	settings, err := readSubjectSettings(backupFile)
+	if err != nil {
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		return printSubjectSettings(cmd, settings)
+	}
+
+	errs := &multierror.Error{ErrorFormat: errors.CustomMultierrorList}
+	for _, setting := range settings {
+		if err := restoreSubject(client, setting); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf(`failed to restore subject "%s": %w`, setting.Subject, err))
+			continue
+		}
+		output.Printf(c.Config.EnableColor, "Restored the settings of subject \"%s\".\n", setting.Subject)
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// matchSubjects lists the subjects matching a glob pattern, using the literal part of the pattern as the subject prefix.
+func matchSubjects(client *schemaregistry.Client, pattern string) ([]string, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf(`invalid glob pattern "%s": %w`, pattern, err)
+	}
+
+	prefix := pattern
+	if i := strings.IndexAny(pattern, `*?[\`); i != -1 {
+		prefix = pattern[:i]
+	}
+
+	subjects, err := client.List(prefix, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []string
+	for _, subject := range subjects {
+		if ok, _ := path.Match(pattern, subject); ok {
+			matches = append(matches, subject)
+		}
+	}
+	return matches, nil
+}
+
+// getSubjectSettings saves the whole subject-level configuration, without the defaults it inherits, since the
+// compatibility group, metadata, and ruleset are updated together with the compatibility.
+func getSubjectSettings(client *schemaregistry.Client, subject string, config, mode bool) (subjectSettings, error) {
+	settings := subjectSettings{Subject: subject}
+
+	if config {
+		subjectConfig, err := client.GetSubjectLevelConfig(subject)
+		if err != nil && !schemaregistry.IsNotFoundError(err) {
+			return subjectSettings{}, err
+		}
+		settings.Config = &subjectConfig
+	}
+
+	if mode {
+		res, err := client.GetSubjectLevelMode(subject)
+		if err != nil && !schemaregistry.IsNotFoundError(err) {
+			return subjectSettings{}, err
+		}
+		settings.Mode = srsdk.PtrString(res.GetMode())
+	}
+
+	return settings, nil
+}
+
+func restoreSubject(client *schemaregistry.Client, settings subjectSettings) error {
+	if settings.Config != nil {
+		if err := restoreSubjectConfig(client, settings.Subject, *settings.Config); err != nil {
+			return err
+		}
+	}
+
+	if settings.Mode != nil {
+		if *settings.Mode == "" {
+			if _, err := client.DeleteSubjectLevelMode(settings.Subject); err != nil && !schemaregistry.IsNotFoundError(err) {
+				return err
+			}
+		} else if _, err := client.UpdateMode(settings.Subject, srsdk.ModeUpdateRequest{Mode: settings.Mode}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restoreSubjectConfig restores each field of a subject-level configuration. Schema Registry keeps the fields which
+// are not part of an update, so the configuration is only deleted first if it has a field which was not set before.
+func restoreSubjectConfig(client *schemaregistry.Client, subject string, previous srsdk.Config) error {
+	current, err := client.GetSubjectLevelConfig(subject)
+	if err != nil && !schemaregistry.IsNotFoundError(err) {
+		return err
+	}
+
+	if hasConfigFieldNotIn(current, previous) {
+		if _, err := client.DeleteSubjectLevelConfig(subject); err != nil && !schemaregistry.IsNotFoundError(err) {
+			return err
+		}
+	}
+
+	if !hasConfigFieldNotIn(previous, srsdk.Config{}) {
+		return nil
+	}
+
+	req := srsdk.ConfigUpdateRequest{
+		Compatibility:      previous.CompatibilityLevel,
+		CompatibilityGroup: previous.CompatibilityGroup,
+		DefaultMetadata:    previous.DefaultMetadata,
+		OverrideMetadata:   previous.OverrideMetadata,
+		DefaultRuleSet:     previous.DefaultRuleSet,
+		OverrideRuleSet:    previous.OverrideRuleSet,
+	}
+	_, err = client.UpdateSubjectLevelConfig(subject, req)
+	return err
+}
+
+// hasConfigFieldNotIn reports whether a configuration sets a field which another configuration does not.
+func hasConfigFieldNotIn(config, other srsdk.Config) bool {
+	return config.CompatibilityLevel != nil && other.CompatibilityLevel == nil ||
+		config.CompatibilityGroup != nil && other.CompatibilityGroup == nil ||
+		config.DefaultMetadata.Get() != nil && other.DefaultMetadata.Get() == nil ||
+		config.OverrideMetadata.Get() != nil && other.OverrideMetadata.Get() == nil ||
+		config.DefaultRuleSet.Get() != nil && other.DefaultRuleSet.Get() == nil ||
+		config.OverrideRuleSet.Get() != nil && other.OverrideRuleSet.Get() == nil
+}
+
+func printSubjectSettings(cmd *cobra.Command, settings []subjectSettings) error {
+	list := output.NewList(cmd)
+	for _, setting := range settings {
+		list.Add(&subjectSettingsOut{
+			Subject:       setting.Subject,
+			Compatibility: setting.getCompatibility(),
+			Mode:          setting.getMode(),
+		})
+	}
+	return list.Print()
+}
+
+// getDefaultSubjectBackupFile returns the backup file in the current directory used when `--backup` is not passed.
+func getDefaultSubjectBackupFile(now time.Time) string {
+	return fmt.Sprintf("subject-settings-%s.json", now.Format("20060102-150405"))
+}
+
+func writeSubjectSettings(backupFile string, settings []subjectSettings) error {
+	data, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(backupFile, append(data, '\n'), 0644)
+}
+
+func readSubjectSettings(backupFile string) ([]subjectSettings, error) {
+	data, err := os.ReadFile(backupFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var settings []subjectSettings
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil, fmt.Errorf(`failed to parse backup file "%s": %w`, backupFile, err)
+	}
+	return settings, nil
+}
+
+func (s subjectSettings) getCompatibility() string {
+	if s.Config == nil {
+		return ""
+	}
+	return s.Config.GetCompatibilityLevel()
+}
+
+func (s subjectSettings) getMode() string {
+	if s.Mode == nil {
+		return ""
+	}
+	return *s.Mode
+}
@@ -0,0 +1,63 @@
+package schemaregistry
+
+import (
+	"github.com/spf13/cobra"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+)
+
+func (c *command) newTagCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "tag",
+		Short:       "Manage Stream Catalog tags.",
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLogin},
+	}
+
+	cmd.AddCommand(c.newTagAttachCommand())
+	cmd.AddCommand(c.newTagCreateCommand())
+	cmd.AddCommand(c.newTagDeleteCommand())
+	cmd.AddCommand(c.newTagDetachCommand())
+	cmd.AddCommand(c.newTagListCommand())
+	cmd.AddCommand(c.newTagSearchCommand())
+
+	return cmd
+}
+
+type tagOut struct {
+	Name        string   `human:"Name" serialized:"name"`
+	Description string   `human:"Description,omitempty" serialized:"description,omitempty"`
+	EntityTypes []string `human:"Entity Types" serialized:"entity_types"`
+}
+
+func addCatalogFlags(cmd *cobra.Command, c *command) {
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	addSchemaRegistryEndpointFlag(cmd)
+}
+
+func tagsFromCatalogFile(file *catalogFile) []catalogAssignment {
+	var assignments []catalogAssignment
+	for _, tag := range file.Tags {
+		for _, entity := range tag.Entities {
+			assignments = append(assignments, catalogAssignment{Name: tag.Name, Entity: entity})
+		}
+	}
+	return assignments
+}
+
+func newTagDef(name, description string, entityTypes []string) srsdk.TagDef {
+	if len(entityTypes) == 0 {
+		entityTypes = []string{"cf_entity"}
+	}
+
+	tagDef := srsdk.TagDef{
+		Name:        srsdk.PtrString(name),
+		EntityTypes: &entityTypes,
+	}
+	if description != "" {
+		tagDef.Description = srsdk.PtrString(description)
+	}
+	return tagDef
+}
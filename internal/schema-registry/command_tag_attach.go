@@ -0,0 +1,99 @@
+package schemaregistry
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+func (c *command) newTagAttachCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attach [name]",
+		Short: "Attach tags to Stream Catalog entities.",
+		Long:  "Attach a tag to a topic, subject, schema, record, field, or connector, or attach every tag listed in a YAML file to its entities.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  c.tagAttach,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Attach tag "PII" to field "email" of record "com.example.User" in schema 100001.`,
+				Code: "confluent schema-registry tag attach PII --entity-type field --entity-name lsrc-123456:.:100001:com.example.User.email",
+			},
+			examples.Example{
+				Text: `Attach tag "PII" to topic "payments".`,
+				Code: "confluent schema-registry tag attach PII --entity-type topic --entity-name lkc-123456:payments",
+			},
+			examples.Example{
+				Text: "Attach the tags listed in a YAML file to their entities.",
+				Code: "confluent schema-registry tag attach --file catalog.yaml",
+			},
+		),
+	}
+
+	addEntityFlags(cmd)
+	addCatalogFileFlag(cmd)
+	addCatalogFlags(cmd, c)
+	pcmd.AddOutputFlag(cmd)
+
+	cmd.MarkFlagsRequiredTogether("entity-type", "entity-name")
+	cmd.MarkFlagsMutuallyExclusive("file", "entity-type")
+
+	return cmd
+}
+
+func (c *command) tagAttach(cmd *cobra.Command, args []string) error {
+	assignments, err := getCatalogAssignments(cmd, args, tagsFromCatalogFile)
+	if err != nil {
+		return err
+	}
+
+	if len(assignments) == 0 {
+		return fmt.Errorf("no tagged entities found")
+	}
+
+	tags := make([]srsdk.Tag, len(assignments))
+	for i, assignment := range assignments {
+		tags[i] = srsdk.Tag{
+			TypeName:   srsdk.PtrString(assignment.Name),
+			EntityType: srsdk.PtrString(assignment.Entity.Type),
+			EntityName: srsdk.PtrString(assignment.Entity.Name),
+		}
+	}
+
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.CreateTags(tags)
+	if err != nil {
+		return err
+	}
+
+	errs := &multierror.Error{ErrorFormat: errors.CustomMultierrorList}
+	list := output.NewList(cmd)
+	for _, tag := range res {
+		if tag.Error != nil {
+			errs = multierror.Append(errs, fmt.Errorf(`failed to attach tag "%s" to %s "%s": %s`, tag.GetTypeName(), tag.GetEntityType(), tag.GetEntityName(), tag.Error.GetMessage()))
+			continue
+		}
+		list.Add(&catalogEntityOut{
+			Name:       tag.GetTypeName(),
+			EntityType: tag.GetEntityType(),
+			EntityName: tag.GetEntityName(),
+		})
+	}
+
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	return errs.ErrorOrNil()
+}
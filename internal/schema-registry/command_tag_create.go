@@ -0,0 +1,117 @@
+package schemaregistry
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+func (c *command) newTagCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [name]",
+		Short: "Create tag definitions.",
+		Long:  "Create a tag definition, or create every tag definition listed in a YAML file.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  c.tagCreate,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Create a tag named "PII" that can be attached to any entity.`,
+				Code: `confluent schema-registry tag create PII --description "Personally identifiable information."`,
+			},
+			examples.Example{
+				Text: "Create the tags defined in a YAML file.",
+				Code: "confluent schema-registry tag create --file catalog.yaml",
+			},
+		),
+	}
+
+	cmd.Flags().String("description", "", "Description of the tag.")
+	cmd.Flags().StringSlice("entity-types", []string{"cf_entity"}, "A comma-separated list of Stream Catalog entity types the tag can be attached to.")
+	addCatalogFileFlag(cmd)
+	addCatalogFlags(cmd, c)
+	pcmd.AddOutputFlag(cmd)
+
+	cmd.MarkFlagsMutuallyExclusive("file", "description")
+	cmd.MarkFlagsMutuallyExclusive("file", "entity-types")
+
+	return cmd
+}
+
+func (c *command) tagCreate(cmd *cobra.Command, args []string) error {
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	var tagDefs []srsdk.TagDef
+	if file != "" {
+		if len(args) > 0 {
+			return fmt.Errorf("cannot specify both a name and `--file`")
+		}
+		catalog, err := readCatalogFile(file)
+		if err != nil {
+			return err
+		}
+		for _, tag := range catalog.Tags {
+			tagDefs = append(tagDefs, newTagDef(tag.Name, tag.Description, tag.EntityTypes))
+		}
+	} else {
+		if len(args) == 0 {
+			return errors.NewErrorWithSuggestions("missing tag name", "Pass a tag name as an argument or use the `--file` flag.")
+		}
+
+		description, err := cmd.Flags().GetString("description")
+		if err != nil {
+			return err
+		}
+
+		entityTypes, err := cmd.Flags().GetStringSlice("entity-types")
+		if err != nil {
+			return err
+		}
+
+		tagDefs = append(tagDefs, newTagDef(args[0], description, entityTypes))
+	}
+
+	if len(tagDefs) == 0 {
+		return fmt.Errorf(`no tags found in "%s"`, file)
+	}
+
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.CreateTagDefs(tagDefs)
+	if err != nil {
+		return err
+	}
+
+	errs := &multierror.Error{ErrorFormat: errors.CustomMultierrorList}
+	list := output.NewList(cmd)
+	for _, tagDef := range res {
+		if tagDef.Error != nil {
+			errs = multierror.Append(errs, fmt.Errorf(`failed to create tag "%s": %s`, tagDef.GetName(), tagDef.Error.GetMessage()))
+			continue
+		}
+		list.Add(&tagOut{
+			Name:        tagDef.GetName(),
+			Description: tagDef.GetDescription(),
+			EntityTypes: tagDef.GetEntityTypes(),
+		})
+	}
+
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,64 @@
+package schemaregistry
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+func (c *command) newTagDetachCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "detach [name]",
+		Short: "Detach tags from Stream Catalog entities.",
+		Long:  "Detach a tag from an entity, or detach every tag listed in a YAML file from its entities.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  c.tagDetach,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Detach tag "PII" from topic "payments".`,
+				Code: "confluent schema-registry tag detach PII --entity-type topic --entity-name lkc-123456:payments",
+			},
+			examples.Example{
+				Text: "Detach the tags listed in a YAML file from their entities.",
+				Code: "confluent schema-registry tag detach --file catalog.yaml",
+			},
+		),
+	}
+
+	addEntityFlags(cmd)
+	addCatalogFileFlag(cmd)
+	addCatalogFlags(cmd, c)
+
+	cmd.MarkFlagsRequiredTogether("entity-type", "entity-name")
+	cmd.MarkFlagsMutuallyExclusive("file", "entity-type")
+
+	return cmd
+}
+
+func (c *command) tagDetach(cmd *cobra.Command, args []string) error {
+	assignments, err := getCatalogAssignments(cmd, args, tagsFromCatalogFile)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	errs := &multierror.Error{ErrorFormat: errors.CustomMultierrorList}
+	for _, assignment := range assignments {
+		if err := client.DeleteTag(assignment.Entity.Type, assignment.Entity.Name, assignment.Name); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf(`failed to detach tag "%s" from %s "%s": %w`, assignment.Name, assignment.Entity.Type, assignment.Entity.Name, err))
+			continue
+		}
+		output.Printf(c.Config.EnableColor, "Detached tag \"%s\" from %s \"%s\".\n", assignment.Name, assignment.Entity.Type, assignment.Entity.Name)
+	}
+
+	return errs.ErrorOrNil()
+}
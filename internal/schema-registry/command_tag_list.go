@@ -0,0 +1,87 @@
+package schemaregistry
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+func (c *command) newTagListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List tag definitions or the tags attached to an entity.",
+		Args:  cobra.NoArgs,
+		RunE:  c.tagList,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "List all tag definitions.",
+				Code: "confluent schema-registry tag list",
+			},
+			examples.Example{
+				Text: `List the tags attached to topic "payments".`,
+				Code: "confluent schema-registry tag list --entity-type topic --entity-name lkc-123456:payments",
+			},
+		),
+	}
+
+	cmd.Flags().String("prefix", "", "Only list tag definitions whose names start with this prefix.")
+	addEntityFlags(cmd)
+	addCatalogFlags(cmd, c)
+	pcmd.AddOutputFlag(cmd)
+
+	cmd.MarkFlagsRequiredTogether("entity-type", "entity-name")
+	cmd.MarkFlagsMutuallyExclusive("prefix", "entity-type")
+
+	return cmd
+}
+
+func (c *command) tagList(cmd *cobra.Command, _ []string) error {
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	entityType, entityName, err := getEntityFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	if entityType != "" {
+		tags, err := client.GetTags(entityType, entityName)
+		if err != nil {
+			return err
+		}
+
+		list := output.NewList(cmd)
+		for _, tag := range tags {
+			list.Add(&catalogEntityOut{
+				Name:       tag.GetTypeName(),
+				EntityType: tag.GetEntityType(),
+				EntityName: tag.GetEntityName(),
+			})
+		}
+		return list.Print()
+	}
+
+	prefix, err := cmd.Flags().GetString("prefix")
+	if err != nil {
+		return err
+	}
+
+	tagDefs, err := client.GetAllTagDefs(prefix)
+	if err != nil {
+		return err
+	}
+
+	list := output.NewList(cmd)
+	for _, tagDef := range tagDefs {
+		list.Add(&tagOut{
+			Name:        tagDef.GetName(),
+			Description: tagDef.GetDescription(),
+			EntityTypes: tagDef.GetEntityTypes(),
+		})
+	}
+	return list.Print()
+}
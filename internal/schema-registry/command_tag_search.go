@@ -0,0 +1,75 @@
+package schemaregistry
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v4/pkg/cmd"
+	"github.com/confluentinc/cli/v4/pkg/examples"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+const searchPageSize = 500
+
+type searchOut struct {
+	EntityType    string   `human:"Entity Type" serialized:"entity_type"`
+	QualifiedName string   `human:"Qualified Name" serialized:"qualified_name"`
+	Tags          []string `human:"Tags" serialized:"tags"`
+}
+
+func (c *command) newTagSearchCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search <name>",
+		Short: "Search for entities that have a tag attached.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  c.tagSearch,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `List all fields tagged with "PII".`,
+				Code: "confluent schema-registry tag search PII --entity-type field",
+			},
+		),
+	}
+
+	cmd.Flags().String("entity-type", "", "Only search entities of this type.")
+	pcmd.RegisterFlagCompletionFunc(cmd, "entity-type", func(_ *cobra.Command, _ []string) []string { return entityTypes })
+	addCatalogFlags(cmd, c)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) tagSearch(cmd *cobra.Command, args []string) error {
+	entityType, err := cmd.Flags().GetString("entity-type")
+	if err != nil {
+		return err
+	}
+	entityType = resolveEntityType(entityType)
+
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	list := output.NewList(cmd)
+	for offset := int32(0); ; offset += searchPageSize {
+		res, err := client.SearchByTag(args[0], entityType, searchPageSize, offset)
+		if err != nil {
+			return err
+		}
+
+		entities := res.GetEntities()
+		for _, entity := range entities {
+			qualifiedName, _ := entity.GetAttributes()["qualifiedName"].(string)
+			list.Add(&searchOut{
+				EntityType:    entity.GetTypeName(),
+				QualifiedName: qualifiedName,
+				Tags:          entity.GetClassificationNames(),
+			})
+		}
+
+		if len(entities) < searchPageSize {
+			break
+		}
+	}
+	return list.Print()
+}
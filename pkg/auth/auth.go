@@ -40,6 +40,9 @@ const (
 	ConfluentPlatformCmfClientKeyPath            = "CONFLUENT_CMF_CLIENT_KEY_PATH"
 	ConfluentPlatformCmfClientCertPath           = "CONFLUENT_CMF_CLIENT_CERT_PATH"
 	ConfluentPlatformCmfCertificateAuthorityPath = "CONFLUENT_CMF_CERTIFICATE_AUTHORITY_PATH"
+
+	// Confluent Platform Kafka Connect environment variables
+	ConfluentPlatformConnectURL = "CONFLUENT_CONNECT_URL"
 )
 
 func IsOnPremSSOEnv() bool {
@@ -107,20 +107,15 @@ func UnmarshalFlatQueryResponseIfDataSchemaMatchError(err error, metricsResponse
 		return err
 	}
 
-	var resBody flatQueryResponse
+	// Points are unmarshalled by the SDK so that the labels of grouped queries are kept in their additional properties
+	var resBody struct {
+		Data []metricsv2.Point `json:"data"`
+	}
 	if err := json.Unmarshal(body, &resBody); err != nil {
 		return err
 	}
 
-	points := make([]metricsv2.Point, len(resBody.Data))
-	for i, dataPoint := range resBody.Data {
-		points[i] = metricsv2.Point{
-			Value:     dataPoint.Value,
-			Timestamp: dataPoint.Timestamp,
-		}
-	}
-
-	metricsResponse.FlatQueryResponse = metricsv2.NewFlatQueryResponse(points)
+	metricsResponse.FlatQueryResponse = metricsv2.NewFlatQueryResponse(resBody.Data)
 	return nil
 }
 
@@ -57,47 +57,74 @@ func NewAuthenticatedWithMDSCLICommand(cmd *cobra.Command, prerunner PreRunner)
 }
 
 func (c *AuthenticatedCLICommand) GetFlinkGatewayClient(computePoolOnly bool) (*ccloudv2.FlinkGatewayClient, error) {
-	if c.flinkGatewayClient == nil {
-		var url string
-		var err error
-
-		if c.Context.GetCurrentFlinkEndpoint() != "" {
-			url = c.Context.GetCurrentFlinkEndpoint()
-		} else if computePoolOnly {
-			if computePoolId := c.Context.GetCurrentFlinkComputePool(); computePoolId != "" {
-				url, err = c.getGatewayUrlForComputePool(c.Context.GetCurrentFlinkAccessType(), computePoolId)
-				if err != nil {
-					return nil, err
-				}
-			} else {
-				return nil, errors.NewErrorWithSuggestions("no compute pool selected", "Select a compute pool with `confluent flink compute-pool use` or `--compute-pool`.")
-			}
-		} else if c.Context.GetCurrentFlinkRegion() != "" && c.Context.GetCurrentFlinkCloudProvider() != "" {
-			url, err = c.getGatewayUrlForRegion(c.Context.GetCurrentFlinkAccessType(), c.Context.GetCurrentFlinkCloudProvider(), c.Context.GetCurrentFlinkRegion())
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			return nil, errors.NewErrorWithSuggestions("no cloud provider and region selected", "Select a cloud provider and region with `confluent flink region use` or `--cloud` and `--region`.")
-		}
+	return c.getFlinkGatewayClient(computePoolOnly, c.Context.GetCurrentFlinkComputePool())
+}
 
-		unsafeTrace, err := c.Flags().GetBool("unsafe-trace")
-		if err != nil {
-			return nil, err
-		}
+// GetFlinkGatewayClientForComputePool returns the Flink gateway client of a compute pool which may differ from the
+// current one, without changing the current compute pool of the context. Only the client of the current compute pool is
+// cached, since another compute pool may be in a different cloud provider or region.
+func (c *AuthenticatedCLICommand) GetFlinkGatewayClientForComputePool(computePoolId string) (*ccloudv2.FlinkGatewayClient, error) {
+	if computePoolId == c.Context.GetCurrentFlinkComputePool() {
+		return c.getFlinkGatewayClient(true, computePoolId)
+	}
+	return c.newFlinkGatewayClient(true, computePoolId)
+}
 
-		dataplaneToken, err := auth.GetDataplaneToken(c.Context)
+// GetFlinkGatewayUrlForComputePool returns the URL of the Flink gateway which runs the statements of a compute pool.
+func (c *AuthenticatedCLICommand) GetFlinkGatewayUrlForComputePool(computePoolId string) (string, error) {
+	return c.getFlinkGatewayUrl(true, computePoolId)
+}
+
+func (c *AuthenticatedCLICommand) getFlinkGatewayClient(computePoolOnly bool, computePoolId string) (*ccloudv2.FlinkGatewayClient, error) {
+	if c.flinkGatewayClient == nil {
+		client, err := c.newFlinkGatewayClient(computePoolOnly, computePoolId)
 		if err != nil {
 			return nil, err
 		}
-
-		log.CliLogger.Debugf("The final url used for setting up Flink dataplane client is: %s\n", url)
-		c.flinkGatewayClient = ccloudv2.NewFlinkGatewayClient(url, c.Version.UserAgent, unsafeTrace, dataplaneToken)
+		c.flinkGatewayClient = client
 	}
 
 	return c.flinkGatewayClient, nil
 }
 
+func (c *AuthenticatedCLICommand) newFlinkGatewayClient(computePoolOnly bool, computePoolId string) (*ccloudv2.FlinkGatewayClient, error) {
+	url, err := c.getFlinkGatewayUrl(computePoolOnly, computePoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	unsafeTrace, err := c.Flags().GetBool("unsafe-trace")
+	if err != nil {
+		return nil, err
+	}
+
+	dataplaneToken, err := auth.GetDataplaneToken(c.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	log.CliLogger.Debugf("The final url used for setting up Flink dataplane client is: %s\n", url)
+	return ccloudv2.NewFlinkGatewayClient(url, c.Version.UserAgent, unsafeTrace, dataplaneToken), nil
+}
+
+func (c *AuthenticatedCLICommand) getFlinkGatewayUrl(computePoolOnly bool, computePoolId string) (string, error) {
+	if c.Context.GetCurrentFlinkEndpoint() != "" {
+		return c.Context.GetCurrentFlinkEndpoint(), nil
+	}
+
+	if computePoolOnly {
+		if computePoolId == "" {
+			return "", errors.NewErrorWithSuggestions("no compute pool selected", "Select a compute pool with `confluent flink compute-pool use` or `--compute-pool`.")
+		}
+		return c.getGatewayUrlForComputePool(c.Context.GetCurrentFlinkAccessType(), computePoolId)
+	}
+
+	if c.Context.GetCurrentFlinkRegion() == "" || c.Context.GetCurrentFlinkCloudProvider() == "" {
+		return "", errors.NewErrorWithSuggestions("no cloud provider and region selected", "Select a cloud provider and region with `confluent flink region use` or `--cloud` and `--region`.")
+	}
+	return c.getGatewayUrlForRegion(c.Context.GetCurrentFlinkAccessType(), c.Context.GetCurrentFlinkCloudProvider(), c.Context.GetCurrentFlinkRegion())
+}
+
 func (c *AuthenticatedCLICommand) getGatewayUrlForComputePool(access, id string) (string, error) {
 	if c.Config.IsTest {
 		return testserver.TestFlinkGatewayUrl.String(), nil
@@ -601,6 +601,12 @@ func resolveOnPremKafkaRestFlags(cmd *cobra.Command) (*onPremKafkaRestFlagValues
 	return values, nil
 }
 
+// CreateOnPremHTTPClient returns a client for an on-premises REST API, which trusts the Certificate Authority from the
+// flag, the environment variable, or the context, and presents the client certificate for mTLS authentication.
+func CreateOnPremHTTPClient(ctx *config.Context, caCertPath, clientCertPath, clientKeyPath string) (*http.Client, error) {
+	return createOnPremKafkaRestClient(ctx, caCertPath, clientCertPath, clientKeyPath, log.CliLogger)
+}
+
 func createOnPremKafkaRestClient(ctx *config.Context, caCertPath, clientCertPath, clientKeyPath string, logger *log.Logger) (*http.Client, error) {
 	if caCertPath == "" {
 		caCertPath = os.Getenv(pauth.ConfluentPlatformCertificateAuthorityPath)
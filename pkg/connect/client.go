@@ -0,0 +1,43 @@
+package connect
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-retryablehttp"
+
+	"github.com/confluentinc/cli/v4/pkg/log"
+)
+
+// Client is a client for the REST API of a self-managed Kafka Connect cluster.
+type Client struct {
+	URL       string
+	Debug     bool
+	UserAgent string
+	// AuthToken is sent as a bearer token, and takes precedence over Username and Password for basic authentication.
+	AuthToken string
+	Username  string
+	Password  string
+	// Client retries failed requests, and NoRetryClient is used for requests which are not safe to retry.
+	Client        *http.Client
+	NoRetryClient *http.Client
+}
+
+// NewClient returns a client which sends requests with httpClient, such as a client configured for TLS.
+func NewClient(url, userAgent string, httpClient *http.Client, unsafeTrace bool) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	client := retryablehttp.NewClient()
+	client.HTTPClient = httpClient
+	client.Logger = log.NewLeveledLogger(unsafeTrace)
+
+	return &Client{
+		URL:           strings.TrimSuffix(url, "/"),
+		Debug:         unsafeTrace,
+		UserAgent:     userAgent,
+		Client:        client.StandardClient(),
+		NoRetryClient: httpClient,
+	}
+}
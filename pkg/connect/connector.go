@@ -0,0 +1,169 @@
+package connect
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+
+	"github.com/confluentinc/cli/v4/pkg/log"
+)
+
+const clientNotInitializedErrorMsg = "connect client not initialized"
+
+type ConnectorInfo struct {
+	Name   string            `json:"name"`
+	Config map[string]string `json:"config"`
+	Type   string            `json:"type"`
+}
+
+type ConnectorState struct {
+	State    string `json:"state"`
+	WorkerId string `json:"worker_id"`
+	Trace    string `json:"trace,omitempty"`
+}
+
+type TaskState struct {
+	Id       int    `json:"id"`
+	State    string `json:"state"`
+	WorkerId string `json:"worker_id"`
+	Trace    string `json:"trace,omitempty"`
+}
+
+type ConnectorStatus struct {
+	Name      string         `json:"name"`
+	Connector ConnectorState `json:"connector"`
+	Tasks     []TaskState    `json:"tasks"`
+	Type      string         `json:"type"`
+}
+
+type ConnectorExpansion struct {
+	Info   ConnectorInfo   `json:"info"`
+	Status ConnectorStatus `json:"status"`
+}
+
+type errorResponse struct {
+	ErrorCode int    `json:"error_code"`
+	Message   string `json:"message"`
+}
+
+// ListConnectors returns the connectors of the Connect cluster with their configurations and statuses, by name.
+func (c *Client) ListConnectors() (map[string]ConnectorExpansion, error) {
+	body, err := c.do(http.MethodGet, "/connectors?expand=info&expand=status", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	connectors := make(map[string]ConnectorExpansion)
+	if err := json.Unmarshal(body, &connectors); err != nil {
+		return nil, err
+	}
+	return connectors, nil
+}
+
+// CreateConnector is not retried, since a retry after a timeout could fail because the connector already exists.
+func (c *Client) CreateConnector(name string, config map[string]string) error {
+	_, err := c.doWithoutRetry(http.MethodPost, "/connectors", map[string]any{"name": name, "config": config})
+	return err
+}
+
+func (c *Client) UpdateConnectorConfig(name string, config map[string]string) error {
+	_, err := c.do(http.MethodPut, fmt.Sprintf("/connectors/%s/config", url.PathEscape(name)), config)
+	return err
+}
+
+func (c *Client) PauseConnector(name string) error {
+	_, err := c.do(http.MethodPut, fmt.Sprintf("/connectors/%s/pause", url.PathEscape(name)), nil)
+	return err
+}
+
+func (c *Client) ResumeConnector(name string) error {
+	_, err := c.do(http.MethodPut, fmt.Sprintf("/connectors/%s/resume", url.PathEscape(name)), nil)
+	return err
+}
+
+func (c *Client) DeleteConnector(name string) error {
+	_, err := c.do(http.MethodDelete, fmt.Sprintf("/connectors/%s", url.PathEscape(name)), nil)
+	return err
+}
+
+func (c *Client) do(method, path string, payload any) ([]byte, error) {
+	if c == nil {
+		return nil, errors.New(clientNotInitializedErrorMsg)
+	}
+	return c.send(c.Client, method, path, payload)
+}
+
+func (c *Client) doWithoutRetry(method, path string, payload any) ([]byte, error) {
+	if c == nil {
+		return nil, errors.New(clientNotInitializedErrorMsg)
+	}
+	return c.send(c.NoRetryClient, method, path, payload)
+}
+
+func (c *Client) send(client *http.Client, method, path string, payload any) ([]byte, error) {
+	var reqBody io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, c.URL+path, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("User-Agent", c.UserAgent)
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.AuthToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.AuthToken)
+	} else if c.Username != "" {
+		req.SetBasicAuth(c.Username, c.Password)
+	}
+
+	if c.Debug {
+		dump, err := httputil.DumpRequestOut(req, true)
+		if err != nil {
+			return nil, err
+		}
+		log.CliLogger.Trace(string(dump))
+	}
+
+	r, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	if c.Debug {
+		dump, err := httputil.DumpResponse(r, true)
+		if err != nil {
+			return nil, err
+		}
+		log.CliLogger.Trace(string(dump))
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.StatusCode >= http.StatusBadRequest {
+		response := new(errorResponse)
+		if err := json.Unmarshal(body, response); err == nil && response.Message != "" {
+			return nil, fmt.Errorf("%s %s failed: %s", method, path, response.Message)
+		}
+		return nil, fmt.Errorf("%s %s failed with status %d", method, path, r.StatusCode)
+	}
+
+	return body, nil
+}
@@ -0,0 +1,80 @@
+package connect
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		requests = append(requests, r.Method+" "+r.URL.RequestURI()+" "+string(body))
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/connectors":
+			_, _ = w.Write([]byte(`{"orders-sink": {"info": {"name": "orders-sink", "config": {"tasks.max": "1"}, "type": "sink"}, "status": {"name": "orders-sink", "connector": {"state": "PAUSED", "worker_id": "connect:8083"}, "tasks": [], "type": "sink"}}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/connectors":
+			w.WriteHeader(http.StatusConflict)
+			_ = json.NewEncoder(w).Encode(errorResponse{ErrorCode: http.StatusConflict, Message: "Connector orders-sink already exists"})
+		default:
+			w.WriteHeader(http.StatusAccepted)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL+"/", "", nil, false)
+
+	connectors, err := client.ListConnectors()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"tasks.max": "1"}, connectors["orders-sink"].Info.Config)
+	require.Equal(t, "PAUSED", connectors["orders-sink"].Status.Connector.State)
+
+	err = client.CreateConnector("orders-sink", map[string]string{"tasks.max": "1"})
+	require.EqualError(t, err, "POST /connectors failed: Connector orders-sink already exists")
+
+	require.NoError(t, client.UpdateConnectorConfig("orders sink", map[string]string{"tasks.max": "2"}))
+	require.NoError(t, client.ResumeConnector("orders-sink"))
+	require.NoError(t, client.DeleteConnector("orders-sink"))
+
+	require.Equal(t, []string{
+		"GET /connectors?expand=info&expand=status ",
+		`POST /connectors {"config":{"tasks.max":"1"},"name":"orders-sink"}`,
+		`PUT /connectors/orders%20sink/config {"tasks.max":"2"}`,
+		"PUT /connectors/orders-sink/resume ",
+		"DELETE /connectors/orders-sink ",
+	}, requests)
+}
+
+func TestClientCreateConnectorIsNotRetried(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "", server.Client(), false)
+	client.AuthToken = "token"
+
+	err := client.CreateConnector("orders-sink", map[string]string{"tasks.max": "1"})
+	require.EqualError(t, err, "POST /connectors failed with status 503")
+
+	client.AuthToken = ""
+	client.Username = "user"
+	client.Password = "pass"
+
+	err = client.CreateConnector("orders-sink", map[string]string{"tasks.max": "1"})
+	require.EqualError(t, err, "POST /connectors failed with status 503")
+
+	require.Equal(t, []string{
+		"POST /connectors Bearer token",
+		"POST /connectors Basic dXNlcjpwYXNz",
+	}, requests)
+}
@@ -33,7 +33,8 @@ type Application struct {
 	baseOutputController        types.OutputControllerInterface
 	refreshToken                func() error
 	reportUsage                 func()
-	appOptions                  types.ApplicationOptions
+	appOptions                  *types.ApplicationOptions
+	snippets                    *snippetLibrary
 }
 
 var mutex sync.Mutex
@@ -58,6 +59,9 @@ func StartApp(gatewayClient ccloudv2.GatewayClientInterface, tokenRefreshFunc fu
 
 	// Load history of previous commands from cache file
 	historyStore := history.LoadHistory()
+	if appOptions.GetSession() != nil {
+		historyStore = history.LoadHistoryFromPath(appOptions.Session.GetHistoryPath())
+	}
 
 	// Instantiate Application Controller - this is the top level controller that will be passed down to all other controllers
 	// and should be used for functions that are not specific to a component
@@ -65,6 +69,7 @@ func StartApp(gatewayClient ccloudv2.GatewayClientInterface, tokenRefreshFunc fu
 
 	// Store used to process statements and store local properties
 	userProperties := store.NewUserProperties(&appOptions)
+	store.PersistSession(userProperties, &appOptions)
 	dataStore := store.NewStore(gatewayClient, appController.ExitApplication, userProperties, &appOptions, synchronizedTokenRefreshFunc)
 	resultFetcher := results.NewResultFetcher(dataStore)
 
@@ -105,7 +110,8 @@ func StartApp(gatewayClient ccloudv2.GatewayClientInterface, tokenRefreshFunc fu
 		}
 	})
 
-	inputController := controller.NewInputController(historyStore, lspCompleter, handlerCh, true)
+	library := newSnippetLibrary(userProperties)
+	inputController := controller.NewInputController(historyStore, lspCompleter, handlerCh, library.get, true)
 	statementController := controller.NewStatementController(appController, dataStore, consoleParser)
 	interactiveOutputController := controller.NewInteractiveOutputController(components.NewTableView(), resultFetcher, userProperties, appOptions.GetVerbose())
 	baseOutputController := controller.NewBaseOutputController(resultFetcher, inputController.GetWindowWidth, userProperties)
@@ -122,7 +128,8 @@ func StartApp(gatewayClient ccloudv2.GatewayClientInterface, tokenRefreshFunc fu
 		baseOutputController:        baseOutputController,
 		refreshToken:                synchronizedTokenRefreshFunc,
 		reportUsage:                 reportUsageFunc,
-		appOptions:                  appOptions,
+		appOptions:                  &appOptions,
+		snippets:                    library,
 	}
 	components.PrintWelcomeHeader(appOptions)
 	return app.readEvalPrintLoop()
@@ -133,6 +140,9 @@ func StartAppOnPrem(flinkCmfClient *flink.CmfRestClient, tokenRefreshFunc func()
 
 	// Load history of previous commands from cache file
 	historyStore := history.LoadHistoryOnPrem()
+	if appOptions.GetSession() != nil {
+		historyStore = history.LoadHistoryFromPath(appOptions.Session.GetHistoryPath())
+	}
 
 	// Instantiate Application Controller - this is the top level controller that will be passed down to all other controllers
 	// and should be used for functions that are not specific to a component
@@ -140,6 +150,7 @@ func StartAppOnPrem(flinkCmfClient *flink.CmfRestClient, tokenRefreshFunc func()
 
 	// Store used to process statements and store local properties
 	userProperties := store.NewUserProperties(&appOptions)
+	store.PersistSession(userProperties, &appOptions)
 	dataStore := store.NewStoreOnPrem(flinkCmfClient, appController.ExitApplication, userProperties, &appOptions, synchronizedTokenRefreshFunc)
 	resultFetcher := results.NewResultFetcher(dataStore)
 
@@ -155,7 +166,8 @@ func StartAppOnPrem(flinkCmfClient *flink.CmfRestClient, tokenRefreshFunc func()
 	})
 
 	// Instantiate Component Controllers
-	inputController := controller.NewInputController(historyStore, nil, nil, false)
+	library := newSnippetLibrary(userProperties)
+	inputController := controller.NewInputController(historyStore, nil, nil, library.get, false)
 	statementController := controller.NewStatementController(appController, dataStore, consoleParser)
 	interactiveOutputController := controller.NewInteractiveOutputController(components.NewTableView(), resultFetcher, userProperties, appOptions.GetVerbose())
 	baseOutputController := controller.NewBaseOutputController(resultFetcher, inputController.GetWindowWidth, userProperties)
@@ -172,7 +184,8 @@ func StartAppOnPrem(flinkCmfClient *flink.CmfRestClient, tokenRefreshFunc func()
 		baseOutputController:        baseOutputController,
 		refreshToken:                synchronizedTokenRefreshFunc,
 		reportUsage:                 func() { /* on-prem does not support usage reporting */ },
-		appOptions:                  appOptions,
+		appOptions:                  &appOptions,
+		snippets:                    library,
 	}
 	components.PrintWelcomeHeaderOnPrem(appOptions)
 	return app.readEvalPrintLoop()
@@ -200,7 +213,29 @@ func (a *Application) readEvalPrint() {
 		return
 	}
 
-	executedStatement, err := a.statementController.ExecuteStatement(userInput)
+	if store.IsExportStatement(userInput) {
+		a.history.Append(userInput)
+		a.exportResults(userInput)
+		return
+	}
+
+	if store.IsSessionsStatement(userInput) {
+		a.history.Append(userInput)
+		a.processSessionsStatement(userInput)
+		return
+	}
+
+	if store.IsSnippetStatement(userInput) {
+		a.history.Append(userInput)
+		a.processSnippetStatement(userInput)
+		return
+	}
+
+	a.executeStatement(userInput)
+}
+
+func (a *Application) executeStatement(statement string) {
+	executedStatement, err := a.statementController.ExecuteStatement(statement)
 	if err != nil {
 		return
 	}
@@ -214,7 +249,31 @@ func (a *Application) readEvalPrint() {
 	if !executedStatement.IsDryRunStatement() {
 		a.resultFetcher.Init(*executedStatement)
 		a.getOutputController(*executedStatement).VisualizeResults()
+
+		if outputFile := a.userProperties.Get(config.KeyOutputFile); outputFile != "" && !executedStatement.IsLocalStatement {
+			// The output file receives the results of every statement, so the results of the previous one are replaced
+			a.exportResultsToFile(outputFile, true)
+		}
+	}
+}
+
+// exportResults handles the local EXPORT statement, which writes the results of the previous statement to a file
+func (a *Application) exportResults(statement string) {
+	path, err := store.ParseExportStatement(statement)
+	if err != nil {
+		utils.OutputErr(err.Error())
+		return
+	}
+	a.exportResultsToFile(path, false)
+}
+
+func (a *Application) exportResultsToFile(path string, overwrite bool) {
+	rowCount, err := results.ExportResults(path, a.resultFetcher.GetMaterializedStatementResults(), overwrite)
+	if err != nil {
+		utils.OutputErrf("Error: failed to export results: %v", err)
+		return
 	}
+	utils.OutputInfof(results.ExportedResultsMsg, rowCount, path)
 }
 
 func (a *Application) panicRecovery() {
@@ -2,6 +2,8 @@ package app
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -14,6 +16,7 @@ import (
 	flinkgatewayv1 "github.com/confluentinc/ccloud-sdk-go-v2/flink-gateway/v1"
 
 	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/flink/config"
 	"github.com/confluentinc/cli/v4/pkg/flink/internal/controller"
 	"github.com/confluentinc/cli/v4/pkg/flink/internal/history"
 	"github.com/confluentinc/cli/v4/pkg/flink/internal/store"
@@ -53,6 +56,7 @@ func (s *ApplicationTestSuite) SetupTest() {
 
 	s.app = Application{
 		history:                     s.history,
+		userProperties:              store.NewUserPropertiesWithDefaults(map[string]string{}, map[string]string{}),
 		store:                       s.store,
 		resultFetcher:               s.resultFetcher,
 		appController:               s.appController,
@@ -230,6 +234,66 @@ func (s *ApplicationTestSuite) TestReplUsesInteractiveOutput() {
 	cupaloy.SnapshotT(s.T(), actual)
 }
 
+func (s *ApplicationTestSuite) TestReplExportsResults() {
+	path := filepath.Join(s.T().TempDir(), "results.csv")
+	userInput := fmt.Sprintf("EXPORT TO '%s';", path)
+	s.inputController.EXPECT().GetUserInput().Return(userInput)
+	s.inputController.EXPECT().HasUserEnabledReverseSearch().Return(false)
+	s.inputController.EXPECT().HasUserInitiatedExit(userInput).Return(false)
+	s.resultFetcher.EXPECT().GetMaterializedStatementResults().Return(getExportResultsExample())
+
+	actual := test.RunAndCaptureSTDOUT(s.T(), s.app.readEvalPrint)
+
+	require.Equal(s.T(), fmt.Sprintf("Exported 1 row(s) to \"%s\".\n", path), actual)
+	require.Equal(s.T(), []string{userInput}, s.history.Data)
+	require.FileExists(s.T(), path)
+}
+
+func (s *ApplicationTestSuite) TestReplExportsResultsRefusesExistingFile() {
+	path := filepath.Join(s.T().TempDir(), "results.csv")
+	require.NoError(s.T(), os.WriteFile(path, []byte("existing"), 0644))
+	userInput := fmt.Sprintf("EXPORT TO '%s';", path)
+	s.inputController.EXPECT().GetUserInput().Return(userInput)
+	s.inputController.EXPECT().HasUserEnabledReverseSearch().Return(false)
+	s.inputController.EXPECT().HasUserInitiatedExit(userInput).Return(false)
+	s.resultFetcher.EXPECT().GetMaterializedStatementResults().Return(getExportResultsExample())
+
+	actual := test.RunAndCaptureSTDOUT(s.T(), s.app.readEvalPrint)
+
+	require.Contains(s.T(), actual, "already exists")
+	contents, err := os.ReadFile(path)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "existing", string(contents))
+}
+
+func (s *ApplicationTestSuite) TestReplExportsResultsToOutputFile() {
+	path := filepath.Join(s.T().TempDir(), "results.jsonl")
+	s.app.userProperties.Set(config.KeyOutputFile, path)
+	userInput := "test-input"
+	statement := types.ProcessedStatement{PageToken: "not-empty"}
+	s.inputController.EXPECT().GetUserInput().Return(userInput)
+	s.inputController.EXPECT().HasUserEnabledReverseSearch().Return(false)
+	s.inputController.EXPECT().HasUserInitiatedExit(userInput).Return(false)
+	s.statementController.EXPECT().ExecuteStatement(userInput).Return(&statement, nil)
+	s.resultFetcher.EXPECT().Init(statement)
+	s.interactiveOutputController.EXPECT().VisualizeResults()
+	s.resultFetcher.EXPECT().GetMaterializedStatementResults().Return(getExportResultsExample())
+
+	actual := test.RunAndCaptureSTDOUT(s.T(), s.app.readEvalPrint)
+
+	require.Equal(s.T(), fmt.Sprintf("Exported 1 row(s) to \"%s\".\n", path), actual)
+	require.FileExists(s.T(), path)
+}
+
+func getExportResultsExample() *types.MaterializedStatementResults {
+	materializedResults := types.NewMaterializedStatementResults([]string{"id"}, 10, nil)
+	materializedResults.Append(types.StatementResultRow{
+		Operation: types.Insert,
+		Fields:    []types.StatementResultField{types.AtomicStatementResultField{Type: types.Integer, Value: "1"}},
+	})
+	return &materializedResults
+}
+
 func (s *ApplicationTestSuite) TestShouldUseTView() {
 	app := Application{
 		interactiveOutputController: &controller.InteractiveOutputController{},
@@ -0,0 +1,82 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/confluentinc/cli/v4/pkg/ccloudv2"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/results"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/store"
+	"github.com/confluentinc/cli/v4/pkg/flink/types"
+)
+
+const (
+	// MaxStatementResults is the maximum number of rows that are materialized for a statement.
+	MaxStatementResults = results.MaxResultsCapacity
+	ExportedResultsMsg  = results.ExportedResultsMsg
+)
+
+// FetchStatementResults fetches the results of an existing statement page by page, the same way the interactive
+// shell does, until the last page has been fetched, maxRows rows have been materialized, or a running statement
+// has no more results available.
+func FetchStatementResults(gatewayClient ccloudv2.GatewayClientInterface, appOptions types.ApplicationOptions, statementName string, maxRows int) (*types.MaterializedStatementResults, error) {
+	statementObj, err := gatewayClient.GetStatement(appOptions.GetEnvironmentId(), statementName, appOptions.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+
+	// The token of the calling command is still valid, so there is no need to refresh it
+	userProperties := store.NewUserProperties(&appOptions)
+	dataStore := store.NewStore(gatewayClient, func() {}, userProperties, &appOptions, func() error { return nil })
+	resultFetcher := results.NewResultFetcher(dataStore)
+
+	return fetchStatementResults(dataStore, resultFetcher, *types.NewProcessedStatement(statementObj), maxRows)
+}
+
+func fetchStatementResults(dataStore types.StoreInterface, resultFetcher types.ResultFetcherInterface, statement types.ProcessedStatement, maxRows int) (*types.MaterializedStatementResults, error) {
+	firstPage, statementErr := dataStore.FetchStatementResults(statement)
+	if statementErr != nil {
+		return nil, statementErr
+	}
+	resultFetcher.Init(*firstPage)
+
+	if err := fetchRemainingStatementResults(resultFetcher, maxRows); err != nil {
+		return nil, err
+	}
+	return resultFetcher.GetMaterializedStatementResults(), nil
+}
+
+// fetchRemainingStatementResults fetches the pages after the one the result fetcher was initialized with.
+func fetchRemainingStatementResults(resultFetcher types.ResultFetcherInterface, maxRows int) error {
+	for resultFetcher.GetMaterializedStatementResults().GetChangelogSize() < maxRows {
+		switch resultFetcher.GetRefreshState() {
+		case types.Completed:
+			return nil
+		case types.Failed:
+			return fmt.Errorf(`failed to fetch the results of statement "%s"`, resultFetcher.GetStatement().StatementName)
+		}
+
+		rowCount := resultFetcher.GetMaterializedStatementResults().GetChangelogSize()
+		resultFetcher.FetchNextPage()
+		if resultFetcher.GetMaterializedStatementResults().GetChangelogSize() == rowCount && resultFetcher.GetStatement().Status == types.RUNNING {
+			break
+		}
+	}
+
+	return nil
+}
+
+// ExportStatementResults writes the changelog of the results to a CSV, JSON Lines, or Parquet file. An existing
+// file is only replaced if overwrite is set.
+func ExportStatementResults(path string, materializedResults *types.MaterializedStatementResults, overwrite bool) (int, error) {
+	return results.ExportResults(path, materializedResults, overwrite)
+}
+
+// GetUniqueColumnNames suffixes repeated column names of statement results with their occurrence.
+func GetUniqueColumnNames(columns []string) []string {
+	return results.GetUniqueColumnNames(columns)
+}
+
+// ValidateExportFile checks that results can be exported to a file with the given path.
+func ValidateExportFile(path string) error {
+	return results.ValidateExportFile(path)
+}
@@ -0,0 +1,68 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/results"
+	"github.com/confluentinc/cli/v4/pkg/flink/test/mock"
+	"github.com/confluentinc/cli/v4/pkg/flink/types"
+)
+
+func getResultsPage(pageToken string, values ...string) *types.ProcessedStatement {
+	rows := make([]types.StatementResultRow, len(values))
+	for i, value := range values {
+		rows[i] = types.StatementResultRow{
+			Operation: types.Insert,
+			Fields:    []types.StatementResultField{types.AtomicStatementResultField{Type: types.Integer, Value: value}},
+		}
+	}
+	return &types.ProcessedStatement{
+		StatementName:    "stmt-1",
+		Status:           types.COMPLETED,
+		PageToken:        pageToken,
+		StatementResults: &types.StatementResults{Headers: []string{"id"}, Rows: rows},
+	}
+}
+
+func TestFetchStatementResults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dataStore := mock.NewMockStoreInterface(ctrl)
+	resultFetcher := results.NewResultFetcher(dataStore)
+
+	statement := types.ProcessedStatement{StatementName: "stmt-1", Status: types.COMPLETED}
+	dataStore.EXPECT().FetchStatementResults(statement).Return(getResultsPage("page-2", "1", "2"), nil)
+	dataStore.EXPECT().FetchStatementResults(*getResultsPage("page-2", "1", "2")).Return(getResultsPage("", "3"), nil)
+
+	materializedResults, err := fetchStatementResults(dataStore, resultFetcher, statement, 10)
+	require.NoError(t, err)
+	require.Equal(t, 3, materializedResults.GetChangelogSize())
+}
+
+func TestFetchStatementResultsStopsAtMaxRows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dataStore := mock.NewMockStoreInterface(ctrl)
+	resultFetcher := results.NewResultFetcher(dataStore)
+
+	statement := types.ProcessedStatement{StatementName: "stmt-1", Status: types.COMPLETED}
+	dataStore.EXPECT().FetchStatementResults(statement).Return(getResultsPage("page-2", "1", "2"), nil)
+
+	materializedResults, err := fetchStatementResults(dataStore, resultFetcher, statement, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, materializedResults.GetChangelogSize())
+}
+
+func TestFetchStatementResultsFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dataStore := mock.NewMockStoreInterface(ctrl)
+	resultFetcher := results.NewResultFetcher(dataStore)
+
+	statement := types.ProcessedStatement{StatementName: "stmt-1", Status: types.COMPLETED}
+	dataStore.EXPECT().FetchStatementResults(statement).Return(getResultsPage("page-2", "1"), nil)
+	dataStore.EXPECT().FetchStatementResults(*getResultsPage("page-2", "1")).Return(nil, &types.StatementError{Message: "not found"})
+
+	_, err := fetchStatementResults(dataStore, resultFetcher, statement, 10)
+	require.EqualError(t, err, `failed to fetch the results of statement "stmt-1"`)
+}
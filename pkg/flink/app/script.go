@@ -0,0 +1,159 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+
+	"github.com/confluentinc/cli/v4/pkg/ccloudv2"
+	"github.com/confluentinc/cli/v4/pkg/flink"
+	"github.com/confluentinc/cli/v4/pkg/flink/config"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/controller"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/results"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/store"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/utils"
+	"github.com/confluentinc/cli/v4/pkg/flink/types"
+	"github.com/confluentinc/cli/v4/pkg/output"
+)
+
+// ScriptStatementResult is the outcome of one statement of a SQL script.
+type ScriptStatementResult struct {
+	Statement     string
+	StatementName string
+	Status        types.PHASE
+	Error         *types.StatementError
+}
+
+var scriptVariablesSuggestion = fmt.Sprintf("Set the variables with `--var`, `--var-file`, environment variables, or \"SET '%s<name>'='<value>'\" statements before they are used.", config.KeyVariables)
+
+// The width of the printed results when the output is not a terminal
+const scriptWindowWidth = 100
+
+type scriptRunner struct {
+	store          types.StoreInterface
+	userProperties types.UserPropertiesInterface
+	exited         bool
+}
+
+// RunScript executes the statements of a SQL script in order without starting the interactive shell.
+// Execution stops at the first failed statement unless continueOnError is set. No statement is executed if any
+// variable of the script is not set.
+func RunScript(gatewayClient ccloudv2.GatewayClientInterface, tokenRefreshFunc func() error, appOptions types.ApplicationOptions, script string, continueOnError bool) ([]ScriptStatementResult, error) {
+	userProperties := store.NewUserProperties(&appOptions)
+	runner := &scriptRunner{userProperties: userProperties}
+	statements := store.SplitSQLStatements(script)
+	if unresolved := store.GetUnresolvedScriptVariables(userProperties, statements); len(unresolved) > 0 {
+		return nil, unresolvedVariablesError(unresolved, scriptVariablesSuggestion)
+	}
+
+	runner.store = store.NewStore(gatewayClient, runner.exit, userProperties, &appOptions, synchronizedTokenRefresh(tokenRefreshFunc))
+	return runner.run(statements, continueOnError), nil
+}
+
+// RunScriptOnPrem executes the statements of a SQL script in order against Confluent Manager for Apache Flink.
+func RunScriptOnPrem(flinkCmfClient *flink.CmfRestClient, tokenRefreshFunc func() error, appOptions types.ApplicationOptions, script string, continueOnError bool) ([]ScriptStatementResult, error) {
+	userProperties := store.NewUserProperties(&appOptions)
+	runner := &scriptRunner{userProperties: userProperties}
+	statements := store.SplitSQLStatements(script)
+	if unresolved := store.GetUnresolvedScriptVariables(userProperties, statements); len(unresolved) > 0 {
+		return nil, unresolvedVariablesError(unresolved, scriptVariablesSuggestion)
+	}
+
+	runner.store = store.NewStoreOnPrem(flinkCmfClient, runner.exit, userProperties, &appOptions, synchronizedTokenRefresh(tokenRefreshFunc))
+	return runner.run(statements, continueOnError), nil
+}
+
+func (r *scriptRunner) exit() {
+	r.exited = true
+}
+
+func (r *scriptRunner) run(statements []string, continueOnError bool) []ScriptStatementResult {
+	var results []ScriptStatementResult
+	for _, statement := range statements {
+		processedStatement, err := r.executeStatement(statement)
+		if r.exited {
+			break
+		}
+
+		result := ScriptStatementResult{Statement: statement}
+		if err != nil {
+			utils.OutputErr(err.Error())
+			result.Status = types.FAILED
+			result.Error = err
+		} else {
+			result.StatementName = processedStatement.StatementName
+			result.Status = processedStatement.Status
+		}
+		results = append(results, result)
+
+		if err != nil && !continueOnError {
+			break
+		}
+	}
+	return results
+}
+
+// executeStatement processes local statements such as SET, USE, and RESET in the client, and otherwise submits the
+// statement, waits for it to reach RUNNING or COMPLETED, and prints its results.
+func (r *scriptRunner) executeStatement(statement string) (*types.ProcessedStatement, *types.StatementError) {
+	statement = strings.TrimSpace(statement)
+
+	processedStatement, err := r.store.ProcessLocalStatement(statement)
+	if err != nil {
+		return nil, err
+	}
+	if processedStatement != nil || r.exited {
+		return processedStatement, nil
+	}
+
+	processedStatement, err = r.store.ProcessStatement(statement)
+	if err != nil {
+		return nil, err
+	}
+	if processedStatement.IsDryRunStatement() {
+		processedStatement.PrintOutputDryRunStatement()
+		return processedStatement, nil
+	}
+	processedStatement.PrintStatusMessage()
+
+	// SELECT statements have no sink, so they are deleted once their results have been printed or they failed
+	if processedStatement.IsSelectStatement() {
+		defer r.store.DeleteStatement(processedStatement.StatementName)
+	}
+
+	readyStatement, err := r.store.WaitPendingStatement(context.Background(), *processedStatement)
+	if err != nil {
+		return nil, err
+	}
+
+	statementWithResults, err := r.store.FetchStatementResults(*readyStatement)
+	if err != nil {
+		return nil, err
+	}
+
+	resultFetcher := results.NewResultFetcher(r.store)
+	resultFetcher.Init(*statementWithResults)
+	if err := fetchRemainingStatementResults(resultFetcher, MaxStatementResults); err != nil {
+		return nil, &types.StatementError{Message: err.Error()}
+	}
+	controller.NewBaseOutputController(resultFetcher, getScriptWindowWidth, r.userProperties).VisualizeResults()
+
+	finishedStatement := resultFetcher.GetStatement()
+	if finishedStatement.IsTerminalState() {
+		finishedStatement.PrintStatementDoneStatus()
+	} else {
+		output.Printf(false, "Statement phase is %s.\n", finishedStatement.Status)
+	}
+
+	return &finishedStatement, nil
+}
+
+func getScriptWindowWidth() int {
+	if width, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil {
+		return width
+	}
+	return scriptWindowWidth
+}
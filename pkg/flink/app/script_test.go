@@ -0,0 +1,140 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/store"
+	"github.com/confluentinc/cli/v4/pkg/flink/test/mock"
+	"github.com/confluentinc/cli/v4/pkg/flink/types"
+)
+
+func TestScriptRunner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dataStore := mock.NewMockStoreInterface(ctrl)
+	runner := &scriptRunner{store: dataStore}
+
+	setStatement := "SET 'sql.local-time-zone' = 'UTC';"
+	createStatement := "CREATE TABLE t (id INT);"
+	pendingStatement := types.ProcessedStatement{Statement: createStatement, StatementName: "stmt-1", Status: types.PENDING}
+	createdStatement := types.ProcessedStatement{Statement: createStatement, StatementName: "stmt-1", Status: types.COMPLETED}
+
+	dataStore.EXPECT().ProcessLocalStatement(setStatement).Return(&types.ProcessedStatement{Statement: setStatement, Status: types.COMPLETED, IsLocalStatement: true}, nil)
+	dataStore.EXPECT().ProcessLocalStatement(createStatement).Return(nil, nil)
+	dataStore.EXPECT().ProcessStatement(createStatement).Return(&pendingStatement, nil)
+	dataStore.EXPECT().WaitPendingStatement(gomock.Any(), pendingStatement).Return(&createdStatement, nil)
+	dataStore.EXPECT().FetchStatementResults(createdStatement).Return(&createdStatement, nil)
+
+	results := runner.run([]string{setStatement, createStatement}, false)
+	require.Equal(t, []ScriptStatementResult{
+		{Statement: setStatement, Status: types.COMPLETED},
+		{Statement: createStatement, StatementName: "stmt-1", Status: types.COMPLETED},
+	}, results)
+}
+
+func TestScriptRunnerStopsOnError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dataStore := mock.NewMockStoreInterface(ctrl)
+	runner := &scriptRunner{store: dataStore}
+
+	statementErr := &types.StatementError{Message: "invalid syntax"}
+	dataStore.EXPECT().ProcessLocalStatement("SELEC 1;").Return(nil, nil)
+	dataStore.EXPECT().ProcessStatement("SELEC 1;").Return(nil, statementErr)
+
+	results := runner.run([]string{"SELEC 1;", "SELECT 2;"}, false)
+	require.Equal(t, []ScriptStatementResult{{Statement: "SELEC 1;", Status: types.FAILED, Error: statementErr}}, results)
+}
+
+func TestScriptRunnerContinueOnError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dataStore := mock.NewMockStoreInterface(ctrl)
+	runner := &scriptRunner{store: dataStore}
+
+	statementErr := &types.StatementError{Message: "invalid syntax"}
+	completedStatement := types.ProcessedStatement{Statement: "SELECT 2;", StatementName: "stmt-2", Status: types.COMPLETED}
+	dataStore.EXPECT().ProcessLocalStatement("SELEC 1;").Return(nil, nil)
+	dataStore.EXPECT().ProcessStatement("SELEC 1;").Return(nil, statementErr)
+	dataStore.EXPECT().ProcessLocalStatement("SELECT 2;").Return(nil, nil)
+	dataStore.EXPECT().ProcessStatement("SELECT 2;").Return(&completedStatement, nil)
+	dataStore.EXPECT().WaitPendingStatement(gomock.Any(), completedStatement).Return(&completedStatement, nil)
+	dataStore.EXPECT().FetchStatementResults(completedStatement).Return(&completedStatement, nil)
+	dataStore.EXPECT().DeleteStatement("stmt-2").Return(true)
+
+	results := runner.run([]string{"SELEC 1;", "SELECT 2;"}, true)
+	require.Equal(t, []ScriptStatementResult{
+		{Statement: "SELEC 1;", Status: types.FAILED, Error: statementErr},
+		{Statement: "SELECT 2;", StatementName: "stmt-2", Status: types.COMPLETED},
+	}, results)
+}
+
+func TestScriptRunnerFetchesAndDeletesSelectStatement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dataStore := mock.NewMockStoreInterface(ctrl)
+	runner := &scriptRunner{store: dataStore, userProperties: store.NewUserProperties(&types.ApplicationOptions{})}
+
+	selectStatement := "SELECT * FROM t;"
+	pendingStatement := types.ProcessedStatement{Statement: selectStatement, StatementName: "stmt-1", Status: types.PENDING}
+	runningStatement := types.ProcessedStatement{Statement: selectStatement, StatementName: "stmt-1", Status: types.RUNNING}
+	firstPage := types.ProcessedStatement{
+		Statement:     selectStatement,
+		StatementName: "stmt-1",
+		Status:        types.RUNNING,
+		PageToken:     "page-2",
+		StatementResults: &types.StatementResults{
+			Headers: []string{"id"},
+			Rows:    []types.StatementResultRow{{Operation: types.Insert, Fields: []types.StatementResultField{types.AtomicStatementResultField{Type: types.Integer, Value: "1"}}}},
+		},
+	}
+	lastPage := types.ProcessedStatement{
+		Statement:     selectStatement,
+		StatementName: "stmt-1",
+		Status:        types.COMPLETED,
+		StatementResults: &types.StatementResults{
+			Headers: []string{"id"},
+			Rows:    []types.StatementResultRow{{Operation: types.Insert, Fields: []types.StatementResultField{types.AtomicStatementResultField{Type: types.Integer, Value: "2"}}}},
+		},
+	}
+
+	dataStore.EXPECT().ProcessLocalStatement(selectStatement).Return(nil, nil)
+	dataStore.EXPECT().ProcessStatement(selectStatement).Return(&pendingStatement, nil)
+	dataStore.EXPECT().WaitPendingStatement(gomock.Any(), pendingStatement).Return(&runningStatement, nil)
+	dataStore.EXPECT().FetchStatementResults(runningStatement).Return(&firstPage, nil)
+	dataStore.EXPECT().FetchStatementResults(firstPage).Return(&lastPage, nil)
+	dataStore.EXPECT().DeleteStatement("stmt-1").Return(true)
+
+	results := runner.run([]string{selectStatement}, false)
+	require.Equal(t, []ScriptStatementResult{{Statement: selectStatement, StatementName: "stmt-1", Status: types.COMPLETED}}, results)
+}
+
+func TestScriptRunnerDeletesFailedSelectStatement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dataStore := mock.NewMockStoreInterface(ctrl)
+	runner := &scriptRunner{store: dataStore}
+
+	selectStatement := "SELECT * FROM missing;"
+	pendingStatement := types.ProcessedStatement{Statement: selectStatement, StatementName: "stmt-1", Status: types.PENDING}
+	statementErr := &types.StatementError{Message: "Table 'missing' not found."}
+
+	dataStore.EXPECT().ProcessLocalStatement(selectStatement).Return(nil, nil)
+	dataStore.EXPECT().ProcessStatement(selectStatement).Return(&pendingStatement, nil)
+	dataStore.EXPECT().WaitPendingStatement(gomock.Any(), pendingStatement).Return(nil, statementErr)
+	dataStore.EXPECT().DeleteStatement("stmt-1").Return(true)
+
+	results := runner.run([]string{selectStatement}, false)
+	require.Equal(t, []ScriptStatementResult{{Statement: selectStatement, Status: types.FAILED, Error: statementErr}}, results)
+}
+
+func TestScriptRunnerStopsOnExit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dataStore := mock.NewMockStoreInterface(ctrl)
+	runner := &scriptRunner{store: dataStore}
+
+	dataStore.EXPECT().ProcessLocalStatement("EXIT;").DoAndReturn(func(_ string) (*types.ProcessedStatement, *types.StatementError) {
+		runner.exit()
+		return nil, nil
+	})
+
+	require.Empty(t, runner.run([]string{"EXIT;", "SELECT 1;"}, false))
+}
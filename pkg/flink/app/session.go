@@ -0,0 +1,147 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/confluentinc/cli/v4/pkg/flink/config"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/history"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/store"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/utils"
+	"github.com/confluentinc/cli/v4/pkg/flink/session"
+)
+
+// LoadSession returns the named Flink shell session of the organization and environment, or a new session if it has not
+// been saved yet. It returns nil if no session name is given.
+func LoadSession(name, organizationId, environmentId string, onPrem bool) (*session.Session, error) {
+	if name == "" {
+		return nil, nil
+	}
+	return session.Load(name, organizationId, environmentId, onPrem)
+}
+
+// processSessionsStatement handles the local \sessions statement, which lists the named sessions or switches to one of them
+func (a *Application) processSessionsStatement(statement string) {
+	name, err := store.ParseSessionsStatement(statement)
+	if err != nil {
+		utils.OutputErr(err.Error())
+		return
+	}
+
+	if name == "" {
+		a.listSessions()
+		return
+	}
+	a.useSession(name)
+}
+
+func (a *Application) listSessions() {
+	sessions, err := session.List(a.appOptions.GetOrganizationId(), a.appOptions.GetEnvironmentId(), !a.appOptions.Cloud)
+	if err != nil {
+		utils.OutputErrf("Error: failed to list sessions: %v", err)
+		return
+	}
+
+	current := a.appOptions.GetSession()
+	if current != nil && !slices.ContainsFunc(sessions, func(s *session.Session) bool { return s.Name == current.Name }) {
+		sessions = append(sessions, current)
+	}
+	if len(sessions) == 0 {
+		utils.OutputInfo("No saved sessions. Start the shell with `--session` or use `\\sessions use <name>` to create one.")
+		return
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].Name < sessions[j].Name
+	})
+
+	for _, s := range sessions {
+		properties := s.Properties
+		marker := " "
+		if current != nil && s.Name == current.Name {
+			// The current session may hold sensitive properties which are not saved
+			properties = a.userProperties.GetMaskedNonLocalProperties()
+			marker = "*"
+		}
+
+		details := []string{fmt.Sprintf("last used %s", s.LastUsedAt.Local().Format(time.DateTime))}
+		if s.ComputePoolId != "" {
+			details = append([]string{fmt.Sprintf("compute pool %s", s.ComputePoolId)}, details...)
+		}
+		utils.OutputInfof("%s %s (%s)\n", marker, s.Name, strings.Join(details, ", "))
+
+		for _, key := range getSortedStatementProperties(properties) {
+			utils.OutputInfof("    %s = %s\n", key, properties[key])
+		}
+	}
+}
+
+func (a *Application) useSession(name string) {
+	current := a.appOptions.GetSession()
+	if current != nil && current.Name == name {
+		utils.OutputInfof("Already using session \"%s\".\n", name)
+		return
+	}
+
+	next, err := session.Load(name, a.appOptions.GetOrganizationId(), a.appOptions.GetEnvironmentId(), !a.appOptions.Cloud)
+	if err != nil {
+		utils.OutputErrf("Error: %v", err)
+		return
+	}
+
+	// The gateway client of the shell is bound to the cloud provider and region it was started in
+	if next.ComputePoolId != "" && next.ComputePoolId != a.appOptions.GetComputePoolId() && a.appOptions.ValidateComputePool != nil {
+		if err := a.appOptions.ValidateComputePool(next.ComputePoolId); err != nil {
+			utils.OutputErrf("Error: %v", err)
+			return
+		}
+	}
+
+	// A new session starts from the properties and compute pool of the current one
+	// Variables are not saved to sessions, so they are kept when switching
+	if len(next.Properties) > 0 {
+		variables := map[string]string{}
+		for key, value := range a.userProperties.GetProperties() {
+			if strings.HasPrefix(key, config.KeyVariables) {
+				variables[key] = value
+			}
+		}
+		a.userProperties.Clear()
+		for key, value := range next.Properties {
+			a.userProperties.Set(key, value)
+		}
+		for key, value := range variables {
+			a.userProperties.Set(key, value)
+		}
+	}
+	// The compute pool of the session only applies to this shell, not to the current context
+	if next.ComputePoolId == "" {
+		next.ComputePoolId = a.appOptions.GetComputePoolId()
+	} else {
+		a.appOptions.ComputePoolId = next.ComputePoolId
+	}
+
+	a.history.Save()
+	*a.history = *history.LoadHistoryFromPath(next.GetHistoryPath())
+	a.inputController.ReloadHistory()
+
+	a.appOptions.Session = next
+	store.PersistSession(a.userProperties, a.appOptions)
+
+	utils.OutputInfof("Switched to session \"%s\" (catalog %s, database %s).\n", name, a.userProperties.Get(config.KeyCatalog), a.userProperties.Get(config.KeyDatabase))
+}
+
+// getSortedStatementProperties returns the keys of the properties which are sent along with statements
+func getSortedStatementProperties(properties map[string]string) []string {
+	var keys []string
+	for key := range properties {
+		if !strings.HasPrefix(key, config.NamespaceClient) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
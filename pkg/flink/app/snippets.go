@@ -0,0 +1,148 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/confluentinc/cli/v4/pkg/flink/config"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/snippets"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/store"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/utils"
+	"github.com/confluentinc/cli/v4/pkg/flink/types"
+	"github.com/confluentinc/cli/v4/pkg/log"
+)
+
+// snippetLibrary caches the saved snippets for the completer, and reloads them once they are saved or the shared
+// snippets directory is changed
+type snippetLibrary struct {
+	userProperties types.UserPropertiesInterface
+	sharedPath     string
+	snippets       []snippets.Snippet
+	loaded         bool
+}
+
+func newSnippetLibrary(userProperties types.UserPropertiesInterface) *snippetLibrary {
+	return &snippetLibrary{userProperties: userProperties}
+}
+
+func (l *snippetLibrary) get() []snippets.Snippet {
+	if sharedPath := l.userProperties.Get(config.KeySnippetsPath); !l.loaded || sharedPath != l.sharedPath {
+		if err := l.reload(); err != nil {
+			log.CliLogger.Warnf("Couldn't load snippets: %v", err)
+		}
+	}
+	return l.snippets
+}
+
+func (l *snippetLibrary) reload() error {
+	l.sharedPath = l.userProperties.Get(config.KeySnippetsPath)
+	l.loaded = true
+
+	loaded, err := snippets.Load(l.sharedPath)
+	if err != nil {
+		l.snippets = nil
+		return err
+	}
+	l.snippets = loaded
+	return nil
+}
+
+// processSnippetStatement handles the local \save, \snippets, and \run statements, which save the last statement as a
+// snippet, list the saved snippets, or run one of them
+func (a *Application) processSnippetStatement(statement string) {
+	switch {
+	case strings.HasPrefix(strings.ToUpper(strings.TrimSpace(statement)), config.OpSave):
+		a.saveSnippet(statement)
+	case strings.HasPrefix(strings.ToUpper(strings.TrimSpace(statement)), config.OpSnippets):
+		a.listSnippets(statement)
+	default:
+		a.runSnippet(statement)
+	}
+}
+
+func (a *Application) saveSnippet(statement string) {
+	name, err := store.ParseSaveStatement(statement)
+	if err != nil {
+		utils.OutputErr(err.Error())
+		return
+	}
+
+	// The last statement is the most recent one in the history which is not a local command such as \save itself
+	lastStatement := ""
+	for i := len(a.history.Data) - 1; i >= 0; i-- {
+		if !strings.HasPrefix(strings.TrimSpace(a.history.Data[i]), `\`) {
+			lastStatement = a.history.Data[i]
+			break
+		}
+	}
+	if lastStatement == "" {
+		utils.OutputErr("Error: no statement to save")
+		return
+	}
+
+	if err := snippets.Save(name, lastStatement); err != nil {
+		utils.OutputErrf("Error: failed to save snippet: %v", err)
+		return
+	}
+	if err := a.snippets.reload(); err != nil {
+		log.CliLogger.Warnf("Couldn't load snippets: %v", err)
+	}
+
+	utils.OutputInfof("Saved snippet \"%s\". Run it with `\\run %s`.\n", name, name)
+}
+
+func (a *Application) listSnippets(statement string) {
+	if err := store.ParseSnippetsStatement(statement); err != nil {
+		utils.OutputErr(err.Error())
+		return
+	}
+
+	if err := a.snippets.reload(); err != nil {
+		utils.OutputErrf("Error: failed to load snippets: %v", err)
+		return
+	}
+	if len(a.snippets.snippets) == 0 {
+		utils.OutputInfo("No saved snippets. Save the last statement with `\\save <name>`.")
+		return
+	}
+
+	for _, snippet := range a.snippets.snippets {
+		header := snippet.Name
+		if parameters := snippet.Parameters(); len(parameters) > 0 {
+			header += fmt.Sprintf(" (%s)", strings.Join(parameters, ", "))
+		}
+		if snippet.Path != "" {
+			header += fmt.Sprintf(" [%s]", snippet.Path)
+		}
+		utils.OutputInfof("%s\n", header)
+		for _, line := range strings.Split(snippet.Statement, "\n") {
+			utils.OutputInfof("    %s\n", line)
+		}
+	}
+}
+
+func (a *Application) runSnippet(statement string) {
+	name, args, err := store.ParseRunStatement(statement)
+	if err != nil {
+		utils.OutputErr(err.Error())
+		return
+	}
+
+	savedSnippets := a.snippets.get()
+	index := slices.IndexFunc(savedSnippets, func(snippet snippets.Snippet) bool { return snippet.Name == name })
+	if index == -1 {
+		utils.OutputErrf("Error: snippet \"%s\" not found. List the saved snippets with `\\snippets`.", name)
+		return
+	}
+
+	// Placeholders without an argument are substituted like any other variable when the statement is processed
+	expanded, expandErr := savedSnippets[index].Expand(args)
+	if expandErr != nil {
+		utils.OutputErrf("Error: %v", expandErr)
+		return
+	}
+
+	utils.OutputInfof("%s\n", expanded)
+	a.executeStatement(expanded)
+}
@@ -0,0 +1,21 @@
+package app
+
+import (
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/formatting"
+	"github.com/confluentinc/cli/v4/pkg/flink/types"
+)
+
+// FormatSQL formats a SQL script canonically. It does not connect to Confluent Cloud.
+func FormatSQL(script string) string {
+	return formatting.Format(script)
+}
+
+// LintSQL checks a SQL script for common mistakes. It does not connect to Confluent Cloud.
+func LintSQL(script string) []types.LintIssue {
+	return formatting.Lint(script)
+}
+
+// GetSQLTables returns the tables a SQL script reads from and writes to. It does not connect to Confluent Cloud.
+func GetSQLTables(script string) (sources, sinks []string) {
+	return formatting.GetTables(script)
+}
@@ -0,0 +1,45 @@
+package app
+
+import (
+	"fmt"
+	"maps"
+
+	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/variables"
+	"github.com/confluentinc/cli/v4/pkg/properties"
+)
+
+// LoadSQLVariables returns the variables of the `--var` and `--var-file` flags. Variables of `--var` take precedence
+// over those of the variable file.
+func LoadSQLVariables(vars []string, varFile string) (map[string]string, error) {
+	loaded := map[string]string{}
+	if varFile != "" {
+		fileVars, err := variables.ReadFile(varFile)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(loaded, fileVars)
+	}
+
+	flagVars, err := properties.ConfigFlagToMap(vars)
+	if err != nil {
+		return nil, err
+	}
+	maps.Copy(loaded, flagVars)
+
+	return loaded, nil
+}
+
+// SubstituteSQLVariables replaces each ${name} in a SQL script with the value of the variable, or of the environment
+// variable of the same name. It fails with a list of all variables which are not set.
+func SubstituteSQLVariables(script string, vars map[string]string) (string, error) {
+	script, unresolved := variables.Substitute(script, vars)
+	if len(unresolved) > 0 {
+		return "", unresolvedVariablesError(unresolved, "Set the variables with `--var`, `--var-file`, or environment variables.")
+	}
+	return script, nil
+}
+
+func unresolvedVariablesError(unresolved []string, suggestion string) error {
+	return errors.NewErrorWithSuggestions(fmt.Sprintf("unresolved variables: %s", variables.Format(unresolved)), suggestion)
+}
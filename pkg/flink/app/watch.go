@@ -0,0 +1,24 @@
+package app
+
+import (
+	"github.com/confluentinc/cli/v4/pkg/ccloudv2"
+	"github.com/confluentinc/cli/v4/pkg/flink"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/store"
+	"github.com/confluentinc/cli/v4/pkg/flink/types"
+)
+
+// NewStatementHealthPoller returns a function which polls the phase, status detail, and exceptions of an existing
+// statement, the same way the interactive shell polls statements.
+func NewStatementHealthPoller(gatewayClient ccloudv2.GatewayClientInterface, tokenRefreshFunc func() error, appOptions types.ApplicationOptions) func(string) (*types.StatementHealth, error) {
+	userProperties := store.NewUserProperties(&appOptions)
+	dataStore := store.NewStore(gatewayClient, func() {}, userProperties, &appOptions, tokenRefreshFunc)
+	return dataStore.GetStatementHealth
+}
+
+// NewStatementHealthPollerOnPrem returns a function which polls the phase, status detail, and exceptions of an existing
+// statement in Confluent Platform.
+func NewStatementHealthPollerOnPrem(cmfClient flink.CmfClientInterface, tokenRefreshFunc func() error, appOptions types.ApplicationOptions) func(string) (*types.StatementHealth, error) {
+	userProperties := store.NewUserProperties(&appOptions)
+	dataStore := store.NewStoreOnPrem(cmfClient, func() {}, userProperties, &appOptions, tokenRefreshFunc)
+	return dataStore.GetStatementHealth
+}
@@ -39,6 +39,7 @@ const (
 	ToggleTableModeShortcut = "M"
 	JumpUpShortcut          = "U"
 	JumpDownShortcut        = "D"
+	ExportResultsShortcut   = "E"
 )
 
 func NewTableView() TableViewInterface {
@@ -252,6 +253,7 @@ func (t *TableView) getTableShortcutsForCompletedRefreshState(toggleTableModeTex
 		{KeyText: ExitTableViewShortcut, Text: "Quit"},
 		{KeyText: ToggleTableModeShortcut, Text: toggleTableModeText},
 		{KeyText: fmt.Sprintf("%s/%s", JumpUpShortcut, JumpDownShortcut), Text: "Jump up/down"},
+		{KeyText: ExportResultsShortcut, Text: "Export"},
 	}
 }
 
@@ -261,5 +263,6 @@ func (t *TableView) getTableShortcutsForNonCompletedRefreshState(toggleTableMode
 		{KeyText: ToggleTableModeShortcut, Text: toggleTableModeText},
 		{KeyText: ToggleRefreshShortcut, Text: toggleRefreshText},
 		{KeyText: fmt.Sprintf("%s/%s", JumpUpShortcut, JumpDownShortcut), Text: "Jump up/down"},
+		{KeyText: ExportResultsShortcut, Text: "Export"},
 	}
 }
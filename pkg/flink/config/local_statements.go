@@ -7,6 +7,11 @@ const (
 	OpReset             = "RESET"
 	OpExit              = "EXIT"
 	OpQuit              = "QUIT"
+	OpExport            = "EXPORT"
+	OpSessions          = `\SESSIONS`
+	OpSave              = `\SAVE`
+	OpSnippets          = `\SNIPPETS`
+	OpRun               = `\RUN`
 	OpUseCatalog        = "CATALOG"
 	StatementTerminator = ";"
 
@@ -14,15 +19,19 @@ const (
 	NamespaceClient = "client."
 
 	// keys
-	KeyCatalog        = "sql.current-catalog"
-	KeyDatabase       = "sql.current-database"
-	KeyLocalTimeZone  = "sql.local-time-zone"
-	KeySqlSecrets     = "sql.secrets."
-	KeyResultsTimeout = "client.results-timeout"
-	KeyServiceAccount = "client.service-account"
-	KeyStatementName  = "client.statement-name"
-	KeyOutputFormat   = "client.output-format"
-	KeyDryRun         = "sql.dry-run"
+	KeyCatalog           = "sql.current-catalog"
+	KeyDatabase          = "sql.current-database"
+	KeyLocalTimeZone     = "sql.local-time-zone"
+	KeyInitialOffsetFrom = "sql.tables.initial-offset-from"
+	KeySqlSecrets        = "sql.secrets."
+	KeyVariables         = "client.var."
+	KeyResultsTimeout    = "client.results-timeout"
+	KeyServiceAccount    = "client.service-account"
+	KeyStatementName     = "client.statement-name"
+	KeyOutputFormat      = "client.output-format"
+	KeyOutputFile        = "client.output-file"
+	KeySnippetsPath      = "client.snippets-path"
+	KeyDryRun            = "sql.dry-run"
 )
 
 type OutputFormat string
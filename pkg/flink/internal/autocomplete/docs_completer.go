@@ -3,10 +3,14 @@ package autocomplete
 import (
 	_ "embed"
 	"encoding/json"
+	"fmt"
+	"slices"
 	"sort"
+	"strings"
 
 	"github.com/confluentinc/go-prompt"
 
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/snippets"
 	"github.com/confluentinc/cli/v4/pkg/log"
 )
 
@@ -43,3 +47,38 @@ func docsCompleter(snippetSuggestions []prompt.Suggest) prompt.Completer {
 		return SuggestNextWord(snippetSuggestions, in.TextBeforeCursor())
 	}
 }
+
+// GenerateSnippetsCompleter suggests the built-in code snippets merged with the snippets saved by the user, and the
+// saved snippets to run when the input starts with "\run"
+func GenerateSnippetsCompleter(getSnippets func() []snippets.Snippet) prompt.Completer {
+	builtInSuggestions := loadSnippetSuggestions()
+	return func(in prompt.Document) []prompt.Suggest {
+		userSnippets := getSnippets()
+		text := in.TextBeforeCursor()
+
+		if strings.HasPrefix(strings.TrimSpace(text), `\`) {
+			runSuggestions := make([]prompt.Suggest, len(userSnippets))
+			for i, snippet := range userSnippets {
+				runSuggestions[i] = prompt.Suggest{Text: fmt.Sprintf(`\run %s`, snippet.Name), Description: getSnippetDescription(snippet)}
+			}
+			return SuggestFromPrefix(runSuggestions, text)
+		}
+
+		snippetSuggestions := slices.Clone(builtInSuggestions)
+		for _, snippet := range userSnippets {
+			snippetSuggestions = append(snippetSuggestions, prompt.Suggest{Text: snippet.Statement})
+		}
+		return SuggestNextWord(snippetSuggestions, text)
+	}
+}
+
+func getSnippetDescription(snippet snippets.Snippet) string {
+	description := "Saved snippet"
+	if snippet.Path != "" {
+		description = "Shared snippet"
+	}
+	if parameters := snippet.Parameters(); len(parameters) > 0 {
+		description += fmt.Sprintf(" with parameters %s", strings.Join(parameters, ", "))
+	}
+	return description
+}
@@ -4,9 +4,12 @@ import (
 	"testing"
 
 	"github.com/bradleyjkemp/cupaloy/v2"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/confluentinc/go-prompt"
+
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/snippets"
 )
 
 type DocsCompleterTestSuite struct {
@@ -61,3 +64,28 @@ func (s *DocsCompleterTestSuite) TestSetDocsAutoCompletionSnapshot() {
 
 	cupaloy.SnapshotT(s.T(), actual)
 }
+
+func TestSnippetsCompleter(t *testing.T) {
+	completer := GenerateSnippetsCompleter(func() []snippets.Snippet {
+		return []snippets.Snippet{
+			{Name: "daily_revenue", Statement: "SELECT SUM(amount) FROM payments;", Path: "snippets/daily_revenue.sql"},
+			{Name: "orders_by_region", Statement: "SELECT * FROM orders_by_region WHERE region = '${region}';"},
+		}
+	})
+
+	buffer := prompt.NewBuffer()
+	buffer.InsertText(`\run`, false, true)
+	require.Equal(t, []prompt.Suggest{
+		{Text: `\run daily_revenue`, Description: "Shared snippet"},
+		{Text: `\run orders_by_region`, Description: "Saved snippet with parameters region"},
+	}, completer(*buffer.Document()))
+
+	buffer = prompt.NewBuffer()
+	buffer.InsertText(`\run ord`, false, true)
+	require.Equal(t, []prompt.Suggest{{Text: "orders_by_region", Description: "Saved snippet with parameters region"}}, completer(*buffer.Document()))
+
+	// Saved snippets are suggested along with the built-in ones
+	buffer = prompt.NewBuffer()
+	buffer.InsertText("SELECT * FROM orders_by", false, true)
+	require.Contains(t, completer(*buffer.Document()), prompt.Suggest{Text: "orders_by_region WHERE"})
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/confluentinc/cli/v4/pkg/flink/internal/highlighting"
 	"github.com/confluentinc/cli/v4/pkg/flink/internal/history"
 	"github.com/confluentinc/cli/v4/pkg/flink/internal/reverseisearch"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/snippets"
 	"github.com/confluentinc/cli/v4/pkg/flink/types"
 	"github.com/confluentinc/cli/v4/pkg/log"
 )
@@ -32,11 +33,12 @@ type InputController struct {
 	shouldExit            bool
 	reverseISearch        reverseisearch.ReverseISearch
 	lspCompleter          prompt.Completer
+	getSnippets           func() []snippets.Snippet
 }
 
 const defaultWindowSize = 100
 
-func NewInputController(history *history.History, lspCompleter prompt.Completer, handlerCh chan *jsonrpc2.Request, isCloud bool) types.InputControllerInterface {
+func NewInputController(history *history.History, lspCompleter prompt.Completer, handlerCh chan *jsonrpc2.Request, getSnippets func() []snippets.Snippet, isCloud bool) types.InputControllerInterface {
 	inputController := &InputController{
 		History:            history,
 		InitialBuffer:      "",
@@ -45,6 +47,7 @@ func NewInputController(history *history.History, lspCompleter prompt.Completer,
 		shouldExit:         false,
 		reverseISearch:     reverseisearch.NewReverseISearch(),
 		lspCompleter:       lspCompleter,
+		getSnippets:        getSnippets,
 	}
 	if prompt, err := inputController.initPrompt(isCloud); err == nil {
 		inputController.prompt = prompt
@@ -68,6 +71,16 @@ func (c *InputController) SetDiagnostics(diagnostics []lsp.Diagnostic) {
 	c.prompt.SetDiagnostics(diagnostics)
 }
 
+// ReloadHistory makes the prompt browse the statements currently in the history, after it was switched to another session
+func (c *InputController) ReloadHistory() {
+	if c.prompt == nil {
+		return
+	}
+	if err := prompt.OptionHistory(c.History.Data)(c.prompt); err != nil {
+		log.CliLogger.Warnf("Couldn't reload statements history: %v", err)
+	}
+}
+
 func (c *InputController) clearBuffer() {
 	// DeleteBeforeCursor() clears everything left of the cursor
 	c.prompt.Buffer().DeleteBeforeCursor(len(c.prompt.Buffer().Text()))
@@ -153,7 +166,7 @@ func (c *InputController) initPrompt(isCloud bool) (prompt.IPrompt, error) {
 			}
 
 			text = strings.ToUpper(text)
-			return text == config.OpExit || text == config.OpQuit || strings.HasSuffix(text, ";") || lastKeyStroke == prompt.AltEnter
+			return text == config.OpExit || text == config.OpQuit || isBackslashCommand(text) || strings.HasSuffix(text, ";") || lastKeyStroke == prompt.AltEnter
 		}),
 	}
 	options = append(options, c.getKeyBindings()...)
@@ -164,6 +177,17 @@ func (c *InputController) initPrompt(isCloud bool) (prompt.IPrompt, error) {
 	)
 }
 
+// isBackslashCommand returns whether the input is a local command such as \sessions, which is executed without a
+// statement terminator
+func isBackslashCommand(text string) bool {
+	for _, op := range []string{config.OpSessions, config.OpSave, config.OpSnippets, config.OpRun} {
+		if strings.HasPrefix(text, op) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *InputController) promptCompleter(isCloud bool) prompt.Completer {
 	completer := autocomplete.NewCompleterBuilder(c.CompletionsEnabled)
 
@@ -182,6 +206,9 @@ func (c *InputController) promptCompleter(isCloud bool) prompt.Completer {
 		completer.AddCompleter(c.lspCompleter)
 	}
 
+	if c.getSnippets != nil {
+		completer.AddCompleter(autocomplete.GenerateSnippetsCompleter(c.getSnippets))
+	}
 	completer.AddCompleter(autocomplete.GenerateHistoryCompleter(c.History.Data))
 
 	return completer.BuildCompleter()
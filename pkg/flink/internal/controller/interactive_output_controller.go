@@ -10,6 +10,7 @@ import (
 
 	"github.com/confluentinc/cli/v4/pkg/flink/components"
 	"github.com/confluentinc/cli/v4/pkg/flink/config"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/results"
 	"github.com/confluentinc/cli/v4/pkg/flink/internal/utils"
 	"github.com/confluentinc/cli/v4/pkg/flink/types"
 	"github.com/confluentinc/cli/v4/pkg/log"
@@ -25,6 +26,8 @@ type InteractiveOutputController struct {
 	isRowViewOpen  bool
 	userProperties types.UserPropertiesInterface
 	debug          bool
+	// printExportResult prints the outcome of exporting the results once the table view is closed
+	printExportResult func()
 }
 
 func NewInteractiveOutputController(tableView components.TableViewInterface, resultFetcher types.ResultFetcherInterface, userProperties types.UserPropertiesInterface, debug bool) types.OutputControllerInterface {
@@ -54,10 +57,14 @@ func (t *InteractiveOutputController) startTView() {
 func (t *InteractiveOutputController) close() {
 	t.resultFetcher.Close()
 	output.Println(false, "Result retrieval aborted.")
+	if t.printExportResult != nil {
+		t.printExportResult()
+	}
 }
 
 func (t *InteractiveOutputController) init() {
 	t.isRowViewOpen = false
+	t.printExportResult = nil
 	t.resultFetcher.SetRefreshCallback(t.renderTableAsync)
 	t.resultFetcher.ToggleRefresh()
 	t.app.SetInputCapture(t.inputCapture)
@@ -144,10 +151,30 @@ func (t *InteractiveOutputController) getActionForShortcut(shortcut string) func
 		return t.stopRefreshOrScroll(t.tableView.JumpUp)
 	case components.JumpDownShortcut:
 		return t.stopRefreshOrScroll(t.tableView.JumpDown)
+	case components.ExportResultsShortcut:
+		return t.exportResults
 	}
 	return nil
 }
 
+func (t *InteractiveOutputController) exportResults() {
+	path := t.userProperties.Get(config.KeyOutputFile)
+	if path == "" {
+		t.printExportResult = func() {
+			utils.OutputErrf(`Error: failed to export results: no output file specified, set "%s" or run "EXPORT TO '<file>'"`, config.KeyOutputFile)
+		}
+		return
+	}
+
+	// The output file is where the results of every statement of the session are exported to, so it is replaced
+	rowCount, err := results.ExportResults(path, t.resultFetcher.GetMaterializedStatementResults(), true)
+	if err != nil {
+		t.printExportResult = func() { utils.OutputErrf("Error: failed to export results: %v", err) }
+		return
+	}
+	t.printExportResult = func() { utils.OutputInfof(results.ExportedResultsMsg, rowCount, path) }
+}
+
 func (t *InteractiveOutputController) toggleTableMode() {
 	t.resultFetcher.ToggleTableMode()
 	t.updateTable()
@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -127,6 +128,29 @@ func (s *InteractiveOutputControllerTestSuite) TestToggleRefreshResultsDoesNothi
 	require.Nil(s.T(), result)
 }
 
+func (s *InteractiveOutputControllerTestSuite) TestExportResultsOnUserInput() {
+	path := filepath.Join(s.T().TempDir(), "results.jsonl")
+	userProperties := store.NewUserPropertiesWithDefaults(map[string]string{config.KeyOutputFile: path}, map[string]string{})
+	interactiveOutputController := NewInteractiveOutputController(s.tableView, s.resultFetcher, userProperties, false).(*InteractiveOutputController)
+	s.resultFetcher.EXPECT().GetMaterializedStatementResults().Return(getResultsExample())
+
+	result := interactiveOutputController.inputCapture(tcell.NewEventKey(tcell.KeyRune, rune(components.ExportResultsShortcut[0]), tcell.ModNone))
+
+	require.Nil(s.T(), result)
+	require.FileExists(s.T(), path)
+	require.NotNil(s.T(), interactiveOutputController.printExportResult)
+}
+
+func (s *InteractiveOutputControllerTestSuite) TestExportResultsOnUserInputWithoutOutputFile() {
+	userProperties := store.NewUserPropertiesWithDefaults(map[string]string{}, map[string]string{})
+	interactiveOutputController := NewInteractiveOutputController(s.tableView, s.resultFetcher, userProperties, false).(*InteractiveOutputController)
+
+	result := interactiveOutputController.inputCapture(tcell.NewEventKey(tcell.KeyRune, rune(components.ExportResultsShortcut[0]), tcell.ModNone))
+
+	require.Nil(s.T(), result)
+	require.NotNil(s.T(), interactiveOutputController.printExportResult)
+}
+
 func (s *InteractiveOutputControllerTestSuite) TestNonSupportedUserInput() {
 	// Test a case when the event is neither 'Q', 'N', Ctrl-C, nor Escape
 	// When we return the event, it's forwarded to tview
@@ -0,0 +1,325 @@
+package formatting
+
+import (
+	"strings"
+
+	"github.com/confluentinc/cli/v4/pkg/flink/config"
+	"github.com/confluentinc/cli/v4/pkg/types"
+)
+
+const indentation = "  "
+
+// Flink SQL identifiers are case-sensitive, so keywords which may also be used as unquoted identifiers keep their case.
+var nonReservedKeywords = types.NewSet(
+	"ADMIN", "AFTER", "BEFORE", "BUCKETS", "BYTES", "CATALOGS", "CENTURY", "CHAIN", "CHANGELOG_MODE", "CHARACTERS",
+	"COLUMNS", "COMMENT", "COMPACT", "CONSTRAINTS", "DATA", "DATABASES", "DAYS", "DECADE", "DEFINED", "DISTRIBUTED",
+	"DISTRIBUTION", "E", "ENCODING", "ENFORCED", "ENGINE", "EPOCH", "ERROR", "ESTIMATED_COST", "EXCLUDING", "EXTENDED",
+	"FILE", "FIRST", "FOLLOWING", "FORMAT", "FOUND", "FRAC_SECOND", "GENERAL", "GENERATED", "HOURS", "INCLUDING",
+	"INCREMENT", "INPUT", "JAR", "JARS", "JAVA", "JOB", "JOBS", "JSON", "JSON_EXECUTION_PLAN", "KEY", "KEY_MEMBER",
+	"KEY_TYPE", "LABEL", "LANGUAGE", "LAST", "LENGTH", "LEVEL", "LOAD", "METADATA", "MICROSECOND", "MILLENNIUM",
+	"MILLISECOND", "MINUTES", "MINVALUE", "MODEL", "MODELS", "MODULE", "MODULES", "MONTHS", "NANOSECOND", "NEXT", "NONE",
+	"NULLS", "NUMBER", "ONE", "OPTION", "OPTIONS", "ORDERING", "OUTPUT", "OVERWRITING", "PARTITIONED", "PARTITIONS",
+	"PASSING", "PAST", "PATH", "PLACING", "PLAN", "PRECEDING", "PRESERVE", "PRIOR", "PRIVILEGES", "PUBLIC", "PYTHON",
+	"QUARTER", "RAW", "READ", "RELATIVE", "REMOVE", "RESPECT", "RESTART", "RESTRICT", "ROLE", "SCALA", "SCALAR", "SCALE",
+	"SCHEMA", "SECONDS", "SECTION", "SECURITY", "SELF", "SERVER", "SERVER_NAME", "SESSION", "SIMPLE", "SIZE", "SOURCE",
+	"SPACE", "STATE", "STATEMENT", "STEP", "STRING", "STRUCTURE", "STYLE", "TABLES", "TIMECOL", "TRANSFORM", "TYPE",
+	"UNDER", "USAGE", "UTF16", "UTF32", "UTF8", "VERSION", "VIEWS", "VIRTUAL", "WATERMARKS", "WEEK", "WEEKS", "WORK",
+	"WRAPPER", "YEARS", "ZONE",
+)
+
+// Non-reserved keywords are only uppercased as part of these phrases.
+var keywordPhrases = [][2]string{
+	{"PRIMARY", "KEY"},
+	{"NOT", "ENFORCED"},
+	{"PARTITIONED", "BY"},
+	{"DISTRIBUTED", "BY"},
+	{"NULLS", "FIRST"},
+	{"NULLS", "LAST"},
+	{"METADATA", "FROM"},
+	{"METADATA", "VIRTUAL"},
+	{"UNBOUNDED", "PRECEDING"},
+	{"UNBOUNDED", "FOLLOWING"},
+}
+
+// Clauses start on a new line, unless they are nested in an expression, such as PARTITION BY in an OVER clause.
+var clauseKeywords = types.NewSet(
+	"SELECT", "FROM", "WHERE", "HAVING", "LIMIT", "WINDOW", "UNION", "INTERSECT", "EXCEPT", "VALUES", "INSERT",
+)
+
+var joinModifiers = types.NewSet("LEFT", "RIGHT", "FULL", "INNER", "CROSS", "NATURAL")
+
+// Expressions cannot end with these keywords, so a following + or - is a sign rather than an operator.
+var unaryPrefixKeywords = types.NewSet(
+	"SELECT", "WHERE", "AND", "OR", "NOT", "WHEN", "THEN", "ELSE", "ON", "BY", "HAVING", "INTERVAL", "AS", "IN",
+	"BETWEEN", "LIMIT", "OFFSET", "RETURN",
+)
+
+type paren struct {
+	block       bool
+	breakCommas bool
+}
+
+type formatter struct {
+	builder   strings.Builder
+	tokens    []token
+	indent    int
+	lineStart bool
+	parens    []paren
+}
+
+// Format formats a SQL script canonically: keywords are uppercased, each clause starts on a new line, subqueries and
+// the column and option lists of CREATE statements are indented, and statements are separated by a blank line.
+func Format(sql string) string {
+	var statements []string
+	for _, statement := range splitStatements(tokenize(sql)) {
+		f := &formatter{tokens: statement}
+		f.format()
+		statements = append(statements, f.builder.String())
+	}
+
+	if len(statements) == 0 {
+		return ""
+	}
+	return strings.Join(statements, "\n\n") + "\n"
+}
+
+func (f *formatter) format() {
+	inStatementSet := false
+	for i, t := range f.tokens {
+		top := f.top()
+		inBlock := top == nil || top.block
+
+		switch {
+		case t.isComment():
+			if t.newlineBefore {
+				f.newline()
+			}
+			f.write(t.text, i)
+			if t.kind == lineCommentToken {
+				f.newline()
+			}
+		case t.is(punctuationToken, "("):
+			p := f.openParen(i)
+			f.write(t.text, i)
+			f.parens = append(f.parens, p)
+			if p.block {
+				f.indent++
+				f.newline()
+			}
+		case t.is(punctuationToken, ")"):
+			if top != nil && top.block {
+				f.indent--
+				f.newline()
+			}
+			f.write(t.text, i)
+			if len(f.parens) > 0 {
+				f.parens = f.parens[:len(f.parens)-1]
+			}
+		case t.is(punctuationToken, ","):
+			f.write(t.text, i)
+			if top != nil && top.breakCommas {
+				f.newline()
+			}
+		case t.is(punctuationToken, config.StatementTerminator):
+			f.write(t.text, i)
+			if inStatementSet {
+				f.newline()
+			}
+		case t.isWord("BEGIN") && f.previous(i).isWord("SET"):
+			inStatementSet = true
+			f.write(f.text(i), i)
+			f.indent++
+			f.newline()
+		case t.isWord("END") && inStatementSet:
+			inStatementSet = false
+			f.indent--
+			f.newline()
+			f.write(f.text(i), i)
+		default:
+			if inBlock && f.isClauseStart(i) {
+				f.newline()
+			}
+			f.write(f.text(i), i)
+		}
+	}
+}
+
+func (f *formatter) top() *paren {
+	if len(f.parens) == 0 {
+		return nil
+	}
+	return &f.parens[len(f.parens)-1]
+}
+
+// openParen determines whether the parenthesis at index i encloses a block which is indented on its own lines:
+// a subquery, or the column or option list of a CREATE statement.
+func (f *formatter) openParen(i int) paren {
+	if next := f.next(i); next.isWord("SELECT") || next.isWord("WITH") {
+		return paren{block: true}
+	}
+
+	if f.previous(i).isWord("WITH") {
+		return paren{block: true, breakCommas: true}
+	}
+
+	if len(f.parens) == 0 && f.isCreateTable() {
+		// The column list directly follows the table name
+		j := i - 1
+		for j >= 0 && (f.tokens[j].kind == wordToken && !f.isKeyword(j) || f.tokens[j].kind == identifierToken || f.tokens[j].is(punctuationToken, ".")) {
+			j--
+		}
+		if j >= 0 && j < i-1 && (f.tokens[j].isWord("TABLE") || f.tokens[j].isWord("EXISTS")) {
+			return paren{block: true, breakCommas: true}
+		}
+	}
+
+	return paren{}
+}
+
+func (f *formatter) isCreateTable() bool {
+	tokens := significant(f.tokens)
+	if len(tokens) == 0 || !tokens[0].isWord("CREATE") {
+		return false
+	}
+	for _, t := range tokens[1:] {
+		if t.isWord("TABLE") {
+			return true
+		}
+		if !t.isWord("OR") && !t.isWord("REPLACE") && !t.isWord("TEMPORARY") {
+			return false
+		}
+	}
+	return false
+}
+
+func (f *formatter) isClauseStart(i int) bool {
+	t := f.tokens[i]
+	if t.kind != wordToken || !f.isKeyword(i) {
+		return false
+	}
+
+	previous := f.previous(i)
+	switch keyword := strings.ToUpper(t.text); {
+	case clauseKeywords.Contains(keyword):
+		return !previous.is(punctuationToken, "(")
+	case keyword == "GROUP" || keyword == "ORDER":
+		return f.next(i).isWord("BY")
+	case keyword == "JOIN":
+		return !joinModifiers.Contains(strings.ToUpper(previous.text)) && !previous.isWord("OUTER")
+	case joinModifiers.Contains(keyword):
+		next := f.next(i)
+		return !joinModifiers.Contains(strings.ToUpper(previous.text)) && (next.isWord("JOIN") || next.isWord("OUTER"))
+	}
+	return false
+}
+
+// isKeyword reports whether the word at index i is a keyword rather than an identifier, such as a column name.
+func (f *formatter) isKeyword(i int) bool {
+	t := f.tokens[i]
+	if t.kind != wordToken {
+		return false
+	}
+
+	keyword := strings.ToUpper(t.text)
+	if !config.SQLKeywords.Contains(keyword) {
+		return false
+	}
+
+	previous, next := f.previous(i), f.next(i)
+
+	// Parts of qualified names are identifiers
+	if previous.is(punctuationToken, ".") || next.is(punctuationToken, ".") {
+		return false
+	}
+
+	if nonReservedKeywords.Contains(keyword) {
+		for _, phrase := range keywordPhrases {
+			if keyword == phrase[0] && next.isWord(phrase[1]) || keyword == phrase[1] && previous.isWord(phrase[0]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+func (f *formatter) text(i int) string {
+	if f.isKeyword(i) {
+		return strings.ToUpper(f.tokens[i].text)
+	}
+	return f.tokens[i].text
+}
+
+// previous returns the last significant token before index i.
+func (f *formatter) previous(i int) token {
+	for j := i - 1; j >= 0; j-- {
+		if !f.tokens[j].isComment() {
+			return f.tokens[j]
+		}
+	}
+	return token{kind: -1}
+}
+
+// next returns the first significant token after index i.
+func (f *formatter) next(i int) token {
+	for j := i + 1; j < len(f.tokens); j++ {
+		if !f.tokens[j].isComment() {
+			return f.tokens[j]
+		}
+	}
+	return token{kind: -1}
+}
+
+func (f *formatter) newline() {
+	if f.builder.Len() > 0 {
+		f.lineStart = true
+	}
+}
+
+func (f *formatter) write(text string, i int) {
+	if f.lineStart {
+		f.builder.WriteString("\n" + strings.Repeat(indentation, f.indent))
+		f.lineStart = false
+	} else if f.builder.Len() > 0 && f.needsSpace(i) {
+		f.builder.WriteString(" ")
+	}
+	f.builder.WriteString(text)
+}
+
+func (f *formatter) needsSpace(i int) bool {
+	t := f.tokens[i]
+	var previous token
+	if i > 0 {
+		previous = f.tokens[i-1]
+	}
+
+	switch {
+	case t.kind == punctuationToken && strings.Contains(",;)].", t.text):
+		return false
+	case previous.kind == punctuationToken && strings.Contains("([.", previous.text):
+		return false
+	case t.is(punctuationToken, "[") && !t.spaceBefore:
+		return false
+	case t.is(punctuationToken, "(") && previous.kind == wordToken && !t.spaceBefore:
+		// Function calls, unlike blocks
+		return f.openParen(i).block
+	case previous.kind == operatorToken && (previous.text == "-" || previous.text == "+") && f.isSign(i-1):
+		return false
+	}
+	return true
+}
+
+// isSign reports whether the + or - at index i is the sign of a number rather than an operator.
+func (f *formatter) isSign(i int) bool {
+	previous := f.previous(i)
+	switch previous.kind {
+	case -1, operatorToken:
+		return true
+	case punctuationToken:
+		return previous.text != ")" && previous.text != "]"
+	case wordToken:
+		return unaryPrefixKeywords.Contains(strings.ToUpper(previous.text))
+	}
+	return false
+}
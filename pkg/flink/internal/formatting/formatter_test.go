@@ -0,0 +1,89 @@
+package formatting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		sql      string
+		expected string
+	}{
+		{
+			name:     "empty script",
+			sql:      " \n ",
+			expected: "",
+		},
+		{
+			name:     "keywords are uppercased and clauses start on a new line",
+			sql:      "select id, count(*) as cnt from orders where amount > 10 and status = 'paid' group by id order by cnt desc limit 10;",
+			expected: "SELECT id, COUNT(*) AS cnt\nFROM orders\nWHERE amount > 10 AND status = 'paid'\nGROUP BY id\nORDER BY cnt DESC\nLIMIT 10;\n",
+		},
+		{
+			name:     "identifiers keep their case",
+			sql:      "SELECT type, `Select`, t.`from`, o.value FROM `My Table` t;",
+			expected: "SELECT type, `Select`, t.`from`, o.value\nFROM `My Table` t;\n",
+		},
+		{
+			name:     "joins",
+			sql:      "SELECT * FROM orders o LEFT OUTER JOIN customers c ON o.customer_id = c.id JOIN payments p ON p.order_id = o.id;",
+			expected: "SELECT *\nFROM orders o\nLEFT OUTER JOIN customers c ON o.customer_id = c.id\nJOIN payments p ON p.order_id = o.id;\n",
+		},
+		{
+			name:     "subqueries are indented",
+			sql:      "SELECT * FROM (SELECT a FROM (SELECT a FROM t)) UNION ALL SELECT a FROM u;",
+			expected: "SELECT *\nFROM (\n  SELECT a\n  FROM (\n    SELECT a\n    FROM t\n  )\n)\nUNION ALL\nSELECT a\nFROM u;\n",
+		},
+		{
+			name:     "create table columns and options",
+			sql:      "create table orders(id bigint, amount decimal(10,2), ts timestamp(3), watermark for ts as ts-interval '5' second, primary key(id) not enforced) with ('connector'='kafka', 'value.format'='json');",
+			expected: "CREATE TABLE orders (\n  id BIGINT,\n  amount DECIMAL(10, 2),\n  ts TIMESTAMP(3),\n  WATERMARK FOR ts AS ts - INTERVAL '5' SECOND,\n  PRIMARY KEY(id) NOT ENFORCED\n) WITH (\n  'connector' = 'kafka',\n  'value.format' = 'json'\n);\n",
+		},
+		{
+			name:     "create table as select",
+			sql:      "CREATE TABLE t AS SELECT ABS(x) FROM u;",
+			expected: "CREATE TABLE t AS\nSELECT ABS(x)\nFROM u;\n",
+		},
+		{
+			name:     "signs",
+			sql:      "SELECT -1, a-1, a * -b, (-c) FROM t;",
+			expected: "SELECT -1, a - 1, a * -b, (-c)\nFROM t;\n",
+		},
+		{
+			name:     "operators",
+			sql:      "select 'a'||'b', x<=1, y != 2, z<>3 from t where `c`>=-1;",
+			expected: "SELECT 'a' || 'b', x <= 1, y != 2, z <> 3\nFROM t\nWHERE `c` >= -1;\n",
+		},
+		{
+			name:     "statement set",
+			sql:      "EXECUTE STATEMENT SET BEGIN INSERT INTO a SELECT * FROM b; INSERT INTO c SELECT * FROM d; END;",
+			expected: "EXECUTE STATEMENT SET BEGIN\n  INSERT INTO a\n  SELECT *\n  FROM b;\n  INSERT INTO c\n  SELECT *\n  FROM d;\nEND;\n",
+		},
+		{
+			name:     "comments are kept",
+			sql:      "-- orders\nSELECT a, -- first\n  b /* second */ FROM t;\n\n\nSET 'sql.local-time-zone' = 'UTC';",
+			expected: "-- orders\nSELECT a, -- first\nb /* second */\nFROM t;\n\nSET 'sql.local-time-zone' = 'UTC';\n",
+		},
+		{
+			name:     "comments without surrounding whitespace",
+			sql:      "SELECT a/* first */FROM t;--done",
+			expected: "SELECT a /* first */\nFROM t;\n\n--done\n",
+		},
+		{
+			name:     "unterminated statement",
+			sql:      "select 1",
+			expected: "SELECT 1\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			formatted := Format(test.sql)
+			require.Equal(t, test.expected, formatted)
+			require.Equal(t, formatted, Format(formatted))
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package formatting
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/confluentinc/cli/v4/pkg/flink/config"
+	"github.com/confluentinc/cli/v4/pkg/types"
+)
+
+// Words following FROM or JOIN which do not start a table name
+var nonTableReferences = types.NewSet("LATERAL", "UNNEST", "TABLE")
+
+// Keywords which end the list of tables of a FROM clause
+var tableReferenceEndKeywords = types.NewSet(
+	"WHERE", "GROUP", "HAVING", "ORDER", "LIMIT", "WINDOW", "UNION", "INTERSECT", "EXCEPT", "JOIN", "LEFT", "RIGHT",
+	"FULL", "INNER", "CROSS", "NATURAL", "ON", "FOR", "MATCH_RECOGNIZE", "SELECT", "END",
+)
+
+// GetTables returns the unqualified names of the tables a SQL script reads from and writes to. Tables written by
+// INSERT statements and CREATE TABLE AS SELECT are sinks; tables read by FROM and JOIN clauses, including those passed
+// to table-valued functions, are sources. Common table expressions are not tables and are left out.
+func GetTables(sql string) (sources, sinks []string) {
+	sourceSet := types.NewSet[string]()
+	sinkSet := types.NewSet[string]()
+
+	for _, statement := range splitStatements(tokenize(sql)) {
+		statement = significant(statement)
+		commonTableExpressions := getCommonTableExpressions(statement)
+
+		if name, ok := getCreatedTable(statement); ok && containsWord(statement, "SELECT") {
+			sinkSet.Add(name)
+		}
+
+		for i, t := range statement {
+			switch {
+			case t.isWord("INSERT"):
+				if i+2 < len(statement) && (statement[i+1].isWord("INTO") || statement[i+1].isWord("OVERWRITE")) {
+					if name, ok := getTableName(statement, i+2); ok {
+						sinkSet.Add(name)
+					}
+				}
+			case t.isWord("FROM") || t.isWord("JOIN"):
+				for _, name := range getTableReferences(statement, i+1) {
+					if !commonTableExpressions.Contains(name) {
+						sourceSet.Add(name)
+					}
+				}
+			case t.isWord("TABLE") && i > 0 && (statement[i-1].is(punctuationToken, "(") || statement[i-1].is(punctuationToken, ",")):
+				// Table arguments of table-valued functions, such as TUMBLE(TABLE orders, ...)
+				if name, ok := getTableName(statement, i+1); ok && !commonTableExpressions.Contains(name) {
+					sourceSet.Add(name)
+				}
+			}
+		}
+	}
+
+	sources, sinks = sourceSet.Slice(), sinkSet.Slice()
+	slices.Sort(sources)
+	slices.Sort(sinks)
+	return sources, sinks
+}
+
+// getTableReferences returns the names of the tables referenced at index i by a FROM or JOIN clause, including those
+// separated by commas, such as "a" and "b" in "FROM a, b".
+func getTableReferences(statement []token, i int) []string {
+	var names []string
+	for i < len(statement) {
+		t := statement[i]
+		if t.is(punctuationToken, "(") || t.kind == wordToken && nonTableReferences.Contains(strings.ToUpper(t.text)) {
+			return names
+		}
+
+		name, ok := getTableName(statement, i)
+		if !ok {
+			return names
+		}
+		names = append(names, name)
+
+		// Skip the rest of the qualified name and the alias
+		for i < len(statement) && !statement[i].is(punctuationToken, ",") && !isTableReferenceEnd(statement[i]) {
+			i++
+		}
+		if i >= len(statement) || !statement[i].is(punctuationToken, ",") {
+			return names
+		}
+		i++
+	}
+	return names
+}
+
+// isTableReferenceEnd reports whether a token ends the list of table references of a FROM clause.
+func isTableReferenceEnd(t token) bool {
+	if t.kind == punctuationToken {
+		return t.text == ")" || t.text == config.StatementTerminator
+	}
+	return t.kind == wordToken && tableReferenceEndKeywords.Contains(strings.ToUpper(t.text))
+}
+
+// getCommonTableExpressions returns the names defined by WITH clauses, such as "recent" in
+// "WITH recent AS (SELECT ...) SELECT * FROM recent".
+func getCommonTableExpressions(statement []token) types.Set[string] {
+	names := types.NewSet[string]()
+	for i := 1; i+2 < len(statement); i++ {
+		previous := statement[i-1]
+		if !previous.isWord("WITH") && !previous.is(punctuationToken, ",") {
+			continue
+		}
+		if statement[i+1].isWord("AS") && statement[i+2].is(punctuationToken, "(") {
+			if name, ok := getTableName(statement, i); ok {
+				names.Add(name)
+			}
+		}
+	}
+	return names
+}
@@ -0,0 +1,61 @@
+package formatting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTables(t *testing.T) {
+	tests := []struct {
+		name    string
+		sql     string
+		sources []string
+		sinks   []string
+	}{
+		{
+			name:    "insert into select",
+			sql:     "INSERT INTO `cat`.`db`.enriched SELECT o.id, c.name FROM orders o JOIN customers c ON o.customer_id = c.id;",
+			sources: []string{"customers", "orders"},
+			sinks:   []string{"enriched"},
+		},
+		{
+			name:    "comma separated tables and subqueries",
+			sql:     "INSERT OVERWRITE report SELECT * FROM a, b AS bb, (SELECT * FROM c WHERE x IN (SELECT x FROM d)) WHERE a.id = bb.id;",
+			sources: []string{"a", "b", "c", "d"},
+			sinks:   []string{"report"},
+		},
+		{
+			name:    "create table as select with common table expression",
+			sql:     "CREATE TABLE totals WITH ('connector' = 'kafka') AS WITH recent AS (SELECT * FROM orders), big AS (SELECT * FROM recent) SELECT * FROM big;",
+			sources: []string{"orders"},
+			sinks:   []string{"totals"},
+		},
+		{
+			name:    "table-valued functions and temporal joins",
+			sql:     "INSERT INTO per_minute SELECT * FROM TABLE(TUMBLE(TABLE clicks, DESCRIPTOR(ts), INTERVAL '1' MINUTE)) t JOIN rates FOR SYSTEM_TIME AS OF t.ts r ON t.c = r.c CROSS JOIN UNNEST(t.tags) AS u (tag);",
+			sources: []string{"clicks", "rates"},
+			sinks:   []string{"per_minute"},
+		},
+		{
+			name:    "statement set",
+			sql:     "EXECUTE STATEMENT SET BEGIN INSERT INTO a SELECT * FROM src; INSERT INTO b SELECT * FROM src; END;",
+			sources: []string{"src"},
+			sinks:   []string{"a", "b"},
+		},
+		{
+			name:    "ddl without query",
+			sql:     "CREATE TABLE orders (id INT) WITH ('connector' = 'kafka');",
+			sources: []string{},
+			sinks:   []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sources, sinks := GetTables(test.sql)
+			require.Equal(t, test.sources, sources)
+			require.Equal(t, test.sinks, sinks)
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package formatting
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/confluentinc/cli/v4/pkg/flink/config"
+	"github.com/confluentinc/cli/v4/pkg/flink/types"
+)
+
+const (
+	ruleUnterminatedString     = "unterminated-string"
+	ruleUnterminatedIdentifier = "unterminated-identifier"
+	ruleUnterminatedComment    = "unterminated-comment"
+	ruleMissingSemicolon       = "missing-semicolon"
+	ruleSelectStarInsert       = "select-star-insert"
+	ruleUnboundedJoin          = "unbounded-join"
+)
+
+// Window table-valued functions bound the state of a join to a single window
+var windowFunctions = []string{"TUMBLE", "HOP", "CUMULATE", "SESSION"}
+
+// Lint checks a SQL script for common mistakes. Tables created in the script are used to check whether the
+// inputs of a join have watermarks; tables created elsewhere are assumed to have them.
+func Lint(sql string) []types.LintIssue {
+	tokens := tokenize(sql)
+
+	var issues []types.LintIssue
+	for _, t := range tokens {
+		if t.unterminated {
+			issues = append(issues, lintUnterminated(t))
+		}
+	}
+
+	watermarks := map[string]bool{}
+	for _, statement := range splitStatements(tokens) {
+		statement = significant(statement)
+		if len(statement) == 0 {
+			continue
+		}
+
+		if !statement[len(statement)-1].is(punctuationToken, config.StatementTerminator) {
+			issues = append(issues, newLintIssue(statement[0], ruleMissingSemicolon, "Statement is not terminated by a semicolon."))
+		}
+
+		if name, ok := getCreatedTable(statement); ok {
+			watermarks[name] = containsWord(statement, "WATERMARK")
+		}
+
+		if issue, ok := lintSelectStarInsert(statement); ok {
+			issues = append(issues, issue)
+		}
+
+		if issue, ok := lintUnboundedJoin(statement, watermarks); ok {
+			issues = append(issues, issue)
+		}
+	}
+
+	slices.SortStableFunc(issues, func(a, b types.LintIssue) int {
+		return cmp.Or(cmp.Compare(a.Line, b.Line), cmp.Compare(a.Column, b.Column))
+	})
+
+	return issues
+}
+
+func newLintIssue(t token, rule, message string) types.LintIssue {
+	return types.LintIssue{Line: t.line, Column: t.column, Rule: rule, Message: message}
+}
+
+func lintUnterminated(t token) types.LintIssue {
+	switch t.kind {
+	case stringToken:
+		return newLintIssue(t, ruleUnterminatedString, "String literal is not terminated.")
+	case identifierToken:
+		return newLintIssue(t, ruleUnterminatedIdentifier, "Quoted identifier is not terminated.")
+	default:
+		return newLintIssue(t, ruleUnterminatedComment, "Comment is not terminated.")
+	}
+}
+
+// lintSelectStarInsert finds INSERT INTO statements which select all columns, since they break as soon as the
+// schema of the source table no longer matches the sink table.
+func lintSelectStarInsert(statement []token) (types.LintIssue, bool) {
+	if !containsWord(statement, "INSERT") {
+		return types.LintIssue{}, false
+	}
+
+	for i, t := range statement {
+		if !t.isWord("SELECT") {
+			continue
+		}
+
+		j := i + 1
+		if j < len(statement) && (statement[j].isWord("DISTINCT") || statement[j].isWord("ALL")) {
+			j++
+		}
+		if j < len(statement) && statement[j].is(operatorToken, "*") {
+			return newLintIssue(statement[j], ruleSelectStarInsert, "INSERT INTO selects all columns with `SELECT *`; list the columns explicitly so that schema changes to the source table do not break the statement."), true
+		}
+	}
+
+	return types.LintIssue{}, false
+}
+
+// lintUnboundedJoin finds regular joins, whose state grows without limit, in contrast to interval joins on inputs with
+// watermarks, window joins, and temporal joins.
+func lintUnboundedJoin(statement []token, watermarks map[string]bool) (types.LintIssue, bool) {
+	join := -1
+	var tables []string
+	for i, t := range statement {
+		if !t.isWord("FROM") && !t.isWord("JOIN") {
+			continue
+		}
+		if i+1 < len(statement) && (statement[i+1].isWord("UNNEST") || statement[i+1].isWord("LATERAL")) {
+			continue
+		}
+		if t.isWord("JOIN") && join == -1 {
+			join = i
+		}
+		if name, ok := getTableName(statement, i+1); ok {
+			tables = append(tables, name)
+		}
+	}
+	if join == -1 {
+		return types.LintIssue{}, false
+	}
+
+	for i, t := range statement {
+		if t.isWord("FOR") && i+1 < len(statement) && statement[i+1].isWord("SYSTEM_TIME") {
+			return types.LintIssue{}, false
+		}
+		for _, function := range windowFunctions {
+			if t.isWord(function) && i+1 < len(statement) && statement[i+1].is(punctuationToken, "(") {
+				return types.LintIssue{}, false
+			}
+		}
+	}
+
+	timeBound := containsWord(statement[join:], "INTERVAL") || containsWord(statement[join:], "BETWEEN")
+	for _, table := range tables {
+		if hasWatermark, ok := watermarks[table]; ok && !hasWatermark {
+			return newLintIssue(statement[join], ruleUnboundedJoin, `Join input "`+table+`" has no watermark, so the join keeps its state indefinitely; define a watermark and join on a time interval.`), true
+		}
+	}
+	if !timeBound {
+		return newLintIssue(statement[join], ruleUnboundedJoin, "Join has no time bound, so it keeps its state indefinitely; use an interval, window, or temporal join instead."), true
+	}
+
+	return types.LintIssue{}, false
+}
+
+// getCreatedTable returns the name of the table created by a CREATE TABLE statement.
+func getCreatedTable(statement []token) (string, bool) {
+	if len(statement) == 0 || !statement[0].isWord("CREATE") {
+		return "", false
+	}
+
+	for i, t := range statement {
+		if t.isWord("TABLE") {
+			j := i + 1
+			if j+2 < len(statement) && statement[j].isWord("IF") && statement[j+1].isWord("NOT") && statement[j+2].isWord("EXISTS") {
+				j += 3
+			}
+			return getTableName(statement, j)
+		}
+		if t.is(punctuationToken, "(") {
+			break
+		}
+	}
+
+	return "", false
+}
+
+// getTableName returns the unqualified name of the table referenced at index i, such as "orders" for
+// `catalog`.`database`.orders.
+func getTableName(statement []token, i int) (string, bool) {
+	var name string
+	for ; i < len(statement); i++ {
+		t := statement[i]
+		if t.unterminated {
+			return "", false
+		}
+
+		switch t.kind {
+		case wordToken:
+			name = t.text
+		case identifierToken:
+			name = strings.ReplaceAll(t.text[1:len(t.text)-1], t.text[:1]+t.text[:1], t.text[:1])
+		default:
+			return "", false
+		}
+
+		if i+1 >= len(statement) || !statement[i+1].is(punctuationToken, ".") {
+			return name, true
+		}
+		i++
+	}
+	return "", false
+}
+
+func containsWord(tokens []token, word string) bool {
+	for _, t := range tokens {
+		if t.isWord(word) {
+			return true
+		}
+	}
+	return false
+}
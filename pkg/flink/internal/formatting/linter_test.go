@@ -0,0 +1,80 @@
+package formatting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/cli/v4/pkg/flink/types"
+)
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name     string
+		sql      string
+		expected []types.LintIssue
+	}{
+		{
+			name: "no issues",
+			sql:  "INSERT INTO sink SELECT id, amount FROM orders;\n-- done",
+		},
+		{
+			name: "unterminated string",
+			sql:  "SELECT 'abc FROM t;",
+			expected: []types.LintIssue{
+				{Line: 1, Column: 1, Rule: ruleMissingSemicolon, Message: "Statement is not terminated by a semicolon."},
+				{Line: 1, Column: 8, Rule: ruleUnterminatedString, Message: "String literal is not terminated."},
+			},
+		},
+		{
+			name: "unterminated identifier and comment",
+			sql:  "SELECT 1;\nSELECT `a FROM t; /* comment",
+			expected: []types.LintIssue{
+				{Line: 2, Column: 1, Rule: ruleMissingSemicolon, Message: "Statement is not terminated by a semicolon."},
+				{Line: 2, Column: 8, Rule: ruleUnterminatedIdentifier, Message: "Quoted identifier is not terminated."},
+			},
+		},
+		{
+			name: "missing semicolon",
+			sql:  "SELECT 1;\n\nSELECT 2",
+			expected: []types.LintIssue{
+				{Line: 3, Column: 1, Rule: ruleMissingSemicolon, Message: "Statement is not terminated by a semicolon."},
+			},
+		},
+		{
+			name: "select star in insert into",
+			sql:  "INSERT INTO sink\nSELECT DISTINCT * FROM orders;\nSELECT * FROM orders;",
+			expected: []types.LintIssue{
+				{Line: 2, Column: 17, Rule: ruleSelectStarInsert, Message: "INSERT INTO selects all columns with `SELECT *`; list the columns explicitly so that schema changes to the source table do not break the statement."},
+			},
+		},
+		{
+			name: "regular join",
+			sql:  "SELECT o.id FROM orders o JOIN customers c ON o.customer_id = c.id;",
+			expected: []types.LintIssue{
+				{Line: 1, Column: 27, Rule: ruleUnboundedJoin, Message: "Join has no time bound, so it keeps its state indefinitely; use an interval, window, or temporal join instead."},
+			},
+		},
+		{
+			name: "interval join on input without watermark",
+			sql:  "CREATE TABLE orders (id INT, ts TIMESTAMP(3));\nSELECT * FROM orders o JOIN `db`.`shipments` s ON o.id = s.id AND s.ts BETWEEN o.ts AND o.ts + INTERVAL '1' HOUR;",
+			expected: []types.LintIssue{
+				{Line: 2, Column: 24, Rule: ruleUnboundedJoin, Message: `Join input "orders" has no watermark, so the join keeps its state indefinitely; define a watermark and join on a time interval.`},
+			},
+		},
+		{
+			name: "bounded joins",
+			sql: "CREATE TABLE orders (id INT, ts TIMESTAMP(3), WATERMARK FOR ts AS ts);\n" +
+				"SELECT * FROM orders o JOIN shipments s ON o.id = s.id AND s.ts BETWEEN o.ts AND o.ts + INTERVAL '1' HOUR;\n" +
+				"SELECT * FROM orders o JOIN rates FOR SYSTEM_TIME AS OF o.ts r ON o.currency = r.currency;\n" +
+				"SELECT * FROM TABLE(TUMBLE(TABLE orders, DESCRIPTOR(ts), INTERVAL '1' MINUTE)) o JOIN TABLE(TUMBLE(TABLE shipments, DESCRIPTOR(ts), INTERVAL '1' MINUTE)) s ON o.id = s.id AND o.window_start = s.window_start;\n" +
+				"SELECT * FROM orders CROSS JOIN UNNEST(tags) AS t (tag);",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, Lint(test.sql))
+		})
+	}
+}
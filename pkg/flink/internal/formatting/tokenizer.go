@@ -0,0 +1,270 @@
+package formatting
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/confluentinc/cli/v4/pkg/flink/config"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/highlighting"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/store"
+)
+
+type tokenKind int
+
+const (
+	wordToken tokenKind = iota
+	stringToken
+	identifierToken
+	operatorToken
+	punctuationToken
+	lineCommentToken
+	blockCommentToken
+)
+
+type token struct {
+	kind   tokenKind
+	text   string
+	line   int
+	column int
+	// spaceBefore and newlineBefore record the whitespace that preceded the token in the input
+	spaceBefore   bool
+	newlineBefore bool
+	unterminated  bool
+}
+
+func (t token) is(kind tokenKind, text string) bool {
+	return t.kind == kind && strings.EqualFold(t.text, text)
+}
+
+func (t token) isWord(text string) bool {
+	return t.is(wordToken, text)
+}
+
+func (t token) isComment() bool {
+	return t.kind == lineCommentToken || t.kind == blockCommentToken
+}
+
+var multiCharacterOperators = []string{"<=", ">=", "<>", "!=", "||", "=>"}
+
+var punctuation = []string{"(", ")", ",", config.StatementTerminator, ".", "[", "]"}
+
+// tokenize splits SQL into tokens. It is built on the words of the highlighting lexer, which splits at each of the
+// config.SpecialSplitTokens: the words of a string literal, quoted identifier, or comment are joined back together,
+// and operators are split from the words they are attached to. Tokens keep their positions so that the SQL can be
+// reassembled.
+func tokenize(sql string) []token {
+	var tokens []token
+	words := highlighting.SplitWithSeparators(sql)
+	line, column := 1, 1
+	var spaceBefore, newlineBefore bool
+
+	for len(words) > 0 {
+		word := words[0]
+		words = words[1:]
+
+		if isWhitespace(word) {
+			spaceBefore = true
+			newlineBefore = newlineBefore || word == "\n"
+			line, column = advance(word, line, column)
+			continue
+		}
+
+		var texts []string
+		t := token{line: line, column: column, spaceBefore: spaceBefore, newlineBefore: newlineBefore}
+		switch {
+		case strings.HasPrefix(word, "--"):
+			t.kind = lineCommentToken
+			t.text, words, _ = joinWords(word, words, lineCommentEnd)
+		case strings.HasPrefix(word, "/*"):
+			t.kind = blockCommentToken
+			t.text, words, t.unterminated = joinWords(word, words, blockCommentEnd)
+			t.unterminated = !t.unterminated
+		case isQuote(rune(word[0])):
+			t.kind = identifierToken
+			if word[0] == '\'' {
+				t.kind = stringToken
+			}
+			t.text, words, t.unterminated = joinWords(word, words, quoteEnd(rune(word[0])))
+			t.unterminated = !t.unterminated
+		case slices.Contains(punctuation, word):
+			t.kind = punctuationToken
+			t.text = word
+		default:
+			// A word ends where a string literal, quoted identifier, or comment starts, such as in 'a'||'b'
+			if i := strings.IndexFunc(word[1:], isQuote); i >= 0 {
+				words = append([]string{word[i+1:]}, words...)
+				word = word[:i+1]
+			}
+			for _, commentStart := range []string{"--", "/*"} {
+				if i := strings.Index(word[1:], commentStart); i >= 0 {
+					words = append([]string{word[i+1:]}, words...)
+					word = word[:i+1]
+				}
+			}
+
+			// Operators which are split tokens of their own, such as < and =, are joined with the next word into
+			// operators such as <=, while operators attached to words, such as in a-1, are split from them
+			if len(words) > 0 && slices.Contains(multiCharacterOperators, word+words[0]) {
+				word += words[0]
+				words = words[1:]
+			}
+			texts = splitOperators(word)
+		}
+
+		if texts == nil {
+			texts = []string{t.text}
+		}
+		for i, text := range texts {
+			if i > 0 {
+				t = token{line: line, column: column}
+			}
+			if t.kind == wordToken || t.kind == operatorToken {
+				t.kind = operatorToken
+				if isWordRune([]rune(text)[0]) {
+					t.kind = wordToken
+				}
+			}
+			t.text = text
+			tokens = append(tokens, t)
+			line, column = advance(text, line, column)
+		}
+		spaceBefore, newlineBefore = false, false
+	}
+
+	return tokens
+}
+
+func isWhitespace(word string) bool {
+	return strings.TrimSpace(word) == ""
+}
+
+func isQuote(c rune) bool {
+	return c == '\'' || c == '"' || c == '`'
+}
+
+func isWordRune(c rune) bool {
+	return unicode.IsLetter(c) || unicode.IsDigit(c) || c == '_' || c == '$'
+}
+
+// advance moves a position past text.
+func advance(text string, line, column int) (int, int) {
+	for _, r := range text {
+		if r == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+	return line, column
+}
+
+// joinWords joins words to the first word until end finds the end of the token in the joined text. Whatever follows
+// the end of the token is put back in front of the remaining words. It also returns whether the end was found.
+func joinWords(text string, words []string, end func(text string) int) (string, []string, bool) {
+	for {
+		if i := end(text); i >= 0 {
+			if i < len(text) {
+				words = append([]string{text[i:]}, words...)
+			}
+			return text[:i], words, true
+		}
+		if len(words) == 0 {
+			return text, nil, false
+		}
+		text += words[0]
+		words = words[1:]
+	}
+}
+
+func lineCommentEnd(text string) int {
+	return strings.Index(text, "\n")
+}
+
+func blockCommentEnd(text string) int {
+	if i := strings.Index(text[2:], "*/"); i >= 0 {
+		return i + 4
+	}
+	return -1
+}
+
+func quoteEnd(quote rune) func(string) int {
+	return func(text string) int {
+		runes := []rune(text)
+		for i := 1; i < len(runes); i++ {
+			if runes[i] != quote {
+				continue
+			}
+			// escaped quote
+			if i+1 < len(runes) && runes[i+1] == quote {
+				i++
+				continue
+			}
+			return len(string(runes[:i+1]))
+		}
+		return -1
+	}
+}
+
+// splitOperators splits a word into the runs of characters which make up words, such as identifiers, keywords, and
+// numbers, and the operators between them.
+func splitOperators(word string) []string {
+	var texts []string
+	runes := []rune(word)
+	for i := 0; i < len(runes); {
+		j := i + 1
+		if isWordRune(runes[i]) {
+			for j < len(runes) && isWordRune(runes[j]) {
+				j++
+			}
+		} else if j < len(runes) && slices.Contains(multiCharacterOperators, string(runes[i:j+1])) {
+			j++
+		}
+		texts = append(texts, string(runes[i:j]))
+		i = j
+	}
+	return texts
+}
+
+// splitStatements groups tokens into statements, each ending with its terminator if it has one. The statements of an
+// EXECUTE STATEMENT SET block are kept together since they are submitted as a single statement.
+func splitStatements(tokens []token) [][]token {
+	var statements [][]token
+	var statement []token
+
+	for _, t := range tokens {
+		if t.is(punctuationToken, config.StatementTerminator) && !store.IsInStatementSet(getText(significant(statement))) {
+			statements = append(statements, append(statement, t))
+			statement = nil
+			continue
+		}
+		statement = append(statement, t)
+	}
+
+	if len(statement) > 0 {
+		statements = append(statements, statement)
+	}
+
+	return statements
+}
+
+// getText joins the text of tokens, separated by spaces.
+func getText(tokens []token) string {
+	texts := make([]string, len(tokens))
+	for i, t := range tokens {
+		texts[i] = t.text
+	}
+	return strings.Join(texts, " ")
+}
+
+// significant returns the tokens of a statement without its comments.
+func significant(tokens []token) []token {
+	var out []token
+	for _, t := range tokens {
+		if !t.isComment() {
+			out = append(out, t)
+		}
+	}
+	return out
+}
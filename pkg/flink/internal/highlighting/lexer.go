@@ -14,7 +14,9 @@ type Word struct {
 	Separator string
 }
 
-func splitWithSeparators(line string) []string {
+// SplitWithSeparators splits a line into words at each of the config.SpecialSplitTokens, which are kept as words of
+// their own, so that joining the words gives back the line.
+func SplitWithSeparators(line string) []string {
 	words := []string{}
 	word := ""
 
@@ -47,7 +49,7 @@ func Lexer(line string) []prompt.LexerElement {
 		return lexerWords
 	}
 
-	words := splitWithSeparators(line)
+	words := SplitWithSeparators(line)
 
 	for _, word := range words {
 		element := prompt.LexerElement{}
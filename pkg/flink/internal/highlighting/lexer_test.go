@@ -104,7 +104,7 @@ func TestSplitWithSeparators(t *testing.T) {
 		// given
 		sentence := generators.RandomSQLSentence().Draw(t, "line")
 		// when
-		tokens := splitWithSeparators(sentence.Text)
+		tokens := SplitWithSeparators(sentence.Text)
 		// then
 		require.Equal(t, sentence.TokenCount, len(tokens))
 	})
@@ -115,7 +115,7 @@ func TestSplitWithSeparatorsDoesNotIncludeEmptyString(t *testing.T) {
 		// given
 		sentence := generators.RandomSQLSentence().Draw(t, "line")
 		// when
-		tokens := splitWithSeparators(sentence.Text)
+		tokens := SplitWithSeparators(sentence.Text)
 		// then
 		require.NotContains(t, tokens, "")
 	})
@@ -125,7 +125,7 @@ func TestSplitWithSeparatorsSnapshot(t *testing.T) {
 	// given
 	sentence := `SELECT count(col1) FROM users \n /*\t\v\f\r[testing]*/WHERE (name = 'John Doe'); -- \n>.,<:= testing)`
 	// when
-	tokens := splitWithSeparators(sentence)
+	tokens := SplitWithSeparators(sentence)
 	// then
 	cupaloy.SnapshotT(t, tokens)
 }
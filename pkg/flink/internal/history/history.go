@@ -31,6 +31,16 @@ func LoadHistoryOnPrem() *History {
 	return loadFromPath(history)
 }
 
+// LoadHistoryFromPath loads the history kept in a file other than the global one, such as the history of a named session
+func LoadHistoryFromPath(historyPath string) *History {
+	history := &History{
+		Data:          nil,
+		confluentPath: filepath.Dir(historyPath),
+		historyPath:   historyPath,
+	}
+	return loadFromPath(history)
+}
+
 func loadFromPath(history *History) *History {
 	if history == nil {
 		return nil
@@ -84,10 +94,8 @@ func (history *History) Save() {
 		log.CliLogger.Warnf("Couldn't save past statements history: couldn't marshal history: %v", err)
 	}
 
-	if err := os.Mkdir(history.confluentPath, os.ModePerm); err != nil {
-		if !errors.Is(err, os.ErrExist) {
-			log.CliLogger.Warnf("Couldn't save past statements history: couldn't create directory: %v", err)
-		}
+	if err := os.MkdirAll(history.confluentPath, os.ModePerm); err != nil {
+		log.CliLogger.Warnf("Couldn't save past statements history: couldn't create directory: %v", err)
 	}
 
 	// Write JSON to file
@@ -73,6 +73,20 @@ func TestHistorySave(t *testing.T) {
 	require.Len(t, history.Data, 500)
 }
 
+func TestLoadHistoryFromPath(t *testing.T) {
+	historyPath := filepath.Join(t.TempDir(), "sessions", "etl-dev", "history.json")
+
+	history := LoadHistoryFromPath(historyPath)
+	require.Empty(t, history.Data)
+
+	// Saving creates the directories of the history file
+	history.Append("statement1")
+	history.Save()
+
+	history = LoadHistoryFromPath(historyPath)
+	require.Equal(t, []string{"statement1"}, history.Data)
+}
+
 func TestAppendHistory(t *testing.T) {
 	// Create a History instance for testing
 	history := &History{
@@ -0,0 +1,264 @@
+package results
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/confluentinc/cli/v4/pkg/flink/types"
+)
+
+const (
+	// OperationColumn is the column holding the changelog operation (+I, -U, +U, -D) of each exported row.
+	OperationColumn    = "op"
+	ExportedResultsMsg = "Exported %d row(s) to \"%s\".\n"
+)
+
+var ExportFileExtensions = []string{".csv", ".jsonl", ".parquet"}
+
+type rowWriter interface {
+	write(row types.StatementResultRow) error
+	close() error
+}
+
+// ValidateExportFile checks that the results can be exported to a file with the given path.
+func ValidateExportFile(path string) error {
+	if path == "" {
+		return fmt.Errorf("no output file specified")
+	}
+	if extension := strings.ToLower(filepath.Ext(path)); !slices.Contains(ExportFileExtensions, extension) {
+		return fmt.Errorf(`unsupported file extension "%s", must be one of %s`, extension, strings.Join(ExportFileExtensions, ", "))
+	}
+	return nil
+}
+
+// GetUniqueColumnNames suffixes repeated column names with their occurrence, so that every column of the results
+// keeps its own field when the results are exported.
+func GetUniqueColumnNames(columns []string) []string {
+	uniqueColumns := make([]string, len(columns))
+	seen := make(map[string]bool, len(columns))
+	for idx, column := range columns {
+		uniqueColumn := column
+		for occurrence := 2; seen[uniqueColumn]; occurrence++ {
+			uniqueColumn = fmt.Sprintf("%s_%d", column, occurrence)
+		}
+		seen[uniqueColumn] = true
+		uniqueColumns[idx] = uniqueColumn
+	}
+	return uniqueColumns
+}
+
+// ExportResults streams the changelog of the materialized results to a CSV, JSON Lines, or Parquet file,
+// depending on the file extension, and returns the number of rows written. An existing file is only replaced if
+// overwrite is set.
+func ExportResults(path string, materializedResults *types.MaterializedStatementResults, overwrite bool) (int, error) {
+	if err := ValidateExportFile(path); err != nil {
+		return 0, err
+	}
+
+	columns := GetUniqueColumnNames(materializedResults.GetColumnNames())
+	if len(columns) == 0 {
+		return 0, fmt.Errorf("no results to export")
+	}
+	if slices.Contains(columns, OperationColumn) {
+		return 0, fmt.Errorf(`column "%s" is reserved for the changelog operation`, OperationColumn)
+	}
+
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if !overwrite {
+		flags |= os.O_EXCL
+	}
+	file, err := os.OpenFile(path, flags, 0644)
+	if errors.Is(err, fs.ErrExist) {
+		return 0, fmt.Errorf(`file "%s" already exists`, path)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	rowCount, err := writeResults(file, path, columns, materializedResults)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// A partially written file is removed rather than left truncated
+		_ = os.Remove(path)
+		return 0, err
+	}
+	return rowCount, nil
+}
+
+func writeResults(file io.Writer, path string, columns []string, materializedResults *types.MaterializedStatementResults) (int, error) {
+	var writer rowWriter
+	var err error
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		writer, err = newCSVRowWriter(file, columns)
+	case ".jsonl":
+		writer = newJSONLinesRowWriter(file, columns)
+	case ".parquet":
+		writer, err = newParquetRowWriter(file, columns)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	rowCount := 0
+	materializedResults.ForEachChangelogRow(func(_ int, row types.StatementResultRow) {
+		if err != nil {
+			return
+		}
+		if err = writer.write(row); err == nil {
+			rowCount++
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if err := writer.close(); err != nil {
+		return 0, err
+	}
+	return rowCount, nil
+}
+
+type csvRowWriter struct {
+	writer *csv.Writer
+}
+
+func newCSVRowWriter(file io.Writer, columns []string) (*csvRowWriter, error) {
+	writer := csv.NewWriter(file)
+	if err := writer.Write(append([]string{OperationColumn}, columns...)); err != nil {
+		return nil, err
+	}
+	return &csvRowWriter{writer: writer}, nil
+}
+
+func (w *csvRowWriter) write(row types.StatementResultRow) error {
+	record := make([]string, 0, len(row.Fields)+1)
+	record = append(record, row.Operation.String())
+	for _, field := range row.Fields {
+		record = append(record, field.ToString())
+	}
+	return w.writer.Write(record)
+}
+
+func (w *csvRowWriter) close() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+type jsonLinesRowWriter struct {
+	writer  io.Writer
+	columns []string
+}
+
+func newJSONLinesRowWriter(file io.Writer, columns []string) *jsonLinesRowWriter {
+	return &jsonLinesRowWriter{
+		writer:  file,
+		columns: columns,
+	}
+}
+
+// write encodes the fields of a row in the order of the columns, since encoding a map would sort them by name.
+func (w *jsonLinesRowWriter) write(row types.StatementResultRow) error {
+	var buffer bytes.Buffer
+	buffer.WriteByte('{')
+	if err := writeJSONMember(&buffer, OperationColumn, row.Operation.String()); err != nil {
+		return err
+	}
+	for idx, field := range row.Fields {
+		buffer.WriteByte(',')
+		if err := writeJSONMember(&buffer, w.columns[idx], field.ToSDKType()); err != nil {
+			return err
+		}
+	}
+	buffer.WriteString("}\n")
+
+	_, err := w.writer.Write(buffer.Bytes())
+	return err
+}
+
+func writeJSONMember(buffer *bytes.Buffer, name string, value any) error {
+	encodedName, err := json.Marshal(name)
+	if err != nil {
+		return err
+	}
+	encodedValue, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	buffer.Write(encodedName)
+	buffer.WriteByte(':')
+	buffer.Write(encodedValue)
+	return nil
+}
+
+func (w *jsonLinesRowWriter) close() error {
+	return nil
+}
+
+// parquetRowWriter writes every column as an optional string, since the SQL types of the results are not
+// available once they have been materialized.
+type parquetRowWriter struct {
+	writer *parquet.Writer
+}
+
+func newParquetRowWriter(file io.Writer, columns []string) (*parquetRowWriter, error) {
+	group := parquet.Group{OperationColumn: parquet.String()}
+	for _, column := range columns {
+		group[column] = parquet.Optional(parquet.String())
+	}
+
+	// A group sorts its fields by name, so they are reordered to keep the operation first, followed by the
+	// columns in the order of the results
+	fields := make(map[string]parquet.Field, len(group))
+	for _, field := range group.Fields() {
+		fields[field.Name()] = field
+	}
+	orderedFields := make([]parquet.Field, 0, len(fields))
+	for _, column := range append([]string{OperationColumn}, columns...) {
+		orderedFields = append(orderedFields, fields[column])
+	}
+
+	schema := parquet.NewSchema("results", &orderedGroup{Group: group, fields: orderedFields})
+	return &parquetRowWriter{writer: parquet.NewWriter(file, schema)}, nil
+}
+
+type orderedGroup struct {
+	parquet.Group
+	fields []parquet.Field
+}
+
+func (g *orderedGroup) Fields() []parquet.Field {
+	return g.fields
+}
+
+func (w *parquetRowWriter) write(row types.StatementResultRow) error {
+	parquetRow := make(parquet.Row, 0, len(row.Fields)+1)
+	parquetRow = append(parquetRow, parquet.ValueOf(row.Operation.String()).Level(0, 0, 0))
+	for idx, field := range row.Fields {
+		columnIdx := idx + 1
+		if field.GetType() == types.Null {
+			parquetRow = append(parquetRow, parquet.NullValue().Level(0, 0, columnIdx))
+		} else {
+			parquetRow = append(parquetRow, parquet.ValueOf(field.ToString()).Level(0, 1, columnIdx))
+		}
+	}
+	_, err := w.writer.WriteRows([]parquet.Row{parquetRow})
+	return err
+}
+
+func (w *parquetRowWriter) close() error {
+	return w.writer.Close()
+}
@@ -0,0 +1,135 @@
+package results
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/cli/v4/pkg/flink/types"
+)
+
+func getExportTestResults() *types.MaterializedStatementResults {
+	materializedResults := types.NewMaterializedStatementResults([]string{"id", "name"}, 10, nil)
+	materializedResults.Append(
+		types.StatementResultRow{
+			Operation: types.Insert,
+			Fields: []types.StatementResultField{
+				types.AtomicStatementResultField{Type: types.Integer, Value: "1"},
+				types.AtomicStatementResultField{Type: types.Varchar, Value: "Alice, Bob"},
+			},
+		},
+		types.StatementResultRow{
+			Operation: types.Delete,
+			Fields: []types.StatementResultField{
+				types.AtomicStatementResultField{Type: types.Integer, Value: "1"},
+				types.AtomicStatementResultField{Type: types.Null, Value: "NULL"},
+			},
+		},
+	)
+	return &materializedResults
+}
+
+func TestExportResultsCSV(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results.csv")
+
+	rowCount, err := ExportResults(path, getExportTestResults(), false)
+	require.NoError(t, err)
+	require.Equal(t, 2, rowCount)
+
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "op,id,name\n+I,1,\"Alice, Bob\"\n-D,1,NULL\n", string(contents))
+}
+
+func TestExportResultsJSONLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results.jsonl")
+
+	rowCount, err := ExportResults(path, getExportTestResults(), false)
+	require.NoError(t, err)
+	require.Equal(t, 2, rowCount)
+
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "{\"op\":\"+I\",\"id\":\"1\",\"name\":\"Alice, Bob\"}\n{\"op\":\"-D\",\"id\":\"1\",\"name\":null}\n", string(contents))
+}
+
+func TestExportResultsParquet(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results.parquet")
+
+	rowCount, err := ExportResults(path, getExportTestResults(), false)
+	require.NoError(t, err)
+	require.Equal(t, 2, rowCount)
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	stat, err := file.Stat()
+	require.NoError(t, err)
+
+	parquetFile, err := parquet.OpenFile(file, stat.Size())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), parquetFile.NumRows())
+	require.Equal(t, [][]string{{"op"}, {"id"}, {"name"}}, parquetFile.Schema().Columns())
+}
+
+func getDuplicateColumnsTestResults() *types.MaterializedStatementResults {
+	materializedResults := types.NewMaterializedStatementResults([]string{"name", "id", "name"}, 10, nil)
+	materializedResults.Append(types.StatementResultRow{
+		Operation: types.Insert,
+		Fields: []types.StatementResultField{
+			types.AtomicStatementResultField{Type: types.Varchar, Value: "Alice"},
+			types.AtomicStatementResultField{Type: types.Integer, Value: "1"},
+			types.AtomicStatementResultField{Type: types.Varchar, Value: "Bob"},
+		},
+	})
+	return &materializedResults
+}
+
+func TestExportResultsJSONLinesDuplicateColumns(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results.jsonl")
+
+	_, err := ExportResults(path, getDuplicateColumnsTestResults(), false)
+	require.NoError(t, err)
+
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "{\"op\":\"+I\",\"name\":\"Alice\",\"id\":\"1\",\"name_2\":\"Bob\"}\n", string(contents))
+}
+
+func TestExportResultsParquetDuplicateColumns(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results.parquet")
+
+	_, err := ExportResults(path, getDuplicateColumnsTestResults(), false)
+	require.NoError(t, err)
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	stat, err := file.Stat()
+	require.NoError(t, err)
+
+	parquetFile, err := parquet.OpenFile(file, stat.Size())
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"op"}, {"name"}, {"id"}, {"name_2"}}, parquetFile.Schema().Columns())
+}
+
+func TestExportResultsExistingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results.csv")
+	require.NoError(t, os.WriteFile(path, []byte("existing"), 0644))
+
+	_, err := ExportResults(path, getExportTestResults(), false)
+	require.EqualError(t, err, fmt.Sprintf(`file "%s" already exists`, path))
+
+	rowCount, err := ExportResults(path, getExportTestResults(), true)
+	require.NoError(t, err)
+	require.Equal(t, 2, rowCount)
+}
+
+func TestExportResultsUnsupportedExtension(t *testing.T) {
+	_, err := ExportResults(filepath.Join(t.TempDir(), "results.txt"), getExportTestResults(), false)
+	require.EqualError(t, err, `unsupported file extension ".txt", must be one of .csv, .jsonl, .parquet`)
+}
@@ -114,6 +114,11 @@ func (t *ResultFetcher) fetchNextPageAndUpdateState() {
 	t.updateState(newResults, err)
 }
 
+// FetchNextPage fetches and materializes the next page of results without starting the auto refresh.
+func (t *ResultFetcher) FetchNextPage() {
+	t.fetchNextPageAndUpdateState()
+}
+
 func (t *ResultFetcher) updateState(newResults *types.ProcessedStatement, err *types.StatementError) {
 	// don't fetch if we're already at the last page, otherwise we would fetch the first page again
 	if t.GetRefreshState() == types.Completed {
@@ -0,0 +1,163 @@
+package snippets
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/confluentinc/cli/v4/pkg/flink/config"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/variables"
+)
+
+const (
+	filename  = "flink_snippets.json"
+	extension = ".sql"
+)
+
+var nameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+
+// Snippet is a named SQL statement saved by the user, or shared as a ".sql" file of a snippets directory. Its
+// parameters are written as ${name} placeholders.
+type Snippet struct {
+	Name      string
+	Statement string
+	// Path is the file of a shared snippet, or empty for a snippet saved by the user
+	Path string
+}
+
+// Parameters returns the names of the placeholders of the snippet, in the order they first appear.
+func (s Snippet) Parameters() []string {
+	return variables.Names(s.Statement)
+}
+
+// Expand fills in the placeholders of the snippet with the given "name=value" arguments. Placeholders without an
+// argument are kept, so that they can be set like any other variable.
+func (s Snippet) Expand(args []string) (string, error) {
+	params := map[string]string{}
+	for _, arg := range args {
+		name, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			return "", fmt.Errorf(`failed to parse "name=value" pattern from argument "%s"`, arg)
+		}
+		params[name] = value
+	}
+	return variables.Expand(s.Statement, params), nil
+}
+
+// ValidateName checks that a snippet name can be used as the name of its file.
+func ValidateName(name string) error {
+	if !nameRegex.MatchString(name) {
+		return fmt.Errorf(`invalid snippet name "%s": snippet names may only contain letters, digits, ".", "_", and "-"`, name)
+	}
+	return nil
+}
+
+// Load returns the snippets saved by the user merged with the ".sql" files of the shared snippets directory, if any,
+// sorted by name. Snippets saved by the user take precedence over shared snippets of the same name.
+func Load(sharedPath string) ([]Snippet, error) {
+	snippets := map[string]Snippet{}
+
+	if sharedPath != "" {
+		entries, err := os.ReadDir(sharedPath)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to read snippets directory "%s": %w`, sharedPath, err)
+		}
+		for _, entry := range entries {
+			name, ok := strings.CutSuffix(entry.Name(), extension)
+			if entry.IsDir() || !ok || ValidateName(name) != nil {
+				continue
+			}
+
+			path := filepath.Join(sharedPath, entry.Name())
+			b, err := os.ReadFile(path)
+			if err != nil {
+				return nil, err
+			}
+			snippets[name] = Snippet{Name: name, Statement: strings.TrimSpace(string(b)), Path: path}
+		}
+	}
+
+	userSnippets, err := loadUserSnippets()
+	if err != nil {
+		return nil, err
+	}
+	for name, statement := range userSnippets {
+		snippets[name] = Snippet{Name: name, Statement: statement}
+	}
+
+	sorted := make([]Snippet, 0, len(snippets))
+	for _, snippet := range snippets {
+		sorted = append(sorted, snippet)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+	return sorted, nil
+}
+
+// Save adds a snippet to the snippets of the user, or replaces the one of the same name.
+func Save(name, statement string) error {
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+
+	userSnippets, err := loadUserSnippets()
+	if err != nil {
+		return err
+	}
+	userSnippets[name] = strings.TrimSpace(statement)
+
+	b, err := json.MarshalIndent(userSnippets, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	path, err := getPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0600)
+}
+
+func loadUserSnippets() (map[string]string, error) {
+	path, err := getPath()
+	if err != nil {
+		return nil, err
+	}
+
+	userSnippets := map[string]string{}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return userSnippets, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, &userSnippets); err != nil {
+		return nil, fmt.Errorf(`failed to parse snippets file "%s": %w`, path, err)
+	}
+	return userSnippets, nil
+}
+
+func getPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	confluentDir := os.Getenv(config.HomeConfluentPathEnvVar)
+	if confluentDir == "" {
+		confluentDir = config.HomeConfluentPathDefault
+	}
+
+	return filepath.Join(home, confluentDir, filename), nil
+}
@@ -0,0 +1,64 @@
+package snippets
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/cli/v4/pkg/flink/config"
+)
+
+func TestValidateName(t *testing.T) {
+	require.NoError(t, ValidateName("orders_by_region"))
+	require.NoError(t, ValidateName("top-10.v2"))
+	require.Error(t, ValidateName(""))
+	require.Error(t, ValidateName("../orders"))
+	require.Error(t, ValidateName("orders by region"))
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(config.HomeConfluentPathEnvVar, ".confluent")
+
+	snippets, err := Load("")
+	require.NoError(t, err)
+	require.Empty(t, snippets)
+
+	require.NoError(t, Save("orders_by_region", "SELECT * FROM orders WHERE region = '${region}';\n"))
+	require.NoError(t, Save("top_products", "SELECT * FROM products LIMIT ${limit};"))
+	require.Error(t, Save("top products", "SELECT 1;"))
+
+	shared := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(shared, "daily_revenue.sql"), []byte("SELECT SUM(amount) FROM payments;\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(shared, "top_products.sql"), []byte("SELECT * FROM products;"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(shared, "README.md"), []byte("Shared snippets"), 0600))
+
+	snippets, err = Load(shared)
+	require.NoError(t, err)
+	require.Equal(t, []Snippet{
+		{Name: "daily_revenue", Statement: "SELECT SUM(amount) FROM payments;", Path: filepath.Join(shared, "daily_revenue.sql")},
+		{Name: "orders_by_region", Statement: "SELECT * FROM orders WHERE region = '${region}';"},
+		{Name: "top_products", Statement: "SELECT * FROM products LIMIT ${limit};"},
+	}, snippets)
+
+	_, err = Load(filepath.Join(shared, "missing"))
+	require.Error(t, err)
+}
+
+func TestSnippetExpand(t *testing.T) {
+	snippet := Snippet{Name: "orders", Statement: "SELECT * FROM orders WHERE region = '${region}' LIMIT ${limit};"}
+	require.Equal(t, []string{"region", "limit"}, snippet.Parameters())
+
+	statement, err := snippet.Expand([]string{"region=us-east", "limit=10"})
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM orders WHERE region = 'us-east' LIMIT 10;", statement)
+
+	statement, err = snippet.Expand([]string{"region=a=b"})
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM orders WHERE region = 'a=b' LIMIT ${limit};", statement)
+
+	_, err = snippet.Expand([]string{"region"})
+	require.Error(t, err)
+}
@@ -36,6 +36,11 @@ func (s *Store) authenticatedGatewayClient() ccloudv2.GatewayClientInterface {
 
 func (s *Store) ProcessLocalStatement(statement string) (*types.ProcessedStatement, *types.StatementError) {
 	defer s.persistUserProperties()
+	statement, err := substituteVariables(s.Properties, statement)
+	if err != nil {
+		return nil, err
+	}
+
 	switch statementType := parseStatementType(statement); statementType {
 	case SetStatement:
 		return processSetStatement(s.Properties, statement)
@@ -46,6 +51,12 @@ func (s *Store) ProcessLocalStatement(statement string) (*types.ProcessedStateme
 	case QuitStatement, ExitStatement:
 		s.exitApplication()
 		return nil, nil
+	case ExportStatement:
+		return nil, &types.StatementError{Message: "EXPORT can only be used in the interactive shell"}
+	case SessionsStatement:
+		return nil, &types.StatementError{Message: `\sessions can only be used in the interactive shell`}
+	case SaveStatement, SnippetsStatement, RunStatement:
+		return nil, &types.StatementError{Message: fmt.Sprintf("%s can only be used in the interactive shell", strings.ToLower(string(statementType)))}
 	default:
 		return nil, nil
 	}
@@ -65,6 +76,8 @@ func (s *Store) persistUserProperties() {
 			log.CliLogger.Errorf("error persisting user properties: %v", err)
 		}
 	}
+
+	PersistSession(s.Properties, s.appOptions)
 }
 
 func (s *Store) ProcessStatement(statement string) (*types.ProcessedStatement, *types.StatementError) {
@@ -77,6 +90,12 @@ func (s *Store) ProcessStatement(statement string) (*types.ProcessedStatement, *
 		return result, sErr
 	}
 
+	// Local statements substitute variables themselves, so variables are only substituted once they are processed
+	statement, sErr = substituteVariables(s.Properties, statement)
+	if sErr != nil {
+		return nil, sErr
+	}
+
 	statementName := s.Properties.GetOrDefault(config.KeyStatementName, types.GenerateStatementName())
 	defer s.Properties.Delete(config.KeyStatementName)
 
@@ -306,6 +325,44 @@ func (s *Store) getStatusDetail(statementObj flinkgatewayv1.SqlV1Statement) stri
 	return exceptions[0].GetMessage()
 }
 
+// GetStatementHealth polls the phase, status detail, and exceptions of an existing statement. As while waiting for a
+// pending statement, the status detail falls back to the most recent exception.
+func (s *Store) GetStatementHealth(statementName string) (*types.StatementHealth, error) {
+	client := s.authenticatedGatewayClient()
+	statementObj, err := client.GetStatement(s.appOptions.GetEnvironmentId(), statementName, s.appOptions.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+
+	exceptions, err := client.GetExceptions(s.appOptions.GetEnvironmentId(), statementName, s.appOptions.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+
+	health := &types.StatementHealth{
+		Name:          statementObj.GetName(),
+		ComputePoolId: statementObj.Spec.GetComputePoolId(),
+		Labels:        statementObj.Metadata.GetLabels(),
+		Phase:         types.PHASE(statementObj.Status.GetPhase()),
+		StatusDetail:  statementObj.Status.GetDetail(),
+		Exceptions:    make([]types.StatementException, len(exceptions)),
+	}
+	for i, exception := range exceptions {
+		health.Exceptions[i] = types.StatementException{
+			Name:      exception.GetName(),
+			Message:   exception.GetMessage(),
+			Timestamp: exception.GetTimestamp(),
+		}
+	}
+
+	// most recent exception is on top of the returned list
+	if health.StatusDetail == "" && len(health.Exceptions) > 0 {
+		health.StatusDetail = health.Exceptions[0].Message
+	}
+
+	return health, nil
+}
+
 func extractPageToken(nextUrl string) (string, error) {
 	if nextUrl == "" {
 		return "", nil
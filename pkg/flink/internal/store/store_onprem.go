@@ -25,6 +25,8 @@ type StoreOnPrem struct {
 	client           flink.CmfClientInterface
 	appOptions       *types.ApplicationOptions
 	tokenRefreshFunc func() error
+	// exceptionTimestamps holds the time at which exceptions without a valid timestamp were first seen
+	exceptionTimestamps map[string]time.Time
 }
 
 func (s *StoreOnPrem) authenticatedCmfClient() flink.CmfClientInterface {
@@ -35,6 +37,12 @@ func (s *StoreOnPrem) authenticatedCmfClient() flink.CmfClientInterface {
 }
 
 func (s *StoreOnPrem) ProcessLocalStatement(statement string) (*types.ProcessedStatement, *types.StatementError) {
+	defer PersistSession(s.Properties, s.appOptions)
+	statement, err := substituteVariables(s.Properties, statement)
+	if err != nil {
+		return nil, err
+	}
+
 	switch statementType := parseStatementType(statement); statementType {
 	case SetStatement:
 		return processSetStatement(s.Properties, statement)
@@ -45,6 +53,12 @@ func (s *StoreOnPrem) ProcessLocalStatement(statement string) (*types.ProcessedS
 	case QuitStatement, ExitStatement:
 		s.exitApplication()
 		return nil, nil
+	case ExportStatement:
+		return nil, &types.StatementError{Message: "EXPORT can only be used in the interactive shell"}
+	case SessionsStatement:
+		return nil, &types.StatementError{Message: `\sessions can only be used in the interactive shell`}
+	case SaveStatement, SnippetsStatement, RunStatement:
+		return nil, &types.StatementError{Message: fmt.Sprintf("%s can only be used in the interactive shell", strings.ToLower(string(statementType)))}
 	default:
 		return nil, nil
 	}
@@ -60,6 +74,12 @@ func (s *StoreOnPrem) ProcessStatement(statement string) (*types.ProcessedStatem
 		return result, sErr
 	}
 
+	// Local statements substitute variables themselves, so variables are only substituted once they are processed
+	statement, sErr = substituteVariables(s.Properties, statement)
+	if sErr != nil {
+		return nil, sErr
+	}
+
 	statementName := s.Properties.GetOrDefault(config.KeyStatementName, types.GenerateStatementNameForOnPrem())
 	if len(statementName) > 45 { // on-prem name length limit
 		statementName = statementName[0:45]
@@ -294,6 +314,65 @@ func (s *StoreOnPrem) getStatusDetail(statementObj cmfsdk.Statement) string {
 	return topException.GetMessage()
 }
 
+// GetStatementHealth polls the phase, status detail, and exceptions of an existing statement. As while waiting for a
+// pending statement, the status detail falls back to the most recent exception.
+func (s *StoreOnPrem) GetStatementHealth(statementName string) (*types.StatementHealth, error) {
+	client := s.authenticatedCmfClient()
+	statementObj, err := client.GetStatement(client.CmfApiContext(), s.appOptions.GetEnvironmentId(), statementName)
+	if err != nil {
+		return nil, err
+	}
+
+	exceptionList, err := client.ListStatementExceptions(client.CmfApiContext(), s.appOptions.GetEnvironmentId(), statementName)
+	if err != nil {
+		return nil, err
+	}
+	exceptions := exceptionList.GetData()
+
+	status := statementObj.GetStatus()
+	health := &types.StatementHealth{
+		Name:          statementObj.Metadata.GetName(),
+		ComputePoolId: statementObj.Spec.GetComputePoolName(),
+		Labels:        statementObj.Metadata.GetLabels(),
+		Phase:         types.PHASE(status.GetPhase()),
+		StatusDetail:  status.GetDetail(),
+		Exceptions:    make([]types.StatementException, len(exceptions)),
+	}
+	for i, exception := range exceptions {
+		health.Exceptions[i] = types.StatementException{
+			Name:      exception.GetName(),
+			Message:   exception.GetMessage(),
+			Timestamp: s.getExceptionTimestamp(statementName, exception),
+		}
+	}
+
+	// most recent exception is on top of the returned list
+	if health.StatusDetail == "" && len(health.Exceptions) > 0 {
+		health.StatusDetail = health.Exceptions[0].Message
+	}
+
+	return health, nil
+}
+
+// getExceptionTimestamp returns the time of an exception. An exception whose timestamp cannot be parsed falls back to the
+// time it was first seen, so that it is neither dropped nor reported again on the next poll.
+func (s *StoreOnPrem) getExceptionTimestamp(statementName string, exception cmfsdk.StatementException) time.Time {
+	timestamp, err := time.Parse(time.RFC3339, exception.GetTimestamp())
+	if err == nil {
+		return timestamp
+	}
+	log.CliLogger.Debugf(`Failed to parse timestamp "%s" of exception "%s": %v`, exception.GetTimestamp(), exception.GetName(), err)
+
+	if s.exceptionTimestamps == nil {
+		s.exceptionTimestamps = map[string]time.Time{}
+	}
+	key := strings.Join([]string{statementName, exception.GetName(), exception.GetMessage(), exception.GetTimestamp()}, "\x00")
+	if _, ok := s.exceptionTimestamps[key]; !ok {
+		s.exceptionTimestamps[key] = time.Now()
+	}
+	return s.exceptionTimestamps[key]
+}
+
 func NewStoreOnPrem(client flink.CmfClientInterface, exitApplication func(), userProperties types.UserPropertiesInterface, appOptions *types.ApplicationOptions, tokenRefreshFunc func() error) types.StoreInterface {
 	return &StoreOnPrem{
 		Properties:       userProperties,
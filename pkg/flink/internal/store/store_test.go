@@ -2350,6 +2350,127 @@ func (s *StoreTestSuite) TestGetStatusDetailReturnsWhenStatusNoFailedOrFailing()
 	}
 }
 
+func (s *StoreTestSuite) TestGetStatementHealth() {
+	exceptionTimestamp := time.Date(2025, 8, 5, 12, 1, 0, 0, time.UTC)
+	expected := &types.StatementHealth{
+		Name:          testStatementName,
+		ComputePoolId: "pool",
+		Phase:         types.DEGRADED,
+		StatusDetail:  "Exception 2",
+		Exceptions: []types.StatementException{
+			{Name: "exception-2", Message: "Exception 2", Timestamp: exceptionTimestamp},
+			{Name: "exception-1", Message: "Exception 1", Timestamp: exceptionTimestamp.Add(-time.Minute)},
+		},
+	}
+
+	{ // Cloud store
+		client := mock.NewMockGatewayClientInterface(gomock.NewController(s.T()))
+		store := Store{
+			Properties: NewUserPropertiesWithDefaults(map[string]string{"TestProp": "TestVal"}, map[string]string{}),
+			client:     client,
+			appOptions: &types.ApplicationOptions{
+				OrganizationId: "orgId",
+				EnvironmentId:  "envId",
+			},
+			tokenRefreshFunc: tokenRefreshFunc,
+		}
+
+		statementObj := flinkgatewayv1.SqlV1Statement{
+			Name: flinkgatewayv1.PtrString(testStatementName),
+			Spec: &flinkgatewayv1.SqlV1StatementSpec{
+				ComputePoolId: flinkgatewayv1.PtrString("pool"),
+			},
+			Status: &flinkgatewayv1.SqlV1StatementStatus{
+				Phase: "DEGRADED",
+			},
+		}
+		exceptionsResponse := []flinkgatewayv1.SqlV1StatementException{
+			{Name: flinkgatewayv1.PtrString("exception-2"), Message: flinkgatewayv1.PtrString("Exception 2"), Timestamp: flinkgatewayv1.PtrTime(exceptionTimestamp)},
+			{Name: flinkgatewayv1.PtrString("exception-1"), Message: flinkgatewayv1.PtrString("Exception 1"), Timestamp: flinkgatewayv1.PtrTime(exceptionTimestamp.Add(-time.Minute))},
+		}
+
+		client.EXPECT().GetStatement("envId", testStatementName, "orgId").Return(statementObj, nil)
+		client.EXPECT().GetExceptions("envId", testStatementName, "orgId").Return(exceptionsResponse, nil)
+
+		health, err := store.GetStatementHealth(testStatementName)
+		require.NoError(s.T(), err)
+		require.Equal(s.T(), expected, health)
+	}
+	{ // On-prem store
+		client := mock.NewMockCmfClientInterface(gomock.NewController(s.T()))
+		store := StoreOnPrem{
+			Properties: NewUserPropertiesWithDefaults(map[string]string{"TestProp": "TestVal"}, map[string]string{}),
+			client:     client,
+			appOptions: &types.ApplicationOptions{
+				EnvironmentId: "envId",
+			},
+			tokenRefreshFunc: tokenRefreshFunc,
+		}
+
+		statementObj := cmfsdk.Statement{
+			Metadata: cmfsdk.StatementMetadata{
+				Name: testStatementName,
+			},
+			Spec: cmfsdk.StatementSpec{
+				ComputePoolName: "pool",
+			},
+			Status: &cmfsdk.StatementStatus{
+				Phase: "DEGRADED",
+			},
+		}
+		exceptionsResponse := cmfsdk.StatementExceptionList{
+			Data: []cmfsdk.StatementException{
+				{Name: "exception-2", Message: "Exception 2", Timestamp: "2025-08-05T12:01:00Z"},
+				{Name: "exception-1", Message: "Exception 1", Timestamp: "2025-08-05T12:00:00Z"},
+			},
+		}
+
+		client.EXPECT().CmfApiContext().Return(context.Background()).Times(2)
+		client.EXPECT().GetStatement(context.Background(), "envId", testStatementName).Return(statementObj, nil)
+		client.EXPECT().ListStatementExceptions(context.Background(), "envId", testStatementName).Return(exceptionsResponse, nil)
+
+		health, err := store.GetStatementHealth(testStatementName)
+		require.NoError(s.T(), err)
+		require.Equal(s.T(), expected, health)
+	}
+}
+
+func (s *StoreTestSuite) TestGetStatementHealthOnPremInvalidExceptionTimestamp() {
+	client := mock.NewMockCmfClientInterface(gomock.NewController(s.T()))
+	store := StoreOnPrem{
+		Properties: NewUserPropertiesWithDefaults(map[string]string{"TestProp": "TestVal"}, map[string]string{}),
+		client:     client,
+		appOptions: &types.ApplicationOptions{
+			EnvironmentId: "envId",
+		},
+		tokenRefreshFunc: tokenRefreshFunc,
+	}
+
+	statementObj := cmfsdk.Statement{
+		Metadata: cmfsdk.StatementMetadata{Name: testStatementName},
+		Status:   &cmfsdk.StatementStatus{Phase: "DEGRADED"},
+	}
+	exceptionsResponse := cmfsdk.StatementExceptionList{
+		Data: []cmfsdk.StatementException{
+			{Name: "exception-1", Message: "Exception 1", Timestamp: "2025-08-05 12:00:00"},
+		},
+	}
+
+	client.EXPECT().CmfApiContext().Return(context.Background()).Times(4)
+	client.EXPECT().GetStatement(context.Background(), "envId", testStatementName).Return(statementObj, nil).Times(2)
+	client.EXPECT().ListStatementExceptions(context.Background(), "envId", testStatementName).Return(exceptionsResponse, nil).Times(2)
+
+	// The exception falls back to the time it was first seen, and keeps it on the next poll
+	health, err := store.GetStatementHealth(testStatementName)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), health.Exceptions, 1)
+	require.False(s.T(), health.Exceptions[0].Timestamp.IsZero())
+
+	next, err := store.GetStatementHealth(testStatementName)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), health.Exceptions, next.Exceptions)
+}
+
 func (s *StoreTestSuite) TestGetStatusDetailReturnsWhenStatusDetailFilled() {
 	{ // Cloud store
 		client := mock.NewMockGatewayClientInterface(gomock.NewController(s.T()))
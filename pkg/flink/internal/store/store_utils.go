@@ -3,7 +3,9 @@ package store
 import (
 	"bytes"
 	"fmt"
+	"os"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -12,18 +14,34 @@ import (
 	"github.com/texttheater/golang-levenshtein/levenshtein"
 
 	"github.com/confluentinc/cli/v4/pkg/flink/config"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/results"
+	"github.com/confluentinc/cli/v4/pkg/flink/internal/variables"
 	"github.com/confluentinc/cli/v4/pkg/flink/types"
+	"github.com/confluentinc/cli/v4/pkg/log"
 )
 
 type StatementType string
 
 const (
-	SetStatement   StatementType = config.OpSet
-	UseStatement   StatementType = config.OpUse
-	ResetStatement StatementType = config.OpReset
-	ExitStatement  StatementType = config.OpExit
-	QuitStatement  StatementType = config.OpQuit
-	OtherStatement StatementType = "OTHER"
+	SetStatement      StatementType = config.OpSet
+	UseStatement      StatementType = config.OpUse
+	ResetStatement    StatementType = config.OpReset
+	ExitStatement     StatementType = config.OpExit
+	QuitStatement     StatementType = config.OpQuit
+	ExportStatement   StatementType = config.OpExport
+	SessionsStatement StatementType = config.OpSessions
+	SaveStatement     StatementType = config.OpSave
+	SnippetsStatement StatementType = config.OpSnippets
+	RunStatement      StatementType = config.OpRun
+	OtherStatement    StatementType = "OTHER"
+)
+
+var (
+	exportStatementRegex   = regexp.MustCompile(`(?i)^EXPORT\s+TO\s+'((?:[^']|'')+)'$`)
+	sessionsStatementRegex = regexp.MustCompile(`(?i)^\\SESSIONS(?:\s+USE\s+(\S+))?$`)
+	saveStatementRegex     = regexp.MustCompile(`(?i)^\\SAVE\s+(\S+)$`)
+	snippetsStatementRegex = regexp.MustCompile(`(?i)^\\SNIPPETS$`)
+	runStatementRegex      = regexp.MustCompile(`(?i)^\\RUN\s+(\S+)((?:\s+\S+)*)$`)
 )
 
 func createStatementResults(columnNames []string, rows [][]string) *types.StatementResults {
@@ -82,6 +100,24 @@ func processSetStatement(properties types.UserPropertiesInterface, statement str
 		}
 	}
 
+	if configKey == config.KeyOutputFile {
+		if err := results.ValidateExportFile(configVal); err != nil {
+			return nil, &types.StatementError{
+				Message:    fmt.Sprintf(`invalid output file for "%s": %v`, config.KeyOutputFile, err),
+				Suggestion: fmt.Sprintf(`please provide a file ending in one of %s`, strings.Join(results.ExportFileExtensions, ", ")),
+			}
+		}
+	}
+
+	if configKey == config.KeySnippetsPath {
+		if info, err := os.Stat(configVal); err != nil || !info.IsDir() {
+			return nil, &types.StatementError{
+				Message:    fmt.Sprintf(`invalid snippets directory for "%s": %s`, config.KeySnippetsPath, configVal),
+				Suggestion: `please provide the path of an existing directory of ".sql" files`,
+			}
+		}
+	}
+
 	properties.Set(configKey, configVal)
 	return &types.ProcessedStatement{
 		Kind:                 config.OpSet,
@@ -346,6 +382,107 @@ func appendToTokens(tokens []string, buffer *bytes.Buffer) []string {
 	return tokens
 }
 
+// SplitSQLStatements splits a SQL script into statements at each statement terminator.
+// Terminators inside string literals, quoted identifiers, and EXECUTE STATEMENT SET blocks are ignored, comments
+// other than optimizer hints are removed, and each statement keeps its terminator so it is processed like a
+// statement typed into the shell.
+func SplitSQLStatements(script string) []string {
+	var statements []string
+	var buffer bytes.Buffer
+	var quote rune
+	var inLineComment, inBlockComment, inHint, hasContent bool
+	input := []rune(script)
+
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		var next rune
+		if i+1 < len(input) {
+			next = input[i+1]
+		}
+
+		switch {
+		case inLineComment:
+			if c == '\n' {
+				inLineComment = false
+				buffer.WriteRune(c)
+			}
+		case inBlockComment:
+			if c == '*' && next == '/' {
+				i++
+				inBlockComment = false
+				if inHint {
+					inHint = false
+					buffer.WriteString("*/")
+				} else {
+					buffer.WriteRune(' ')
+				}
+			} else if inHint {
+				buffer.WriteRune(c)
+			}
+		case quote != 0:
+			buffer.WriteRune(c)
+			if c == quote {
+				// escaped quote
+				if next == quote {
+					i++
+					buffer.WriteRune(next)
+					continue
+				}
+				quote = 0
+			}
+		case c == '-' && next == '-':
+			inLineComment = true
+		case c == '/' && next == '*':
+			i++
+			inBlockComment = true
+			// optimizer hints such as /*+ OPTIONS(...) */ are part of the statement
+			if i+1 < len(input) && input[i+1] == '+' {
+				inHint = true
+				buffer.WriteString("/*")
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+			hasContent = true
+			buffer.WriteRune(c)
+		case string(c) == config.StatementTerminator && IsInStatementSet(buffer.String()):
+			buffer.WriteRune(c)
+		case string(c) == config.StatementTerminator:
+			if hasContent {
+				statements = append(statements, strings.TrimSpace(buffer.String())+config.StatementTerminator)
+			}
+			buffer.Reset()
+			hasContent = false
+		default:
+			if !unicode.IsSpace(c) {
+				hasContent = true
+			}
+			buffer.WriteRune(c)
+		}
+	}
+
+	if hasContent {
+		statements = append(statements, strings.TrimSpace(buffer.String()))
+	}
+
+	return statements
+}
+
+// IsInStatementSet checks whether a statement is an EXECUTE STATEMENT SET block which has not been closed with END yet,
+// since the statements inside of the block are submitted together with it.
+func IsInStatementSet(statement string) bool {
+	tokens := TokenizeSQL(statement)
+	if len(tokens) < 4 {
+		return false
+	}
+
+	for i, keyword := range []string{"EXECUTE", "STATEMENT", "SET", "BEGIN"} {
+		if strings.ToUpper(tokens[i]) != keyword {
+			return false
+		}
+	}
+	return strings.ToUpper(tokens[len(tokens)-1]) != "END"
+}
+
 /*
 Expected statement: "USE CATALOG `catalog_name`" or "USE `database_name` or "USE `catalog_name`.`database_name`"
 Returns the catalog and database extracted if the present, otherwise returns an error
@@ -397,6 +534,179 @@ func useError() *types.StatementError {
 	}
 }
 
+// IsExportStatement returns whether the statement exports the results of the previous statement to a file.
+func IsExportStatement(statement string) bool {
+	return parseStatementType(strings.TrimSpace(statement)) == ExportStatement
+}
+
+/*
+Expected statement: "EXPORT TO 'results.csv'"
+Returns the path of the file to export the results to, otherwise returns an error
+*/
+func ParseExportStatement(statement string) (string, *types.StatementError) {
+	statement = strings.TrimSpace(removeStatementTerminator(strings.TrimSpace(statement)))
+
+	matches := exportStatementRegex.FindStringSubmatch(statement)
+	if matches == nil {
+		return "", &types.StatementError{
+			Message: "invalid syntax for EXPORT",
+			Usage:   []string{"EXPORT TO 'results.csv'", "EXPORT TO 'results.jsonl'", "EXPORT TO 'results.parquet'"},
+		}
+	}
+
+	path := strings.ReplaceAll(matches[1], "''", "'")
+	if err := results.ValidateExportFile(path); err != nil {
+		return "", &types.StatementError{Message: err.Error()}
+	}
+	return path, nil
+}
+
+// PersistSession saves the properties to the named session of the shell, if there is one
+func PersistSession(properties types.UserPropertiesInterface, appOptions *types.ApplicationOptions) {
+	session := appOptions.GetSession()
+	if session == nil {
+		return
+	}
+
+	session.Properties = properties.GetPersistableProperties()
+	if err := session.Save(); err != nil {
+		log.CliLogger.Errorf("error persisting session %s: %v", session.Name, err)
+	}
+}
+
+// IsSessionsStatement returns whether the statement lists or switches the named sessions of the shell.
+func IsSessionsStatement(statement string) bool {
+	return parseStatementType(strings.TrimSpace(statement)) == SessionsStatement
+}
+
+/*
+Expected statement: "\sessions" or "\sessions use etl-dev"
+Returns the name of the session to switch to, or an empty string if the sessions should be listed, otherwise returns an error
+*/
+func ParseSessionsStatement(statement string) (string, *types.StatementError) {
+	statement = strings.TrimSpace(removeStatementTerminator(strings.TrimSpace(statement)))
+
+	matches := sessionsStatementRegex.FindStringSubmatch(statement)
+	if matches == nil {
+		return "", &types.StatementError{
+			Message: `invalid syntax for \sessions`,
+			Usage:   []string{`\sessions`, `\sessions use etl-dev`},
+		}
+	}
+	return matches[1], nil
+}
+
+// IsSnippetStatement returns whether the statement saves, lists, or runs the saved snippets of the shell.
+func IsSnippetStatement(statement string) bool {
+	switch parseStatementType(strings.TrimSpace(statement)) {
+	case SaveStatement, SnippetsStatement, RunStatement:
+		return true
+	default:
+		return false
+	}
+}
+
+/*
+Expected statement: "\save orders_by_region"
+Returns the name to save the last statement as, otherwise returns an error
+*/
+func ParseSaveStatement(statement string) (string, *types.StatementError) {
+	statement = strings.TrimSpace(removeStatementTerminator(strings.TrimSpace(statement)))
+
+	matches := saveStatementRegex.FindStringSubmatch(statement)
+	if matches == nil {
+		return "", &types.StatementError{
+			Message: `invalid syntax for \save`,
+			Usage:   []string{`\save orders_by_region`},
+		}
+	}
+	return matches[1], nil
+}
+
+/*
+Expected statement: "\snippets"
+Returns an error if the statement has arguments
+*/
+func ParseSnippetsStatement(statement string) *types.StatementError {
+	statement = strings.TrimSpace(removeStatementTerminator(strings.TrimSpace(statement)))
+
+	if !snippetsStatementRegex.MatchString(statement) {
+		return &types.StatementError{
+			Message: `invalid syntax for \snippets`,
+			Usage:   []string{`\snippets`},
+		}
+	}
+	return nil
+}
+
+/*
+Expected statement: "\run orders_by_region" or "\run orders_by_region region=us-east limit=10"
+Returns the name of the snippet to run and its "name=value" arguments, otherwise returns an error
+*/
+func ParseRunStatement(statement string) (string, []string, *types.StatementError) {
+	statement = strings.TrimSpace(removeStatementTerminator(strings.TrimSpace(statement)))
+
+	matches := runStatementRegex.FindStringSubmatch(statement)
+	if matches == nil {
+		return "", nil, &types.StatementError{
+			Message: `invalid syntax for \run`,
+			Usage:   []string{`\run orders_by_region`, `\run orders_by_region region=us-east limit=10`},
+		}
+	}
+	return matches[1], strings.Fields(matches[2]), nil
+}
+
+// getVariables returns the variables set with SET 'client.var.<name>'='<value>', or with the --var and --var-file flags
+func getVariables(properties types.UserPropertiesInterface) map[string]string {
+	vars := map[string]string{}
+	for key, value := range properties.GetProperties() {
+		if name, ok := strings.CutPrefix(key, config.KeyVariables); ok {
+			vars[name] = value
+		}
+	}
+	return vars
+}
+
+// substituteVariables replaces the ${name} variables of a statement, and fails if any of them is not set
+func substituteVariables(properties types.UserPropertiesInterface, statement string) (string, *types.StatementError) {
+	statement, unresolved := variables.Substitute(statement, getVariables(properties))
+	if len(unresolved) > 0 {
+		return "", unresolvedVariablesError(unresolved)
+	}
+	return statement, nil
+}
+
+func unresolvedVariablesError(unresolved []string) *types.StatementError {
+	return &types.StatementError{
+		Message:    fmt.Sprintf("unresolved variables: %s", variables.Format(unresolved)),
+		Suggestion: fmt.Sprintf(`please set them with "SET '%s<name>'='<value>'", "--var", "--var-file", or environment variables`, config.KeyVariables),
+	}
+}
+
+// GetUnresolvedScriptVariables returns the variables of a SQL script which are not set, taking into account the
+// variables set by the SET statements of the script itself, so that they can be reported before any statement runs.
+func GetUnresolvedScriptVariables(properties types.UserPropertiesInterface, statements []string) []string {
+	vars := getVariables(properties)
+	var unresolved []string
+	for _, statement := range statements {
+		statement, statementUnresolved := variables.Substitute(strings.TrimSpace(statement), vars)
+		for _, name := range statementUnresolved {
+			if !slices.Contains(unresolved, name) {
+				unresolved = append(unresolved, name)
+			}
+		}
+
+		if parseStatementType(statement) == SetStatement {
+			if key, value, err := parseSetStatement(statement); err == nil {
+				if name, ok := strings.CutPrefix(key, config.KeyVariables); ok {
+					vars[name] = value
+				}
+			}
+		}
+	}
+	return unresolved
+}
+
 /* Expected statement: "RESET 'pipeline.name'" */
 func parseResetStatement(statement string) (string, error) {
 	statement = removeStatementTerminator(statement)
@@ -483,7 +793,7 @@ func removeStatementTerminator(s string) string {
 
 func statementStartsWithOp(statement, op string) bool {
 	cleanedStatement := strings.ToUpper(statement)
-	pattern := fmt.Sprintf("^%s\\b", op)
+	pattern := fmt.Sprintf("^%s\\b", regexp.QuoteMeta(op))
 	startsWithOp, _ := regexp.MatchString(pattern, cleanedStatement)
 	return startsWithOp
 }
@@ -499,6 +809,16 @@ func parseStatementType(statement string) StatementType {
 		return ExitStatement
 	} else if statementStartsWithOp(statement, string(QuitStatement)) {
 		return QuitStatement
+	} else if statementStartsWithOp(statement, string(ExportStatement)) {
+		return ExportStatement
+	} else if statementStartsWithOp(statement, string(SessionsStatement)) {
+		return SessionsStatement
+	} else if statementStartsWithOp(statement, string(SaveStatement)) {
+		return SaveStatement
+	} else if statementStartsWithOp(statement, string(SnippetsStatement)) {
+		return SnippetsStatement
+	} else if statementStartsWithOp(statement, string(RunStatement)) {
+		return RunStatement
 	} else {
 		return OtherStatement
 	}
@@ -108,6 +108,14 @@ func TestProcessSetStatement(t *testing.T) {
 		}, err)
 	})
 
+	t.Run("should fail if user wants to set an unsupported output file", func(t *testing.T) {
+		_, err := processSetStatement(s.Properties, fmt.Sprintf("set '%s'='%s'", config.KeyOutputFile, "results.txt"))
+		assert.Equal(t, &types.StatementError{
+			Message:    `invalid output file for "client.output-file": unsupported file extension ".txt", must be one of .csv, .jsonl, .parquet`,
+			Suggestion: "please provide a file ending in one of .csv, .jsonl, .parquet",
+		}, err)
+	})
+
 	t.Run("should parse and identify sensitive set statement", func(t *testing.T) {
 		result, err := processSetStatement(s.Properties, "set 'sql.secrets.openai' = 'mysecret'")
 		assert.Nil(t, err)
@@ -308,9 +316,127 @@ func TestParseStatementType(t *testing.T) {
 	require.Equal(t, ExitStatement, parseStatementType("exit;"))
 	require.Equal(t, QuitStatement, parseStatementType("quit;"))
 	require.Equal(t, QuitStatement, parseStatementType("quit"))
+	require.Equal(t, ExportStatement, parseStatementType("export to 'results.csv';"))
+	require.Equal(t, SessionsStatement, parseStatementType(`\sessions use etl-dev`))
+	require.Equal(t, SaveStatement, parseStatementType(`\save orders_by_region`))
+	require.Equal(t, SnippetsStatement, parseStatementType(`\snippets`))
+	require.Equal(t, RunStatement, parseStatementType(`\run orders_by_region region=us-east`))
+	require.Equal(t, OtherStatement, parseStatementType("sessions"))
 	require.Equal(t, OtherStatement, parseStatementType("Some other statement"))
 }
 
+func TestParseExportStatement(t *testing.T) {
+	tests := []struct {
+		statement string
+		path      string
+		err       string
+	}{
+		{statement: "EXPORT TO 'results.csv';", path: "results.csv"},
+		{statement: "export   to '/tmp/my results.JSONL' ;", path: "/tmp/my results.JSONL"},
+		{statement: "EXPORT TO 'it''s.parquet'", path: "it's.parquet"},
+		{statement: "EXPORT 'results.csv';", err: "invalid syntax for EXPORT"},
+		{statement: "EXPORT TO results.csv;", err: "invalid syntax for EXPORT"},
+		{statement: "EXPORT TO 'results.txt';", err: `unsupported file extension ".txt", must be one of .csv, .jsonl, .parquet`},
+	}
+
+	for _, test := range tests {
+		path, err := ParseExportStatement(test.statement)
+		if test.err != "" {
+			require.NotNil(t, err)
+			require.Equal(t, test.err, err.Message)
+		} else {
+			require.Nil(t, err)
+			require.Equal(t, test.path, path)
+		}
+	}
+}
+
+func TestParseSessionsStatement(t *testing.T) {
+	tests := []struct {
+		statement string
+		name      string
+		err       string
+	}{
+		{statement: `\sessions`},
+		{statement: `\SESSIONS;`},
+		{statement: `\sessions use etl-dev`, name: "etl-dev"},
+		{statement: `\sessions  USE  etl-dev ;`, name: "etl-dev"},
+		{statement: `\sessions etl-dev`, err: `invalid syntax for \sessions`},
+		{statement: `\sessions use etl dev`, err: `invalid syntax for \sessions`},
+	}
+
+	for _, test := range tests {
+		name, err := ParseSessionsStatement(test.statement)
+		if test.err != "" {
+			require.NotNil(t, err)
+			require.Equal(t, test.err, err.Message)
+		} else {
+			require.Nil(t, err)
+			require.Equal(t, test.name, name)
+		}
+	}
+}
+
+func TestParseSnippetStatements(t *testing.T) {
+	require.True(t, IsSnippetStatement(`\save orders_by_region`))
+	require.True(t, IsSnippetStatement(` \SNIPPETS;`))
+	require.True(t, IsSnippetStatement(`\run orders_by_region`))
+	require.False(t, IsSnippetStatement(`\sessions`))
+
+	name, err := ParseSaveStatement(`\SAVE orders_by_region;`)
+	require.Nil(t, err)
+	require.Equal(t, "orders_by_region", name)
+	_, err = ParseSaveStatement(`\save`)
+	require.Equal(t, `invalid syntax for \save`, err.Message)
+
+	require.Nil(t, ParseSnippetsStatement(`\snippets;`))
+	require.Equal(t, `invalid syntax for \snippets`, ParseSnippetsStatement(`\snippets orders`).Message)
+
+	name, args, err := ParseRunStatement(`\run orders_by_region  region=us-east limit=10;`)
+	require.Nil(t, err)
+	require.Equal(t, "orders_by_region", name)
+	require.Equal(t, []string{"region=us-east", "limit=10"}, args)
+
+	name, args, err = ParseRunStatement(`\run orders_by_region`)
+	require.Nil(t, err)
+	require.Equal(t, "orders_by_region", name)
+	require.Empty(t, args)
+
+	_, _, err = ParseRunStatement(`\run`)
+	require.Equal(t, `invalid syntax for \run`, err.Message)
+}
+
+func TestSubstituteVariables(t *testing.T) {
+	appOptions := &types.ApplicationOptions{Variables: map[string]string{"topic": "orders-v2"}}
+	properties := NewUserProperties(appOptions)
+	properties.Set(config.KeyVariables+"catalog", "prod")
+
+	statement, err := substituteVariables(properties, "INSERT INTO `${catalog}`.`db`.`${topic}` SELECT 1;")
+	require.Nil(t, err)
+	require.Equal(t, "INSERT INTO `prod`.`db`.`orders-v2` SELECT 1;", statement)
+
+	_, err = substituteVariables(properties, "SELECT * FROM `${database}`.`${table}`;")
+	require.NotNil(t, err)
+	require.Equal(t, "unresolved variables: ${database}, ${table}", err.Message)
+}
+
+func TestGetUnresolvedScriptVariables(t *testing.T) {
+	properties := NewUserProperties(&types.ApplicationOptions{Variables: map[string]string{"catalog": "prod"}})
+
+	statements := []string{
+		"SET 'client.var.topic' = 'orders-v2';",
+		"INSERT INTO `${catalog}`.`db`.`${topic}` SELECT 1;",
+	}
+	require.Empty(t, GetUnresolvedScriptVariables(properties, statements))
+
+	statements = []string{
+		"INSERT INTO `${catalog}`.`db`.`${topic}` SELECT 1;",
+		"SET 'client.var.topic' = 'orders-v2';",
+		"SELECT * FROM `${table}`;",
+	}
+	require.Equal(t, []string{"topic", "table"}, GetUnresolvedScriptVariables(properties, statements))
+}
+
 func hoursToSeconds(hours float32) int {
 	return int(hours * 60 * 60)
 }
@@ -538,6 +664,61 @@ func TestTokenizeSQLSpecialCharacters(t *testing.T) {
 	require.Equal(expected, TokenizeSQL(input))
 }
 
+func TestSplitSQLStatements(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "single statement",
+			input:    "SELECT 1;",
+			expected: []string{"SELECT 1;"},
+		},
+		{
+			name:     "multiple statements across lines",
+			input:    "SET 'sql.local-time-zone' = 'UTC';\nUSE `my_db`;\n\nCREATE TABLE t (\n  id INT\n);\n",
+			expected: []string{"SET 'sql.local-time-zone' = 'UTC';", "USE `my_db`;", "CREATE TABLE t (\n  id INT\n);"},
+		},
+		{
+			name:     "terminators inside quotes",
+			input:    "INSERT INTO t VALUES ('a;b', 'it''s;');SELECT `x;y` FROM \"t;\";",
+			expected: []string{"INSERT INTO t VALUES ('a;b', 'it''s;');", "SELECT `x;y` FROM \"t;\";"},
+		},
+		{
+			name:     "comments are removed",
+			input:    "-- create the table;\nCREATE TABLE t (id INT); /* drop it; later */\n-- trailing comment",
+			expected: []string{"CREATE TABLE t (id INT);"},
+		},
+		{
+			name:     "optimizer hints are kept",
+			input:    "SELECT /*+ OPTIONS('scan.startup.mode'='earliest-offset') */ * FROM t /* all rows; */;",
+			expected: []string{"SELECT /*+ OPTIONS('scan.startup.mode'='earliest-offset') */ * FROM t;"},
+		},
+		{
+			name:     "statement set is one statement",
+			input:    "EXECUTE STATEMENT SET\nBEGIN\n  INSERT INTO a SELECT * FROM t;\n  INSERT INTO b SELECT * FROM t;\nEND;\nSELECT 1;",
+			expected: []string{"EXECUTE STATEMENT SET\nBEGIN\n  INSERT INTO a SELECT * FROM t;\n  INSERT INTO b SELECT * FROM t;\nEND;", "SELECT 1;"},
+		},
+		{
+			name:     "last statement without terminator",
+			input:    "SELECT 1;\nSELECT 2",
+			expected: []string{"SELECT 1;", "SELECT 2"},
+		},
+		{
+			name:     "empty statements are dropped",
+			input:    " ;; \n ; ",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, SplitSQLStatements(tt.input))
+		})
+	}
+}
+
 func TestGetSubstringUpToSecondDot(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -37,12 +37,26 @@ func getInitialProperties(appOptions *types.ApplicationOptions) map[string]strin
 	if appOptions.GetDatabase() != "" {
 		properties[config.KeyDatabase] = appOptions.GetDatabase()
 	}
+	for name, value := range appOptions.GetVariables() {
+		properties[config.KeyVariables+name] = value
+	}
 
 	return properties
 }
 
 func NewUserProperties(appOptions *types.ApplicationOptions) types.UserPropertiesInterface {
-	return NewUserPropertiesWithDefaults(getDefaultProperties(appOptions), getInitialProperties(appOptions))
+	userProperties := NewUserPropertiesWithDefaults(getDefaultProperties(appOptions), getInitialProperties(appOptions))
+
+	// The catalog, database, and service account of a named session are resolved along with their flags
+	if session := appOptions.GetSession(); session != nil {
+		for key, value := range session.Properties {
+			if key != config.KeyCatalog && key != config.KeyDatabase && key != config.KeyServiceAccount {
+				userProperties.Set(key, value)
+			}
+		}
+	}
+
+	return userProperties
 }
 
 // NewUserPropertiesWithDefaults add initial props
@@ -111,6 +125,18 @@ func (p *UserProperties) GetMaskedNonLocalProperties() map[string]string {
 	return maskedProperties
 }
 
+// GetPersistableProperties returns the properties that are saved to a named session, which excludes sensitive values and
+// variables, since variables may hold secrets and are given again with each run of the shell
+func (p *UserProperties) GetPersistableProperties() map[string]string {
+	properties := map[string]string{}
+	for key, value := range p.properties {
+		if !hasSensitiveKey(key) && !strings.HasPrefix(key, config.KeyVariables) {
+			properties[key] = value
+		}
+	}
+	return properties
+}
+
 func (p *UserProperties) Delete(key string) {
 	defaultValue, isDefaultKey := p.defaultProperties[key]
 	if isDefaultKey {
@@ -197,3 +197,14 @@ func (s *UserPropertiesTestSuite) TestShouldReturnMaskedProperties() {
 	expectedProperties[secretKey] = "hidden"
 	require.Equal(s.T(), expectedProperties, s.userProperties.GetMaskedNonLocalProperties())
 }
+
+func (s *UserPropertiesTestSuite) TestGetPersistablePropertiesExcludesSecretsAndVariables() {
+	s.userProperties.Set(config.KeyCatalog, "test-catalog")
+	s.userProperties.Set(config.KeySqlSecrets+"api_key_1", "my-apy-key-value")
+	s.userProperties.Set(config.KeyVariables+"password", "my-password")
+
+	require.Equal(s.T(), map[string]string{
+		"default-key":     "default-value",
+		config.KeyCatalog: "test-catalog",
+	}, s.userProperties.GetPersistableProperties())
+}
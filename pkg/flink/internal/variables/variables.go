@@ -0,0 +1,100 @@
+package variables
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/confluentinc/cli/v4/pkg/properties"
+)
+
+// Matches ${name}, and $${name} which escapes it
+var variableRegex = regexp.MustCompile(`\$?\$\{([a-zA-Z_][a-zA-Z0-9_.-]*)\}`)
+
+// Substitute replaces each ${name} in a SQL script with the value of the variable, or of the environment variable of the
+// same name if the variable is not set. "$${name}" is kept as a literal "${name}". It returns the names of the
+// variables which are set neither way, in the order they first appear.
+func Substitute(sql string, vars map[string]string) (string, []string) {
+	var unresolved []string
+	sql = variableRegex.ReplaceAllStringFunc(sql, func(match string) string {
+		if strings.HasPrefix(match, "$$") {
+			return match[1:]
+		}
+
+		name := match[2 : len(match)-1]
+		if value, ok := vars[name]; ok {
+			return value
+		}
+		if value, ok := os.LookupEnv(name); ok {
+			return value
+		}
+
+		if !slices.Contains(unresolved, name) {
+			unresolved = append(unresolved, name)
+		}
+		return match
+	})
+	return sql, unresolved
+}
+
+// Expand replaces each ${name} in a SQL script whose variable is set, and keeps the others as they are, so that they
+// can be substituted later. Escaped variables are kept as they are too.
+func Expand(sql string, vars map[string]string) string {
+	return variableRegex.ReplaceAllStringFunc(sql, func(match string) string {
+		if strings.HasPrefix(match, "$$") {
+			return match
+		}
+		if value, ok := vars[match[2:len(match)-1]]; ok {
+			return value
+		}
+		return match
+	})
+}
+
+// Names returns the names of the variables in a SQL script, in the order they first appear.
+func Names(sql string) []string {
+	var names []string
+	for _, match := range variableRegex.FindAllStringSubmatch(sql, -1) {
+		if !strings.HasPrefix(match[0], "$$") && !slices.Contains(names, match[1]) {
+			names = append(names, match[1])
+		}
+	}
+	return names
+}
+
+// ReadFile reads variables from an env file of "name=value" lines. Empty lines, comments, "export" prefixes, and quotes
+// around values are ignored.
+func ReadFile(path string) (map[string]string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	vars := map[string]string{}
+	for _, line := range properties.ParseLines(string(b)) {
+		name, value, ok := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+		if !ok {
+			return nil, fmt.Errorf(`failed to parse "name=value" pattern from variable file "%s": %s`, path, line)
+		}
+		vars[strings.TrimSpace(name)] = unquote(strings.TrimSpace(value))
+	}
+	return vars, nil
+}
+
+// Format returns the names of variables as they are written in SQL, such as "${topic}, ${catalog}".
+func Format(names []string) string {
+	formatted := make([]string, len(names))
+	for i, name := range names {
+		formatted[i] = fmt.Sprintf("${%s}", name)
+	}
+	return strings.Join(formatted, ", ")
+}
+
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
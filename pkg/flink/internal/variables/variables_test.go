@@ -0,0 +1,98 @@
+package variables
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubstitute(t *testing.T) {
+	t.Setenv("FLINK_TEST_CATALOG", "prod")
+
+	tests := []struct {
+		name       string
+		sql        string
+		vars       map[string]string
+		expected   string
+		unresolved []string
+	}{
+		{
+			name:     "no variables",
+			sql:      "SELECT * FROM orders;",
+			expected: "SELECT * FROM orders;",
+		},
+		{
+			name:     "variables and environment variables",
+			sql:      "INSERT INTO `${FLINK_TEST_CATALOG}`.`${db}`.`${topic}` SELECT * FROM `${topic}_raw`;",
+			vars:     map[string]string{"db": "orders", "topic": "orders-v2"},
+			expected: "INSERT INTO `prod`.`orders`.`orders-v2` SELECT * FROM `orders-v2_raw`;",
+		},
+		{
+			name:     "variables take precedence over environment variables",
+			sql:      "USE CATALOG `${FLINK_TEST_CATALOG}`;",
+			vars:     map[string]string{"FLINK_TEST_CATALOG": "dev"},
+			expected: "USE CATALOG `dev`;",
+		},
+		{
+			name:     "escaped variable",
+			sql:      "SELECT '$${topic}', '${topic}';",
+			vars:     map[string]string{"topic": "orders"},
+			expected: "SELECT '${topic}', 'orders';",
+		},
+		{
+			name:       "unresolved variables",
+			sql:        "SELECT * FROM `${catalog}`.`${db}`.orders WHERE region = '${region}' AND id > ${min.id};",
+			vars:       map[string]string{"db": "sales"},
+			expected:   "SELECT * FROM `${catalog}`.`sales`.orders WHERE region = '${region}' AND id > ${min.id};",
+			unresolved: []string{"catalog", "region", "min.id"},
+		},
+		{
+			name:       "unresolved variable is listed once",
+			sql:        "SELECT '${region}', '${region}';",
+			expected:   "SELECT '${region}', '${region}';",
+			unresolved: []string{"region"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sql, unresolved := Substitute(test.sql, test.vars)
+			require.Equal(t, test.expected, sql)
+			require.Equal(t, test.unresolved, unresolved)
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	sql := "SELECT * FROM `${topic}` WHERE region = '${region}' AND note <> '$${topic}';"
+	require.Equal(t, "SELECT * FROM `orders` WHERE region = '${region}' AND note <> '$${topic}';", Expand(sql, map[string]string{"topic": "orders"}))
+}
+
+func TestNames(t *testing.T) {
+	require.Equal(t, []string{"topic", "min.id"}, Names("SELECT * FROM `${topic}` WHERE id > ${min.id} AND name <> '$${name}' OR ${topic} IS NULL;"))
+	require.Empty(t, Names("SELECT 1;"))
+}
+
+func TestReadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prod.env")
+	contents := "# Production\n" +
+		"catalog=prod\n" +
+		"export topic = \"orders-v2\"\n" +
+		"\n" +
+		"filter='a=b'\n"
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0600))
+
+	vars, err := ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"catalog": "prod", "topic": "orders-v2", "filter": "a=b"}, vars)
+
+	require.NoError(t, os.WriteFile(path, []byte("catalog\n"), 0600))
+	_, err = ReadFile(path)
+	require.Error(t, err)
+}
+
+func TestFormat(t *testing.T) {
+	require.Equal(t, "${catalog}, ${min.id}", Format([]string{"catalog", "min.id"}))
+}
@@ -0,0 +1,189 @@
+package session
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/confluentinc/cli/v4/pkg/flink/config"
+)
+
+const (
+	directory       = "flink_sessions"
+	directoryOnPrem = "flink_sessions_onprem"
+	sessionFilename = "session.json"
+	historyFilename = "history.json"
+)
+
+var nameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+
+// Session is a named Flink shell session, whose properties, compute pool, and statement history are restored when the
+// shell is started with the same session name in the same organization and environment.
+type Session struct {
+	Name          string            `json:"name"`
+	ComputePoolId string            `json:"compute_pool_id,omitempty"`
+	Properties    map[string]string `json:"properties,omitempty"`
+	LastUsedAt    time.Time         `json:"last_used_at"`
+	path          string
+}
+
+// ValidateName checks that a session name can be used as the name of its directory.
+func ValidateName(name string) error {
+	if !nameRegex.MatchString(name) {
+		return fmt.Errorf(`invalid session name "%s": session names may only contain letters, digits, ".", "_", and "-"`, name)
+	}
+	return nil
+}
+
+// Load returns the session with the given name in the organization and environment, or a new, empty session if it has
+// not been saved yet. The organization is empty for the on-prem shell.
+func Load(name, organizationId, environmentId string, onPrem bool) (*Session, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+
+	sessionsPath, err := getSessionsPath(organizationId, environmentId, onPrem)
+	if err != nil {
+		return nil, err
+	}
+
+	session := &Session{
+		Name:       name,
+		Properties: map[string]string{},
+		path:       filepath.Join(sessionsPath, name),
+	}
+
+	b, err := os.ReadFile(session.getSessionPath())
+	if errors.Is(err, os.ErrNotExist) {
+		return session, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf(`failed to read session "%s": %w`, name, err)
+	}
+
+	if err := json.Unmarshal(b, session); err != nil {
+		return nil, fmt.Errorf(`failed to parse session "%s": %w`, name, err)
+	}
+	if session.Properties == nil {
+		session.Properties = map[string]string{}
+	}
+	session.Name = name
+
+	return session, nil
+}
+
+// List returns all saved sessions of the organization and environment, sorted by name.
+func List(organizationId, environmentId string, onPrem bool) ([]*Session, error) {
+	sessionsPath, err := getSessionsPath(organizationId, environmentId, onPrem)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(sessionsPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var sessions []*Session
+	for _, entry := range entries {
+		if !entry.IsDir() || ValidateName(entry.Name()) != nil {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(sessionsPath, entry.Name(), sessionFilename)); err != nil {
+			continue
+		}
+
+		session, err := Load(entry.Name(), organizationId, environmentId, onPrem)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].Name < sessions[j].Name
+	})
+
+	return sessions, nil
+}
+
+// Save writes the session to its directory and marks it as last used now.
+func (s *Session) Save() error {
+	s.LastUsedAt = time.Now().UTC()
+
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(s.path, 0700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.getSessionPath(), b, 0600)
+}
+
+func (s *Session) GetComputePoolId() string {
+	if s != nil {
+		return s.ComputePoolId
+	}
+	return ""
+}
+
+func (s *Session) GetCatalog() string {
+	if s != nil {
+		return s.Properties[config.KeyCatalog]
+	}
+	return ""
+}
+
+func (s *Session) GetDatabase() string {
+	if s != nil {
+		return s.Properties[config.KeyDatabase]
+	}
+	return ""
+}
+
+// GetHistoryPath returns the path of the file which holds the statement history of the session.
+func (s *Session) GetHistoryPath() string {
+	return filepath.Join(s.path, historyFilename)
+}
+
+func (s *Session) getSessionPath() string {
+	return filepath.Join(s.path, sessionFilename)
+}
+
+func getSessionsPath(organizationId, environmentId string, onPrem bool) (string, error) {
+	if environmentId == "" {
+		return "", errors.New("sessions require an environment")
+	}
+	// Organization and environment IDs become directory names, so they are held to the same rules as session names
+	for _, id := range []string{organizationId, environmentId} {
+		if id != "" && !nameRegex.MatchString(id) {
+			return "", fmt.Errorf(`invalid organization or environment "%s" for sessions`, id)
+		}
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	confluentDir := os.Getenv(config.HomeConfluentPathEnvVar)
+	if confluentDir == "" {
+		confluentDir = config.HomeConfluentPathDefault
+	}
+
+	if onPrem {
+		return filepath.Join(home, confluentDir, directoryOnPrem, environmentId), nil
+	}
+	return filepath.Join(home, confluentDir, directory, organizationId, environmentId), nil
+}
@@ -0,0 +1,74 @@
+package session
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/cli/v4/pkg/flink/config"
+)
+
+func TestValidateName(t *testing.T) {
+	require.NoError(t, ValidateName("etl-dev"))
+	require.NoError(t, ValidateName("team_a.v2"))
+	require.Error(t, ValidateName(""))
+	require.Error(t, ValidateName(".."))
+	require.Error(t, ValidateName("a/b"))
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(config.HomeConfluentPathEnvVar, ".confluent")
+
+	session, err := Load("etl-dev", "org-123", "env-123456", false)
+	require.NoError(t, err)
+	require.Equal(t, "etl-dev", session.Name)
+	require.Empty(t, session.Properties)
+	require.Equal(t, filepath.Join(home, ".confluent", "flink_sessions", "org-123", "env-123456", "etl-dev", "history.json"), session.GetHistoryPath())
+
+	session.ComputePoolId = "lfcp-123456"
+	session.Properties[config.KeyCatalog] = "my-environment"
+	require.NoError(t, session.Save())
+
+	session, err = Load("etl-dev", "org-123", "env-123456", false)
+	require.NoError(t, err)
+	require.Equal(t, "lfcp-123456", session.ComputePoolId)
+	require.Equal(t, map[string]string{config.KeyCatalog: "my-environment"}, session.Properties)
+	require.False(t, session.LastUsedAt.IsZero())
+
+	// Sessions of the on-prem shell and of other environments are kept separately
+	sessions, err := List("", "env-123456", true)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+
+	sessions, err = List("org-123", "env-654321", false)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+
+	session, err = Load("etl-dev", "org-123", "env-654321", false)
+	require.NoError(t, err)
+	require.Empty(t, session.ComputePoolId)
+
+	_, err = Load("analytics", "org-123", "env-123456", false)
+	require.NoError(t, err)
+	other, err := Load("adhoc", "org-123", "env-123456", false)
+	require.NoError(t, err)
+	require.NoError(t, other.Save())
+
+	sessions, err = List("org-123", "env-123456", false)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	require.Equal(t, "adhoc", sessions[0].Name)
+	require.Equal(t, "etl-dev", sessions[1].Name)
+}
+
+func TestLoadInvalidEnvironment(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	_, err := Load("etl-dev", "org-123", "", false)
+	require.Error(t, err)
+	_, err = Load("etl-dev", "org-123", "../env", false)
+	require.Error(t, err)
+}
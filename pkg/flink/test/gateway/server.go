@@ -0,0 +1,240 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	flinkgatewayv1 "github.com/confluentinc/ccloud-sdk-go-v2/flink-gateway/v1"
+
+	"github.com/confluentinc/cli/v4/pkg/errors"
+)
+
+const statementsPath = "/sql/v1/organizations/{organization_id}/environments/{environment_id}/statements"
+
+// Response is the scripted response of the gateway to a SQL statement.
+type Response struct {
+	// Phase is the phase of the statement once it is no longer pending. It defaults to "COMPLETED".
+	Phase string
+	// StatusDetail is the status detail of the statement, such as the reason it failed.
+	StatusDetail string
+	// Columns and Rows are the results of the statement. Values of rows are usually strings, or nil for NULL.
+	Columns []Column
+	Rows    [][]any
+	// Exceptions are the messages of the exceptions reported by the statement.
+	Exceptions []string
+}
+
+type Column struct {
+	Name string
+	// Type is the SQL type of the column. It defaults to "VARCHAR".
+	Type string
+}
+
+// Server is a fake Flink SQL gateway which implements the statement APIs of Confluent Cloud with scripted responses,
+// so that the Flink shell and SQL scripts can be tested without a network. Statements without a scripted response
+// complete with no results.
+type Server struct {
+	URL string
+
+	server     *httptest.Server
+	mu         sync.Mutex
+	responses  map[string]Response
+	statements map[string]*flinkgatewayv1.SqlV1Statement
+	submitted  []string
+}
+
+// NewServer starts a fake gateway on a local port. Pass its URL to `confluent flink shell --gateway-url`, and close it
+// once done.
+func NewServer() *Server {
+	s := &Server{
+		responses:  map[string]Response{},
+		statements: map[string]*flinkgatewayv1.SqlV1Statement{},
+	}
+
+	router := mux.NewRouter()
+	router.HandleFunc(statementsPath, s.handleStatements).Methods(http.MethodGet, http.MethodPost)
+	router.HandleFunc(statementsPath+"/{statement}", s.handleStatement).Methods(http.MethodGet, http.MethodPut, http.MethodDelete)
+	router.HandleFunc(statementsPath+"/{statement}/results", s.handleStatementResults).Methods(http.MethodGet)
+	router.HandleFunc(statementsPath+"/{statement}/exceptions", s.handleStatementExceptions).Methods(http.MethodGet)
+
+	s.server = httptest.NewServer(router)
+	s.URL = s.server.URL
+	return s
+}
+
+// Respond scripts the response to a SQL statement. Statements are matched ignoring surrounding whitespace and the
+// trailing semicolon.
+func (s *Server) Respond(sql string, response Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.responses[normalize(sql)] = response
+}
+
+// Statements returns the SQL statements submitted to the gateway, in order.
+func (s *Server) Statements() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.submitted...)
+}
+
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+func (s *Server) handleStatements(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		list := flinkgatewayv1.SqlV1StatementList{Data: []flinkgatewayv1.SqlV1Statement{}}
+		for _, statement := range s.statements {
+			list.Data = append(list.Data, *statement)
+		}
+		writeJson(w, http.StatusOK, list)
+	case http.MethodPost:
+		statement := &flinkgatewayv1.SqlV1Statement{}
+		if err := json.NewDecoder(r.Body).Decode(statement); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if _, ok := s.statements[statement.GetName()]; ok {
+			writeError(w, http.StatusConflict, fmt.Sprintf("statement %s already exists", statement.GetName()))
+			return
+		}
+
+		statement.Metadata = &flinkgatewayv1.StatementObjectMeta{CreatedAt: flinkgatewayv1.PtrTime(time.Now())}
+		statement.Status = &flinkgatewayv1.SqlV1StatementStatus{Phase: "PENDING"}
+		s.statements[statement.GetName()] = statement
+		s.submitted = append(s.submitted, statement.Spec.GetStatement())
+		writeJson(w, http.StatusOK, statement)
+	}
+}
+
+func (s *Server) handleStatement(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := mux.Vars(r)["statement"]
+	statement, ok := s.statements[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("statement %s not found", name))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if statement.Status.GetPhase() == "PENDING" {
+			s.startStatement(statement)
+		}
+		writeJson(w, http.StatusOK, statement)
+	case http.MethodPut:
+		update := &flinkgatewayv1.SqlV1Statement{}
+		if err := json.NewDecoder(r.Body).Decode(update); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if update.Spec.GetStopped() {
+			statement.Spec.SetStopped(true)
+			statement.Status.Phase = "STOPPED"
+		} else if statement.Spec.GetStopped() {
+			statement.Spec.SetStopped(false)
+			statement.Status.Phase = "RUNNING"
+		}
+		w.WriteHeader(http.StatusAccepted)
+	case http.MethodDelete:
+		delete(s.statements, name)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// startStatement moves a pending statement to the phase of its scripted response.
+func (s *Server) startStatement(statement *flinkgatewayv1.SqlV1Statement) {
+	response := s.responses[normalize(statement.Spec.GetStatement())]
+
+	statement.Status.Phase = "COMPLETED"
+	if response.Phase != "" {
+		statement.Status.Phase = strings.ToUpper(response.Phase)
+	}
+	if response.StatusDetail != "" {
+		statement.Status.Detail = flinkgatewayv1.PtrString(response.StatusDetail)
+	}
+
+	columns := make([]flinkgatewayv1.ColumnDetails, len(response.Columns))
+	for i, column := range response.Columns {
+		columnType := "VARCHAR"
+		if column.Type != "" {
+			columnType = column.Type
+		}
+		columns[i] = flinkgatewayv1.ColumnDetails{Name: column.Name, Type: flinkgatewayv1.DataType{Type: columnType, Nullable: true}}
+	}
+	statement.Status.Traits = &flinkgatewayv1.SqlV1StatementTraits{Schema: &flinkgatewayv1.SqlV1ResultSchema{Columns: &columns}}
+}
+
+func (s *Server) handleStatementResults(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := mux.Vars(r)["statement"]
+	statement, ok := s.statements[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("statement %s not found", name))
+		return
+	}
+
+	// All rows are returned on the first page
+	data := []any{}
+	if r.URL.Query().Get("page_token") == "" {
+		for _, row := range s.responses[normalize(statement.Spec.GetStatement())].Rows {
+			data = append(data, map[string]any{"op": 0, "row": row})
+		}
+	}
+
+	writeJson(w, http.StatusOK, flinkgatewayv1.SqlV1StatementResult{
+		Metadata: flinkgatewayv1.ResultListMeta{Next: flinkgatewayv1.PtrString("")},
+		Results:  &flinkgatewayv1.SqlV1StatementResultResults{Data: &data},
+	})
+}
+
+func (s *Server) handleStatementExceptions(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := mux.Vars(r)["statement"]
+	statement, ok := s.statements[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("statement %s not found", name))
+		return
+	}
+
+	list := flinkgatewayv1.SqlV1StatementExceptionList{Data: []flinkgatewayv1.SqlV1StatementException{}}
+	for i, message := range s.responses[normalize(statement.Spec.GetStatement())].Exceptions {
+		list.Data = append(list.Data, flinkgatewayv1.SqlV1StatementException{
+			Name:      flinkgatewayv1.PtrString(fmt.Sprintf("exception-%d", i+1)),
+			Message:   flinkgatewayv1.PtrString(message),
+			Timestamp: statement.Metadata.CreatedAt,
+		})
+	}
+	writeJson(w, http.StatusOK, list)
+}
+
+func normalize(sql string) string {
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(sql), ";"))
+}
+
+func writeJson(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, detail string) {
+	writeJson(w, status, errors.ErrorResponseBody{Errors: []errors.ErrorDetail{{Detail: detail}}})
+}
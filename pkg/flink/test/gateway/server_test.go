@@ -0,0 +1,93 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	flinkgatewayv1 "github.com/confluentinc/ccloud-sdk-go-v2/flink-gateway/v1"
+
+	"github.com/confluentinc/cli/v4/pkg/ccloudv2"
+	client "github.com/confluentinc/cli/v4/pkg/flink/app"
+	"github.com/confluentinc/cli/v4/pkg/flink/types"
+)
+
+func TestServer(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	server.Respond("SELECT * FROM orders;", Response{
+		Columns: []Column{{Name: "id", Type: "INTEGER"}, {Name: "product"}},
+		Rows:    [][]any{{"1", "apples"}, {"2", nil}},
+	})
+	server.Respond("INSERT INTO missing SELECT * FROM orders", Response{Phase: "failed", StatusDetail: "Table 'missing' not found.", Exceptions: []string{"Table 'missing' not found."}})
+
+	gatewayClient := ccloudv2.NewFlinkGatewayClient(server.URL, "", false, "authToken")
+
+	statement, err := gatewayClient.CreateStatement(createStatement("statement-1", "SELECT * FROM orders;"), "", "env-123456", "org-local")
+	require.NoError(t, err)
+	require.Equal(t, "PENDING", statement.Status.GetPhase())
+
+	statement, err = gatewayClient.GetStatement("env-123456", "statement-1", "org-local")
+	require.NoError(t, err)
+	require.Equal(t, "COMPLETED", statement.Status.GetPhase())
+	require.Len(t, statement.Status.Traits.Schema.GetColumns(), 2)
+
+	results, err := gatewayClient.GetStatementResults("env-123456", "statement-1", "org-local", "")
+	require.NoError(t, err)
+	require.Len(t, results.Results.GetData(), 2)
+
+	_, err = gatewayClient.CreateStatement(createStatement("statement-2", "INSERT INTO missing SELECT * FROM orders;"), "", "env-123456", "org-local")
+	require.NoError(t, err)
+
+	statement, err = gatewayClient.GetStatement("env-123456", "statement-2", "org-local")
+	require.NoError(t, err)
+	require.Equal(t, "FAILED", statement.Status.GetPhase())
+	require.Equal(t, "Table 'missing' not found.", statement.Status.GetDetail())
+
+	exceptions, err := gatewayClient.GetExceptions("env-123456", "statement-2", "org-local")
+	require.NoError(t, err)
+	require.Len(t, exceptions, 1)
+
+	require.NoError(t, gatewayClient.DeleteStatement("env-123456", "statement-2", "org-local"))
+	_, err = gatewayClient.GetStatement("env-123456", "statement-2", "org-local")
+	require.Error(t, err)
+
+	require.Equal(t, []string{"SELECT * FROM orders;", "INSERT INTO missing SELECT * FROM orders;"}, server.Statements())
+}
+
+func TestServerRunScript(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	server.Respond("INSERT INTO missing SELECT * FROM orders", Response{Phase: "FAILED", StatusDetail: "Table 'missing' not found."})
+
+	gatewayClient := ccloudv2.NewFlinkGatewayClient(server.URL, "", false, "authToken")
+	opts := types.ApplicationOptions{
+		Cloud:          true,
+		EnvironmentId:  "env-123456",
+		OrganizationId: "org-local",
+		ComputePoolId:  "lfcp-123456",
+		GatewayUrl:     server.URL,
+		Variables:      map[string]string{"topic": "orders"},
+	}
+
+	script := "SET 'sql.state-ttl' = '1 h';\n" +
+		"CREATE TABLE ${topic} (id INT);\n" +
+		"INSERT INTO missing SELECT * FROM ${topic};\n" +
+		"SELECT * FROM ${topic};\n"
+	results, err := client.RunScript(gatewayClient, func() error { return nil }, opts, script, false)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	require.Equal(t, types.COMPLETED, results[1].Status)
+	require.Equal(t, types.FAILED, results[2].Status)
+
+	require.Equal(t, []string{"CREATE TABLE orders (id INT);", "INSERT INTO missing SELECT * FROM orders;"}, server.Statements())
+}
+
+func createStatement(name, sql string) flinkgatewayv1.SqlV1Statement {
+	return flinkgatewayv1.SqlV1Statement{
+		Name: flinkgatewayv1.PtrString(name),
+		Spec: &flinkgatewayv1.SqlV1StatementSpec{Statement: flinkgatewayv1.PtrString(sql)},
+	}
+}
@@ -109,6 +109,18 @@ func (mr *MockInputControllerInterfaceMockRecorder) HasUserInitiatedExit(arg0 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasUserInitiatedExit", reflect.TypeOf((*MockInputControllerInterface)(nil).HasUserInitiatedExit), arg0)
 }
 
+// ReloadHistory mocks base method.
+func (m *MockInputControllerInterface) ReloadHistory() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReloadHistory")
+}
+
+// ReloadHistory indicates an expected call of ReloadHistory.
+func (mr *MockInputControllerInterfaceMockRecorder) ReloadHistory() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReloadHistory", reflect.TypeOf((*MockInputControllerInterface)(nil).ReloadHistory))
+}
+
 // SetDiagnostics mocks base method.
 func (m *MockInputControllerInterface) SetDiagnostics(arg0 []lsp.Diagnostic) {
 	m.ctrl.T.Helper()
@@ -52,6 +52,18 @@ func (mr *MockResultFetcherInterfaceMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockResultFetcherInterface)(nil).Close))
 }
 
+// FetchNextPage mocks base method.
+func (m *MockResultFetcherInterface) FetchNextPage() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FetchNextPage")
+}
+
+// FetchNextPage indicates an expected call of FetchNextPage.
+func (mr *MockResultFetcherInterfaceMockRecorder) FetchNextPage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchNextPage", reflect.TypeOf((*MockResultFetcherInterface)(nil).FetchNextPage))
+}
+
 // GetLastRefreshTimestamp mocks base method.
 func (m *MockResultFetcherInterface) GetLastRefreshTimestamp() *time.Time {
 	m.ctrl.T.Helper()
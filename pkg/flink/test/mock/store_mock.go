@@ -70,6 +70,21 @@ func (mr *MockStoreInterfaceMockRecorder) FetchStatementResults(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchStatementResults", reflect.TypeOf((*MockStoreInterface)(nil).FetchStatementResults), arg0)
 }
 
+// GetStatementHealth mocks base method.
+func (m *MockStoreInterface) GetStatementHealth(statementName string) (*types.StatementHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatementHealth", statementName)
+	ret0, _ := ret[0].(*types.StatementHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatementHealth indicates an expected call of GetStatementHealth.
+func (mr *MockStoreInterfaceMockRecorder) GetStatementHealth(statementName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatementHealth", reflect.TypeOf((*MockStoreInterface)(nil).GetStatementHealth), statementName)
+}
+
 // ProcessLocalStatement mocks base method.
 func (m *MockStoreInterface) ProcessLocalStatement(statement string) (*types.ProcessedStatement, *types.StatementError) {
 	m.ctrl.T.Helper()
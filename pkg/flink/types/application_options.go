@@ -8,23 +8,27 @@ import (
 
 	"github.com/confluentinc/cli/v4/pkg/config"
 	"github.com/confluentinc/cli/v4/pkg/errors"
+	"github.com/confluentinc/cli/v4/pkg/flink/session"
 )
 
 type ApplicationOptions struct {
-	Cloud              bool
-	UnsafeTrace        bool
-	UserAgent          string
-	EnvironmentId      string
-	EnvironmentName    string
-	OrganizationId     string // Cloud only
-	Database           string
-	ComputePoolId      string
-	ServiceAccountId   string            // Cloud only
-	FlinkConfiguration map[string]string // On-prem only
-	Verbose            bool
-	LSPBaseUrl         string // Cloud only
-	GatewayUrl         string // Cloud only
-	Context            *config.Context
+	Cloud               bool
+	UnsafeTrace         bool
+	UserAgent           string
+	EnvironmentId       string
+	EnvironmentName     string
+	OrganizationId      string // Cloud only
+	Database            string
+	ComputePoolId       string
+	ServiceAccountId    string            // Cloud only
+	FlinkConfiguration  map[string]string // On-prem only
+	Verbose             bool
+	LSPBaseUrl          string // Cloud only
+	GatewayUrl          string // Cloud only
+	Context             *config.Context
+	Session             *session.Session
+	Variables           map[string]string
+	ValidateComputePool func(computePoolId string) error // Cloud only
 }
 
 func ParseApplicationOptionsFromSlices(
@@ -192,6 +196,20 @@ func (a *ApplicationOptions) GetContext() *config.Context {
 	return nil
 }
 
+func (a *ApplicationOptions) GetSession() *session.Session {
+	if a != nil {
+		return a.Session
+	}
+	return nil
+}
+
+func (a *ApplicationOptions) GetVariables() map[string]string {
+	if a != nil {
+		return a.Variables
+	}
+	return nil
+}
+
 func (a *ApplicationOptions) GetLSPBaseUrl() string {
 	if a != nil {
 		return a.LSPBaseUrl
@@ -15,6 +15,7 @@ type InputControllerInterface interface {
 	GetWindowWidth() int
 	SetDiagnostics(diagnostics []lsp.Diagnostic)
 	DiagnosticsEnabled() bool
+	ReloadHistory()
 }
 
 type StatementControllerInterface interface {
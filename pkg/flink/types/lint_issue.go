@@ -0,0 +1,9 @@
+package types
+
+// LintIssue is a problem found in a SQL script without submitting it.
+type LintIssue struct {
+	Line    int
+	Column  int
+	Rule    string
+	Message string
+}
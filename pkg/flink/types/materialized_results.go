@@ -302,3 +302,23 @@ func (s *MaterializedStatementResults) GetTableSize() int {
 func (s *MaterializedStatementResults) GetChangelogSize() int {
 	return s.changelog.Len()
 }
+
+// GetColumnNames returns the column names of the results, without the operation column shown in changelog mode.
+func (s *MaterializedStatementResults) GetColumnNames() []string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.headers
+}
+
+// ForEachChangelogRow iterates over the changelog, regardless of the current view mode.
+func (s *MaterializedStatementResults) ForEachChangelogRow(f func(rowIdx int, row StatementResultRow)) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	rowIdx := 0
+	for element := s.changelog.Front(); element != nil; element = element.Next() {
+		f(rowIdx, *element.Value())
+		rowIdx++
+	}
+}
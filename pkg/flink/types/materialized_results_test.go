@@ -489,6 +489,23 @@ func (s *MaterializedStatementResultsTestSuite) TestToggleTableMode() {
 	require.Equal(s.T(), 1, materializedStatementResults.Size())
 }
 
+func (s *MaterializedStatementResultsTestSuite) TestForEachChangelogRow() {
+	headers := []string{"column1", "column2"}
+	materializedStatementResults := NewMaterializedStatementResults(headers, 10, nil)
+	appendRow(&materializedStatementResults, Insert, Varchar, "1", "2")
+	appendRow(&materializedStatementResults, Delete, Varchar, "1", "2")
+
+	var operations []string
+	materializedStatementResults.ForEachChangelogRow(func(_ int, row StatementResultRow) {
+		require.Len(s.T(), row.Fields, len(headers))
+		operations = append(operations, row.Operation.String())
+	})
+
+	require.True(s.T(), materializedStatementResults.IsTableMode())
+	require.Equal(s.T(), headers, materializedStatementResults.GetColumnNames())
+	require.Equal(s.T(), []string{"+I", "-D"}, operations)
+}
+
 func (s *MaterializedStatementResultsTestSuite) TestRowKeysShouldNotCollide() {
 	headers := []string{"column1", "column2"}
 	materializedStatementResults := NewMaterializedStatementResults(headers, 10, nil)
@@ -19,6 +19,7 @@ const (
 	RUNNING   PHASE = "RUNNING"   // More results are available (pagination)
 	COMPLETED PHASE = "COMPLETED" // All results were fetched
 	FAILED    PHASE = "FAILED"
+	DEGRADED  PHASE = "DEGRADED"
 )
 
 // ProcessedStatement Custom Internal type that shall be used internally by the client
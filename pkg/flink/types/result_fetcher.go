@@ -33,6 +33,7 @@ type ResultFetcherInterface interface {
 	IsRefreshRunning() bool
 	Init(statement ProcessedStatement)
 	Close()
+	FetchNextPage()
 	SetRefreshCallback(func())
 	GetStatement() ProcessedStatement
 	GetMaterializedStatementResults() *MaterializedStatementResults
@@ -0,0 +1,20 @@
+package types
+
+import "time"
+
+// StatementHealth is the state of a statement at the time it was last polled.
+type StatementHealth struct {
+	Name          string
+	ComputePoolId string
+	Labels        map[string]string
+	Phase         PHASE
+	StatusDetail  string
+	// Exceptions are ordered from the most recent to the oldest
+	Exceptions []StatementException
+}
+
+type StatementException struct {
+	Name      string
+	Message   string
+	Timestamp time.Time
+}
@@ -12,4 +12,5 @@ type StoreInterface interface {
 	DeleteStatement(statementName string) bool
 	WaitPendingStatement(ctx context.Context, statement ProcessedStatement) (*ProcessedStatement, *StatementError)
 	WaitForTerminalStatementState(ctx context.Context, statement ProcessedStatement) (*ProcessedStatement, *StatementError)
+	GetStatementHealth(statementName string) (*StatementHealth, error)
 }
@@ -10,6 +10,7 @@ type UserPropertiesInterface interface {
 	GetNonLocalProperties() map[string]string
 	GetOrDefault(key string, defaultValue string) string
 	GetOutputFormat() config.OutputFormat
+	GetPersistableProperties() map[string]string
 	GetProperties() map[string]string
 	HasKey(key string) bool
 	Set(key string, value string)
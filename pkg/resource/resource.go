@@ -17,6 +17,7 @@ const (
 	ACL                              = "ACL"
 	ApiKey                           = "API key"
 	Broker                           = "broker"
+	BusinessMetadata                 = "business metadata definition"
 	ByokKey                          = "self-managed key"
 	CatalogIntegration               = "catalog integration"
 	CCPMCustomConnectorPlugin        = "CCPM custom connector plugin"
@@ -79,9 +80,11 @@ const (
 	SchemaExporter                   = "schema exporter"
 	SchemaRegistryCluster            = "Schema Registry cluster"
 	SchemaRegistryConfiguration      = "Schema Registry configuration"
+	SchemaRegistryContext            = "Schema Registry context"
 	ServiceAccount                   = "service account"
 	SsoGroupMapping                  = "SSO group mapping"
 	Tableflow                        = "tableflow"
+	Tag                              = "tag"
 	Topic                            = "topic"
 	TransitGatewayAttachment         = "transit gateway attachment"
 	User                             = "user"
@@ -2,6 +2,7 @@ package schemaregistry
 
 import (
 	"context"
+	"fmt"
 
 	srsdk "github.com/confluentinc/schema-registry-sdk-go"
 
@@ -54,6 +55,21 @@ func (c *Client) Get() error {
 	return err
 }
 
+// GetSchemaRegistryClusterId returns the ID of the Schema Registry cluster from the scope of the server, since the ID
+// of the server is the ID of the Kafka cluster which stores the schemas.
+func (c *Client) GetSchemaRegistryClusterId() (string, error) {
+	res, _, err := c.DefaultApi.GetClusterId(c.context()).Execute()
+	if err != nil {
+		return "", err
+	}
+
+	clusters := res.GetScope()["clusters"]
+	if clusterId, ok := clusters["schema-registry-cluster"].(string); ok && clusterId != "" {
+		return clusterId, nil
+	}
+	return "", fmt.Errorf("the Schema Registry cluster ID is not in the scope of the server")
+}
+
 func (c *Client) GetTopLevelConfig() (srsdk.Config, error) {
 	res, _, err := c.DefaultApi.GetTopLevelConfig(c.context()).Execute()
 	return res, err
@@ -84,6 +100,16 @@ func (c *Client) UpdateMode(subject string, req srsdk.ModeUpdateRequest) (srsdk.
 	return res, err
 }
 
+func (c *Client) GetSubjectLevelMode(subject string) (srsdk.Mode, error) {
+	res, _, err := c.DefaultApi.GetMode(c.context(), subject).Execute()
+	return res, err
+}
+
+func (c *Client) DeleteSubjectLevelMode(subject string) (string, error) {
+	res, _, err := c.DefaultApi.DeleteSubjectMode(c.context(), subject).Execute()
+	return res, err
+}
+
 func (c *Client) GetSubjectLevelConfig(subject string) (srsdk.Config, error) {
 	res, _, err := c.DefaultApi.GetSubjectLevelConfig(c.context(), subject).Execute()
 	return res, err
@@ -209,6 +235,85 @@ func (c *Client) GetTags(typeName, qualifiedName string) ([]srsdk.TagResponse, e
 	return res, err
 }
 
+func (c *Client) GetAllTagDefs(prefix string) ([]srsdk.TagDefResponse, error) {
+	res, _, err := c.DefaultApi.GetAllTagDefs(c.context()).Prefix(prefix).Execute()
+	return res, err
+}
+
+func (c *Client) GetTagDefByName(name string) (srsdk.TagDef, error) {
+	res, _, err := c.DefaultApi.GetTagDefByName(c.context(), name).Execute()
+	return res, err
+}
+
+func (c *Client) DeleteTagDef(name string) error {
+	_, err := c.DefaultApi.DeleteTagDef(c.context(), name).Execute()
+	return err
+}
+
+func (c *Client) DeleteTag(typeName, qualifiedName, tagName string) error {
+	_, err := c.DefaultApi.DeleteTag(c.context(), typeName, qualifiedName, tagName).Execute()
+	return err
+}
+
+func (c *Client) CreateBusinessMetadataDefs(businessMetadataDefs []srsdk.AtlasBusinessMetadataDef) ([]srsdk.BusinessMetadataDefResponse, error) {
+	res, _, err := c.DefaultApi.CreateBusinessMetadataDefs(c.context()).AtlasBusinessMetadataDef(businessMetadataDefs).Execute()
+	return res, err
+}
+
+func (c *Client) GetAllBusinessMetadataDefs(prefix string) ([]srsdk.BusinessMetadataDefResponse, error) {
+	res, _, err := c.DefaultApi.GetAllBusinessMetadataDefs(c.context()).Prefix(prefix).Execute()
+	return res, err
+}
+
+func (c *Client) GetBusinessMetadataDefByName(name string) (srsdk.AtlasBusinessMetadataDef, error) {
+	res, _, err := c.DefaultApi.GetBusinessMetadataDefByName(c.context(), name).Execute()
+	return res, err
+}
+
+func (c *Client) DeleteBusinessMetadataDef(name string) error {
+	_, err := c.DefaultApi.DeleteBusinessMetadataDef(c.context(), name).Execute()
+	return err
+}
+
+func (c *Client) CreateBusinessMetadata(businessMetadata []srsdk.BusinessMetadata) ([]srsdk.BusinessMetadataResponse, error) {
+	res, _, err := c.DefaultApi.CreateBusinessMetadata(c.context()).BusinessMetadata(businessMetadata).Execute()
+	return res, err
+}
+
+func (c *Client) GetBusinessMetadata(typeName, qualifiedName string) ([]srsdk.BusinessMetadataResponse, error) {
+	res, _, err := c.DefaultApi.GetBusinessMetadata(c.context(), typeName, qualifiedName).Execute()
+	return res, err
+}
+
+func (c *Client) DeleteBusinessMetadata(typeName, qualifiedName, businessMetadataName string) error {
+	_, err := c.DefaultApi.DeleteBusinessMetadata(c.context(), typeName, qualifiedName, businessMetadataName).Execute()
+	return err
+}
+
+func (c *Client) SearchByTag(tag, entityType string, limit, offset int32) (srsdk.SearchResult, error) {
+	req := c.DefaultApi.SearchUsingAttribute(c.context()).Tag([]string{tag}).Limit(limit).Offset(offset)
+	if entityType != "" {
+		req = req.Type_([]string{entityType})
+	}
+	res, _, err := req.Execute()
+	return res, err
+}
+
+func (c *Client) ListContexts() ([]string, error) {
+	res, _, err := c.DefaultApi.ListContexts(c.context()).Execute()
+	return res, err
+}
+
+func (c *Client) GetContextMode(context string) (srsdk.Mode, error) {
+	res, _, err := c.DefaultApi.GetMode(c.context(), context).DefaultToGlobal(true).Execute()
+	return res, err
+}
+
+func (c *Client) GetContextConfig(context string) (srsdk.Config, error) {
+	res, _, err := c.DefaultApi.GetSubjectLevelConfig(c.context(), context).DefaultToGlobal(true).Execute()
+	return res, err
+}
+
 func (c *Client) List(subjectPrefix string, deleted bool) ([]string, error) {
 	res, _, err := c.DefaultApi.List(c.context()).SubjectPrefix(subjectPrefix).Deleted(deleted).Execute()
 	return res, err
@@ -1,8 +1,33 @@
 package schemaregistry
 
-import "github.com/confluentinc/cli/v4/pkg/errors"
+import (
+	"encoding/json"
+	"net/http"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	"github.com/confluentinc/cli/v4/pkg/errors"
+)
 
 var ErrNotEnabled = errors.NewErrorWithSuggestions(
 	"Schema Registry not enabled",
 	"Enable Schema Registry for this environment by creating a Kafka cluster with `confluent kafka cluster create`.",
 )
+
+// IsNotFoundError reports whether Schema Registry responded with a 404, such as error code 40401 for a subject which
+// does not exist, or 40408 for a subject without a subject-level configuration.
+func IsNotFoundError(err error) bool {
+	openAPIError, ok := err.(srsdk.GenericOpenAPIError)
+	if !ok {
+		return false
+	}
+
+	response := new(srsdk.ErrorMessage)
+	if err := json.Unmarshal(openAPIError.Body(), response); err != nil {
+		return false
+	}
+
+	// Schema Registry error codes extend the HTTP status with two more digits
+	code := response.GetErrorCode()
+	return code == http.StatusNotFound || code/100 == http.StatusNotFound
+}
@@ -0,0 +1,75 @@
+package serdes
+
+import (
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"os"
+
+	"github.com/tink-crypto/tink-go/v2/aead"
+	"github.com/tink-crypto/tink-go/v2/core/registry"
+	"github.com/tink-crypto/tink-go/v2/daead"
+	tinkpb "github.com/tink-crypto/tink-go/v2/proto/tink_go_proto"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry/rules/encryption"
+)
+
+func getDekKeyTemplate(algorithm string) (*tinkpb.KeyTemplate, error) {
+	switch algorithm {
+	case "AES128_GCM":
+		return aead.AES128GCMKeyTemplate(), nil
+	case "", "AES256_GCM":
+		return aead.AES256GCMKeyTemplate(), nil
+	case "AES256_SIV":
+		return daead.AESSIVKeyTemplate(), nil
+	default:
+		return nil, fmt.Errorf(`unsupported DEK algorithm "%s"`, algorithm)
+	}
+}
+
+// NewEncryptedDekKeyMaterial generates key material for a new Data Encryption Key (DEK) and encrypts it with the
+// Key Encryption Key (KEK) stored in the KMS. The result is base64-encoded, as Schema Registry expects.
+// The KMS drivers are registered when this package is loaded. The local KMS, used for testing, reads its secret
+// from the LOCAL_KMS_SECRET environment variable.
+func NewEncryptedDekKeyMaterial(algorithm, kmsType, kmsKeyId string, kmsProps map[string]string) (string, error) {
+	template, err := getDekKeyTemplate(algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	keyData, err := registry.NewKeyData(template)
+	if err != nil {
+		return "", err
+	}
+
+	kmsConfig := maps.Clone(kmsProps)
+	if kmsConfig == nil {
+		kmsConfig = map[string]string{}
+	}
+	if localKmsSecretValue := os.Getenv(localKmsSecretMacro); localKmsSecretValue != "" {
+		kmsConfig[localKmsSecretKey] = localKmsSecretValue
+	}
+
+	kekUrl := fmt.Sprintf("%s://%s", kmsType, kmsKeyId)
+	driver, err := encryption.GetKMSDriver(kekUrl)
+	if err != nil {
+		return "", err
+	}
+
+	kmsClient, err := driver.NewKMSClient(kmsConfig, &kekUrl)
+	if err != nil {
+		return "", fmt.Errorf("failed to create KMS client: %w", err)
+	}
+
+	primitive, err := kmsClient.GetAEAD(kekUrl)
+	if err != nil {
+		return "", err
+	}
+
+	encryptedKeyMaterial, err := primitive.Encrypt(keyData.GetValue(), []byte{})
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt DEK key material with KEK: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(encryptedKeyMaterial), nil
+}
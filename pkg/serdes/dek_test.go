@@ -0,0 +1,34 @@
+package serdes
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry/rules/encryption/localkms"
+)
+
+func TestNewEncryptedDekKeyMaterial(t *testing.T) {
+	t.Setenv(localKmsSecretMacro, "test_local_kms_secret")
+
+	for _, algorithm := range DekAlgorithms {
+		keyMaterial, err := NewEncryptedDekKeyMaterial(algorithm, "local-kms", "mykey", nil)
+		require.NoError(t, err)
+
+		encrypted, err := base64.StdEncoding.DecodeString(keyMaterial)
+		require.NoError(t, err)
+
+		client, err := localkms.NewLocalClient("local-kms://", "test_local_kms_secret")
+		require.NoError(t, err)
+		primitive, err := client.GetAEAD("local-kms://mykey")
+		require.NoError(t, err)
+		_, err = primitive.Decrypt(encrypted, []byte{})
+		require.NoError(t, err)
+	}
+}
+
+func TestNewEncryptedDekKeyMaterial_UnsupportedAlgorithm(t *testing.T) {
+	_, err := NewEncryptedDekKeyMaterial("AES512_GCM", "local-kms", "mykey", nil)
+	require.EqualError(t, err, `unsupported DEK algorithm "AES512_GCM"`)
+}
@@ -0,0 +1,198 @@
+package serdes
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry/rules/encryption"
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry/serde"
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry/serde/avrov3"
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry/serde/jsonschema"
+)
+
+// Rule types which are disabled while sampling, since they would run on encrypted field values
+var sampledDisabledRuleTypes = []string{"CEL", "CEL_FIELD", "JSONATA"}
+
+// DekVersion identifies the Data Encryption Key (DEK) with which an encrypted field of a record was written.
+type DekVersion struct {
+	Algorithm string
+	Version   int32
+}
+
+type genericDeserializer interface {
+	DeserializeInto(topic string, payload []byte, msg any) error
+	DeserializeWithHeadersInto(topic string, headers []kafka.Header, payload []byte, msg any) error
+	SetRuleRegistry(registry *serde.RuleRegistry, ruleConfig map[string]string) error
+}
+
+// DekVersionSampler reads the DEK versions with which the encrypted fields of records were written, without
+// decrypting them. A version is only written to a record when its encryption rule sets "encrypt.dek.expiry.days".
+type DekVersionSampler struct {
+	deser    genericDeserializer
+	executor *dekVersionExecutor
+}
+
+func NewDekVersionSampler(srClientUrl, srClusterId, mode, schemaType string, srAuth SchemaRegistryAuth, existingClient schemaregistry.Client) (*DekVersionSampler, error) {
+	serdeClient, err := initSchemaRegistryClient(srClientUrl, srClusterId, srAuth, existingClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create deserializer-specific Schema Registry client: %w", err)
+	}
+
+	var serdeType serde.Type
+	switch mode {
+	case "key":
+		serdeType = serde.KeySerde
+	case "value":
+		serdeType = serde.ValueSerde
+	default:
+		return nil, fmt.Errorf("unknown deserialization mode: %s", mode)
+	}
+
+	sampler := &DekVersionSampler{executor: newDekVersionExecutor()}
+
+	switch schemaType {
+	case "", avroSchemaBackendName:
+		deser, err := avrov3.NewDeserializer(serdeClient, serdeType, avrov3.NewDeserializerConfig())
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize AVRO deserializer: %w", err)
+		}
+		sampler.deser = deser
+	case jsonSchemaBackendName:
+		deser, err := jsonschema.NewDeserializer(serdeClient, serdeType, jsonschema.NewDeserializerConfig())
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize JSON deserializer: %w", err)
+		}
+		sampler.deser = deser
+	default:
+		return nil, fmt.Errorf("reading DEK versions from %s records is not supported", schemaType)
+	}
+
+	// The sampler replaces the encryption executor, so that no KMS access is needed
+	registry := serde.NewRuleRegistry()
+	registry.RegisterExecutor(sampler.executor)
+	disabled := true
+	for _, ruleType := range sampledDisabledRuleTypes {
+		registry.RegisterOverride(&serde.RuleOverride{Type: ruleType, Disabled: &disabled})
+	}
+	if err := sampler.deser.SetRuleRegistry(&registry, nil); err != nil {
+		return nil, err
+	}
+
+	return sampler, nil
+}
+
+// Sample returns the DEK versions of the encrypted fields of a record, and whether any of its encrypted fields was
+// written without a version.
+func (s *DekVersionSampler) Sample(topic string, headers []kafka.Header, payload []byte) ([]DekVersion, bool, error) {
+	s.executor.versions = nil
+	s.executor.unversioned = false
+
+	message := make(map[string]any)
+	var err error
+	if len(headers) > 0 {
+		err = s.deser.DeserializeWithHeadersInto(topic, headers, payload, &message)
+	} else {
+		err = s.deser.DeserializeInto(topic, payload, &message)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to deserialize payload: %w", err)
+	}
+
+	return s.executor.versions, s.executor.unversioned, nil
+}
+
+type dekVersionExecutor struct {
+	serde.AbstractFieldRuleExecutor
+	versions    []DekVersion
+	unversioned bool
+}
+
+func newDekVersionExecutor() *dekVersionExecutor {
+	executor := &dekVersionExecutor{}
+	executor.FieldRuleExecutor = executor
+	return executor
+}
+
+func (e *dekVersionExecutor) Configure(_ *schemaregistry.Config, _ map[string]string) error {
+	return nil
+}
+
+func (e *dekVersionExecutor) Type() string {
+	return "ENCRYPT"
+}
+
+func (e *dekVersionExecutor) NewTransform(ctx serde.RuleContext) (serde.FieldTransform, error) {
+	algorithm := encryption.Aes256Gcm
+	if value := ctx.GetParameter(encryption.EncryptDekAlgorithm); value != nil {
+		algorithm = *value
+	}
+
+	var expiryDays int
+	if value := ctx.GetParameter(encryption.EncryptDekExpiryDays); value != nil {
+		days, err := strconv.Atoi(*value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %s: %s", encryption.EncryptDekExpiryDays, *value)
+		}
+		expiryDays = days
+	}
+
+	return &dekVersionTransform{
+		executor:  e,
+		algorithm: algorithm,
+		versioned: expiryDays > 0,
+	}, nil
+}
+
+func (e *dekVersionExecutor) Close() error {
+	return nil
+}
+
+type dekVersionTransform struct {
+	executor  *dekVersionExecutor
+	algorithm string
+	versioned bool
+}
+
+// Transform records the DEK version of an encrypted field, and returns the field unchanged.
+func (t *dekVersionTransform) Transform(_ serde.RuleContext, _ serde.FieldContext, fieldValue any) (any, error) {
+	var ciphertext []byte
+	switch value := fieldValue.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, err
+		}
+		ciphertext = decoded
+	case []byte:
+		ciphertext = value
+	default:
+		return fieldValue, nil
+	}
+
+	if !t.versioned {
+		t.executor.unversioned = true
+		return fieldValue, nil
+	}
+
+	version, err := getDekVersion(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	t.executor.versions = append(t.executor.versions, DekVersion{Algorithm: t.algorithm, Version: version})
+
+	return fieldValue, nil
+}
+
+// getDekVersion reads the DEK version which prefixes the ciphertext of a field whose DEK is rotated.
+func getDekVersion(ciphertext []byte) (int32, error) {
+	if len(ciphertext) < 5 || ciphertext[0] != serde.MagicByteV0 {
+		return 0, fmt.Errorf("encrypted field does not start with a DEK version")
+	}
+	return int32(binary.BigEndian.Uint32(ciphertext[1:5])), nil
+}
@@ -0,0 +1,85 @@
+package serdes
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+)
+
+func serializeEncryptedAvroRecord(t *testing.T, subject string, params map[string]string) (schemaregistry.Client, []byte) {
+	t.Setenv(localKmsSecretMacro, localKmsSecretValueDefault)
+
+	schemaString := `{"type":"record","name":"myRecord","fields":[{"name":"f1","type":"string","confluent:tags": ["PII"]}]}`
+	schemaPath := filepath.Join(t.TempDir(), "avro-schema-dek-version.txt")
+	require.NoError(t, os.WriteFile(schemaPath, []byte(schemaString), 0644))
+
+	serializationProvider, _ := GetSerializationProvider(avroSchemaName)
+	require.NoError(t, serializationProvider.InitSerializer(mockClientUrl, "", "value", -1, SchemaRegistryAuth{}))
+	require.NoError(t, serializationProvider.LoadSchema(schemaPath, map[string]string{}))
+
+	params["encrypt.kek.name"] = "kek1"
+	params["encrypt.kms.type"] = "local-kms"
+	params["encrypt.kms.key.id"] = "mykey"
+	ruleSet := schemaregistry.RuleSet{
+		DomainRules: []schemaregistry.Rule{{
+			Name:      "avro-encrypt",
+			Kind:      "TRANSFORM",
+			Mode:      "WRITEREAD",
+			Type:      "ENCRYPT",
+			Tags:      []string{"PII"},
+			Params:    params,
+			OnFailure: "ERROR,NONE",
+		}},
+	}
+
+	client := serializationProvider.GetSchemaRegistryClient()
+	_, err := client.Register(subject, schemaregistry.SchemaInfo{Schema: schemaString, SchemaType: "AVRO", RuleSet: &ruleSet}, false)
+	require.NoError(t, err)
+
+	_, data, err := serializationProvider.Serialize("topic1", `{"f1":"this is a confidential message"}`)
+	require.NoError(t, err)
+
+	return client, data
+}
+
+func TestDekVersionSampler(t *testing.T) {
+	client, data := serializeEncryptedAvroRecord(t, "topic1-value", map[string]string{"encrypt.dek.expiry.days": "1"})
+
+	sampler, err := NewDekVersionSampler(mockClientUrl, "", "value", "AVRO", SchemaRegistryAuth{}, client)
+	require.NoError(t, err)
+
+	versions, unversioned, err := sampler.Sample("topic1", nil, data)
+	require.NoError(t, err)
+	require.False(t, unversioned)
+	require.Equal(t, []DekVersion{{Algorithm: "AES256_GCM", Version: 1}}, versions)
+}
+
+func TestDekVersionSampler_Unversioned(t *testing.T) {
+	client, data := serializeEncryptedAvroRecord(t, "topic1-value", map[string]string{})
+
+	sampler, err := NewDekVersionSampler(mockClientUrl, "", "value", "AVRO", SchemaRegistryAuth{}, client)
+	require.NoError(t, err)
+
+	versions, unversioned, err := sampler.Sample("topic1", nil, data)
+	require.NoError(t, err)
+	require.True(t, unversioned)
+	require.Empty(t, versions)
+}
+
+func TestDekVersionSampler_UnsupportedSchemaType(t *testing.T) {
+	_, err := NewDekVersionSampler(mockClientUrl, "", "value", "PROTOBUF", SchemaRegistryAuth{}, nil)
+	require.EqualError(t, err, "reading DEK versions from PROTOBUF records is not supported")
+}
+
+func TestGetDekVersion(t *testing.T) {
+	version, err := getDekVersion([]byte{0, 0, 0, 0, 3, 42})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), version)
+
+	_, err = getDekVersion([]byte{1, 0, 0, 0, 3, 42})
+	require.EqualError(t, err, "encrypted field does not start with a DEK version")
+}
@@ -192,6 +192,27 @@ func (s *CLITestSuite) TestConnectPluginInstall() {
 	}
 }
 
+func (s *CLITestSuite) TestConnectPluginInstalled() {
+	if runtime.GOOS == "windows" {
+		return
+	}
+
+	workerConfig := "test/fixtures/input/connect/confluent-installed/etc/kafka/connect-distributed.properties"
+
+	tests := []CLITest{
+		{args: "connect plugin installed list --worker-configurations " + workerConfig + " -o json", fixture: "connect/plugin/installed/list-json.golden"},
+		{args: "connect plugin upgrade confluentinc/integration-test-plugin --worker-configurations " + workerConfig + " --dry-run --force", fixture: "connect/plugin/upgrade/dry-run.golden"},
+		{args: "connect plugin upgrade confluentinc/integration-test-plugin:0.0.5 --worker-configurations " + workerConfig + " --dry-run --force", fixture: "connect/plugin/upgrade/up-to-date.golden"},
+		{args: "connect plugin uninstall confluentinc/integration-test-plugin --worker-configurations " + workerConfig + " --dry-run --force", fixture: "connect/plugin/uninstall/dry-run.golden"},
+		{args: "connect plugin uninstall confluentinc/dne-connector --worker-configurations " + workerConfig + " --dry-run --force", fixture: "connect/plugin/uninstall/not-installed.golden", exitCode: 1},
+	}
+
+	for _, test := range tests {
+		test.login = "onprem"
+		s.runIntegrationTest(test)
+	}
+}
+
 func (s *CLITestSuite) TestConnect_Autocomplete() {
 	tests := []CLITest{
 		{args: `__complete connect cluster describe ""`, useKafka: "lkc-123", fixture: "connect/cluster/describe-autocomplete.golden"},
@@ -276,6 +297,9 @@ func (s *CLITestSuite) TestConnectOffset() {
 		{args: "connect offset delete lcc-111 --cluster lkc-123", fixture: "connect/offset/delete-offset.golden"},
 		{args: "connect offset delete lcc-111 --cluster lkc-123 -o json", fixture: "connect/offset/delete-offset-json.golden"},
 		{args: "connect offset delete lcc-111 --cluster lkc-123 -o yaml", fixture: "connect/offset/delete-offset-yaml.golden"},
+
+		{args: "connect offset export lcc-123 --timeout 1 --cluster lkc-123", fixture: "connect/offset/export-offset.golden"},
+		{args: "connect offset import lcc-123 --from test/fixtures/input/connect/offset.json --dry-run --cluster lkc-123 -o json", fixture: "connect/offset/import-offset-dry-run-json.golden"},
 	}
 
 	for _, test := range tests {
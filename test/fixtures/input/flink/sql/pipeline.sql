@@ -0,0 +1,2 @@
+create table orders (id bigint, amount decimal(10, 2), ts timestamp(3));
+insert into sink select * from orders o join customers c on o.customer_id = c.id
@@ -449,6 +449,9 @@ func (s *CLITestSuite) TestFlinkStatement() {
 		{args: "flink statement list --cloud aws --region eu-west-1 --status pending", fixture: "flink/statement/list-pending.golden"},
 		{args: "flink statement list --cloud aws --region eu-west-1 --compute-pool lfcp-nonexistent", fixture: "flink/statement/list-cp-not-found.golden", exitCode: 1},
 		{args: "flink statement list --cloud aws --region eu-west-2 --compute-pool lfcp-123456", fixture: "flink/statement/list-cp-incorrect-region.golden", exitCode: 1},
+		{args: "flink statement results my-statement --cloud aws --region eu-west-1", fixture: "flink/statement/results.golden"},
+		{args: "flink statement results my-statement --cloud aws --region eu-west-1 -o json", fixture: "flink/statement/results-json.golden"},
+		{args: "flink statement results my-statement --cloud aws --region eu-west-1 --output-file results.txt", fixture: "flink/statement/results-invalid-output-file.golden", exitCode: 1},
 		{args: "flink statement stop my-statement --cloud aws --region eu-west-1", fixture: "flink/statement/stop.golden"},
 		{args: "flink statement resume my-statement --cloud aws --region eu-west-1", fixture: "flink/statement/resume-valid.golden"},
 		{args: "flink statement resume my-statement --cloud aws --region eu-west-1 --principal u-123456", fixture: "flink/statement/resume-valid.golden"},
@@ -560,6 +563,18 @@ func (s *CLITestSuite) TestFlink_Autocomplete() {
 	}
 }
 
+func (s *CLITestSuite) TestFlinkSql() {
+	tests := []CLITest{
+		{args: "flink sql fmt test/fixtures/input/flink/sql/pipeline.sql", fixture: "flink/sql/fmt.golden"},
+		{args: "flink sql fmt test/fixtures/input/flink/sql/pipeline.sql --check", fixture: "flink/sql/fmt-check.golden", exitCode: 1},
+		{args: "flink sql lint test/fixtures/input/flink/sql/pipeline.sql -o json", fixture: "flink/sql/lint-json.golden", exitCode: 1},
+	}
+
+	for _, test := range tests {
+		s.runIntegrationTest(test)
+	}
+}
+
 func (s *CLITestSuite) TestFlinkShell() {
 	tests := []flinkShellTest{
 		{
@@ -2,6 +2,11 @@ package test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
 
 	testserver "github.com/confluentinc/cli/v4/test/test-server"
 )
@@ -91,6 +96,8 @@ func (s *CLITestSuite) TestSchemaRegistryExporter() {
 		{args: fmt.Sprintf(`schema-registry exporter delete myexporter myexporter2 --environment %s --force`, testserver.SRApiEnvId), fixture: "schema-registry/exporter/delete-multiple-success.golden"},
 		{args: fmt.Sprintf("schema-registry exporter delete myexporter --environment %s", testserver.SRApiEnvId), input: "y\n", fixture: "schema-registry/exporter/delete-prompt.golden"},
 		{args: fmt.Sprintf("schema-registry exporter status describe myexporter --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/exporter/status/describe.golden"},
+		{args: fmt.Sprintf("schema-registry exporter status list --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/exporter/status/list.golden", exitCode: 1},
+		{args: fmt.Sprintf("schema-registry exporter status list --environment %s -o json", testserver.SRApiEnvId), fixture: "schema-registry/exporter/status/list-json.golden", exitCode: 1},
 		{args: fmt.Sprintf("schema-registry exporter configuration describe myexporter --output json --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/exporter/configuration/describe-json.golden"},
 		{args: fmt.Sprintf("schema-registry exporter configuration describe myexporter --output yaml --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/exporter/configuration/describe-yaml.golden"},
 		{args: fmt.Sprintf("schema-registry exporter pause myexporter --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/exporter/pause.golden"},
@@ -142,6 +149,10 @@ func (s *CLITestSuite) TestSchemaRegistrySubject() {
 		{args: fmt.Sprintf("schema-registry subject update testSubject --compatibility backward --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/subject/update-compatibility.golden"},
 		{args: fmt.Sprintf("schema-registry subject update testSubject --compatibility backward --compatibility-group application.version --metadata-defaults %s --ruleset-defaults %s --environment %s", metadataPath, rulesetPath, testserver.SRApiEnvId), fixture: "schema-registry/subject/update-compatibility.golden"},
 		{args: fmt.Sprintf("schema-registry subject update testSubject --mode readonly --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/subject/update-mode.golden"},
+		{args: fmt.Sprintf(`schema-registry subject update --match "topic*" --compatibility full --mode readonly --dry-run --environment %s`, testserver.SRApiEnvId), fixture: "schema-registry/subject/update-match-dry-run.golden"},
+		{args: fmt.Sprintf(`schema-registry subject update --match "topic*" --mode readonly --environment %s`, testserver.SRApiEnvId), fixture: "schema-registry/subject/update-match-mode.golden", regex: true, wantFunc: removeSubjectBackupFiles},
+		{args: fmt.Sprintf(`schema-registry subject update --match "orders-*" --mode readonly --environment %s`, testserver.SRApiEnvId), fixture: "schema-registry/subject/update-match-none.golden", exitCode: 1},
+		{args: fmt.Sprintf("schema-registry subject update --restore %s --environment %s", getInputFixturePath("schema-registry", "subject-backup.json"), testserver.SRApiEnvId), fixture: "schema-registry/subject/update-restore.golden"},
 	}
 
 	for _, test := range tests {
@@ -160,6 +171,8 @@ func (s *CLITestSuite) TestSchemaRegistryKek() {
 		{args: "schema-registry kek delete kek-name ", input: "y\n", fixture: "schema-registry/kek/delete-prompt.golden"},
 		{args: "schema-registry kek undelete kek-name --force", fixture: "schema-registry/kek/undelete.golden"},
 		{args: "schema-registry kek undelete kek-name", input: "y\n", fixture: "schema-registry/kek/undelete-prompt.golden"},
+		{args: "schema-registry kek rotate local-kek", env: []string{"LOCAL_KMS_SECRET=secret"}, fixture: "schema-registry/kek/rotate.golden"},
+		{args: "schema-registry kek rotate local-kek --subjects payments --dry-run -o json", fixture: "schema-registry/kek/rotate-dry-run-json.golden"},
 	}
 
 	for _, test := range tests {
@@ -183,3 +196,65 @@ func (s *CLITestSuite) TestSchemaRegistryDek() {
 		s.runIntegrationTest(test)
 	}
 }
+
+func (s *CLITestSuite) TestSchemaRegistryTag() {
+	catalogPath := getInputFixturePath("schema-registry", "catalog.yaml")
+
+	tests := []CLITest{
+		{args: fmt.Sprintf(`schema-registry tag create PII --description "Personally identifiable information." --environment %s -o json`, testserver.SRApiEnvId), fixture: "schema-registry/tag/create-json.golden"},
+		{args: fmt.Sprintf("schema-registry tag create --file %s --environment %s -o json", catalogPath, testserver.SRApiEnvId), fixture: "schema-registry/tag/create-file-json.golden"},
+		{args: fmt.Sprintf("schema-registry tag list --environment %s -o json", testserver.SRApiEnvId), fixture: "schema-registry/tag/list-json.golden"},
+		{args: fmt.Sprintf("schema-registry tag attach --file %s --environment %s -o json", catalogPath, testserver.SRApiEnvId), fixture: "schema-registry/tag/attach-file-json.golden"},
+		{args: fmt.Sprintf("schema-registry tag detach PII --entity-type topic --entity-name lkc-123456:payments --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/tag/detach.golden"},
+		{args: fmt.Sprintf("schema-registry tag delete PII --environment %s --force", testserver.SRApiEnvId), fixture: "schema-registry/tag/delete.golden"},
+		{args: fmt.Sprintf("schema-registry tag delete PII --environment %s", testserver.SRApiEnvId), input: "y\n", fixture: "schema-registry/tag/delete-prompt.golden"},
+		{args: fmt.Sprintf("schema-registry tag search PII --environment %s -o json", testserver.SRApiEnvId), fixture: "schema-registry/tag/search-json.golden"},
+	}
+
+	for _, test := range tests {
+		test.login = "cloud"
+		s.runIntegrationTest(test)
+	}
+}
+
+func (s *CLITestSuite) TestSchemaRegistryBusinessMetadata() {
+	catalogPath := getInputFixturePath("schema-registry", "catalog.yaml")
+
+	tests := []CLITest{
+		{args: fmt.Sprintf("schema-registry business-metadata attach --file %s --environment %s -o json", catalogPath, testserver.SRApiEnvId), fixture: "schema-registry/business-metadata/attach-file-json.golden"},
+		{args: fmt.Sprintf("schema-registry business-metadata list --environment %s -o json", testserver.SRApiEnvId), fixture: "schema-registry/business-metadata/list-json.golden"},
+	}
+
+	for _, test := range tests {
+		test.login = "cloud"
+		s.runIntegrationTest(test)
+	}
+}
+
+func (s *CLITestSuite) TestSchemaRegistryContext() {
+	tests := []CLITest{
+		{args: fmt.Sprintf("schema-registry context list --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/context/list.golden"},
+		{args: fmt.Sprintf("schema-registry context list --environment %s -o json", testserver.SRApiEnvId), fixture: "schema-registry/context/list-json.golden"},
+		{args: fmt.Sprintf("schema-registry context describe .tenant-a --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/context/describe.golden"},
+		{args: fmt.Sprintf("schema-registry context describe tenant-a --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/context/describe.golden"},
+		{args: fmt.Sprintf("schema-registry context describe .missing --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/context/describe-not-found.golden", exitCode: 1},
+		{args: fmt.Sprintf("schema-registry context copy .tenant-a .tenant-b --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/context/copy.golden"},
+		{args: fmt.Sprintf("schema-registry context copy .tenant-a .tenant-b --subjects orders-value,missing-value --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/context/copy-subject-not-found.golden", exitCode: 1},
+		{args: fmt.Sprintf("schema-registry context delete .tenant-a --permanent --force --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/context/delete.golden"},
+		{args: fmt.Sprintf("schema-registry context delete .tenant-a --environment %s", testserver.SRApiEnvId), input: "y\n", fixture: "schema-registry/context/delete-prompt.golden"},
+		{args: fmt.Sprintf("schema-registry context delete . --force --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/context/delete-default.golden", exitCode: 1},
+	}
+
+	for _, test := range tests {
+		test.login = "cloud"
+		s.runIntegrationTest(test)
+	}
+}
+
+func removeSubjectBackupFiles(t *testing.T) {
+	files, err := filepath.Glob("subject-settings-*.json")
+	require.NoError(t, err)
+	for _, file := range files {
+		require.NoError(t, os.Remove(file))
+	}
+}
@@ -24,6 +24,7 @@ var flinkGatewayRoutes = []route{
 	{"/sql/v1/organizations/{organization_id}/environments/{environment}/statements", handleSqlEnvironmentsEnvironmentStatements},
 	{"/sql/v1/organizations/{organization_id}/environments/{environment}/statements/{statement}", handleSqlEnvironmentsEnvironmentStatementsStatement},
 	{"/sql/v1/organizations/{organization_id}/environments/{environment}/statements/{statement}/exceptions", handleSqlEnvironmentsEnvironmentStatementExceptions},
+	{"/sql/v1/organizations/{organization_id}/environments/{environment}/statements/{statement}/results", handleSqlEnvironmentsEnvironmentStatementResults},
 	{"/sql/v1/organizations/{organization_id}/environments/{environment_id}/connections", handleSqlEnvironmentsEnvironmentConnections},
 	{"/sql/v1/organizations/{organization_id}/environments/{environment_id}/connections/{connection}", handleSqlEnvironmentsEnvironmentConnectionsConnection},
 	{"/sql/v1/organizations/{organization_id}/environments/{environment_id}/databases/{kafka_cluster_id}/materialized-tables", handleSqlMaterializedTables},
@@ -244,6 +245,14 @@ func handleStatementGet(t *testing.T) http.HandlerFunc {
 					"customers_source": "partition:0,offset:9223372036854775808",
 				},
 				LatestOffsetsTimestamp: flinkgatewayv1.PtrTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+				Traits: &flinkgatewayv1.SqlV1StatementTraits{
+					Schema: &flinkgatewayv1.SqlV1ResultSchema{
+						Columns: &[]flinkgatewayv1.ColumnDetails{
+							{Name: "id", Type: flinkgatewayv1.DataType{Type: "INTEGER"}},
+							{Name: "name", Type: flinkgatewayv1.DataType{Type: "VARCHAR", Nullable: true}},
+						},
+					},
+				},
 			},
 			Metadata: &flinkgatewayv1.StatementObjectMeta{CreatedAt: flinkgatewayv1.PtrTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))},
 		}
@@ -253,6 +262,30 @@ func handleStatementGet(t *testing.T) http.HandlerFunc {
 	}
 }
 
+// Handler for "/sql/v1/organizations/{organization_id}/environments/{environment_id}/statements/{statement_name}/results"
+func handleSqlEnvironmentsEnvironmentStatementResults(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		result := flinkgatewayv1.SqlV1StatementResult{Metadata: flinkgatewayv1.ResultListMeta{Next: flinkgatewayv1.PtrString("")}}
+
+		switch r.URL.Query().Get("page_token") {
+		case "":
+			result.Metadata.Next = flinkgatewayv1.PtrString(fmt.Sprintf("%s?page_token=page-2", r.URL.Path))
+			result.Results = &flinkgatewayv1.SqlV1StatementResultResults{Data: &[]any{
+				map[string]any{"op": 0, "row": []any{"1", "Alice"}},
+				map[string]any{"op": 0, "row": []any{"2", "Bob"}},
+			}}
+		case "page-2":
+			result.Results = &flinkgatewayv1.SqlV1StatementResultResults{Data: &[]any{
+				map[string]any{"op": 3, "row": []any{"2", "Bob"}},
+				map[string]any{"op": 0, "row": []any{"3", nil}},
+			}}
+		}
+
+		err := json.NewEncoder(w).Encode(result)
+		require.NoError(t, err)
+	}
+}
+
 func handleStatementUpdate(t *testing.T) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		req := new(flinkgatewayv1.SqlV1Statement)
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/gorilla/mux"
@@ -81,7 +82,11 @@ func handleSRSubjectVersions(t *testing.T) http.HandlerFunc {
 			require.NoError(t, err)
 		case http.MethodGet:
 			var versions []int32
-			if mux.Vars(r)["subject"] == "testSubject" {
+			if subject := mux.Vars(r)["subject"]; subject == "testSubject" {
+				versions = []int32{1, 2, 3}
+			} else if strings.HasPrefix(subject, ":.tenant-a:") || subject == ":.mycontext:my-foo" {
+				versions = []int32{1, 2}
+			} else if subject == "foo" || subject == "bar" || subject == ":.lsrc-1234:my-foo" {
 				versions = []int32{1, 2, 3}
 			}
 			err := json.NewEncoder(w).Encode(versions)
@@ -320,11 +325,28 @@ func handleSRById(t *testing.T) http.HandlerFunc {
 func handleSRSubjects(t *testing.T) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		subjects := []string{"subject1", "subject2", "subject3", "topic1-value", "topic2-value"}
+		switch r.URL.Query().Get("subjectPrefix") {
+		case ":.tenant-a:":
+			subjects = []string{":.tenant-a:orders-value", ":.tenant-a:payments-value"}
+		case ":.mycontext:":
+			subjects = []string{":.mycontext:my-foo"}
+		case ":.lsrc-1234:":
+			subjects = []string{":.lsrc-1234:my-foo"}
+		}
 		err := json.NewEncoder(w).Encode(subjects)
 		require.NoError(t, err)
 	}
 }
 
+// Handler for: "/v1/metadata/id"
+func handleSRClusterId(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		scope := map[string]map[string]any{"clusters": {"kafka-cluster": "lkc-123456", "schema-registry-cluster": "lsrc-1234"}}
+		err := json.NewEncoder(w).Encode(srsdk.ServerClusterId{Id: srsdk.PtrString("lkc-123456"), Scope: &scope})
+		require.NoError(t, err)
+	}
+}
+
 // Handler for: "/exporters"
 func handleSRExporters(t *testing.T) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -358,6 +380,10 @@ func handleSRExporter(t *testing.T) http.HandlerFunc {
 				SubjectRenameFormat: srsdk.PtrString("my-${subject}"),
 				Config:              &map[string]string{"key1": "value1", "key2": "value2"},
 			}
+			if name == "exporter2" {
+				info.ContextType = srsdk.PtrString("AUTO")
+				info.Context = nil
+			}
 			err := json.NewEncoder(w).Encode(info)
 			require.NoError(t, err)
 		case http.MethodPut:
@@ -376,6 +402,10 @@ func handleSRExporterStatus(t *testing.T) http.HandlerFunc {
 			Name:  srsdk.PtrString(name),
 			State: srsdk.PtrString("RUNNING"),
 		}
+		if name == "exporter2" {
+			status.State = srsdk.PtrString("PAUSED")
+			status.Offset = srsdk.PtrInt64(42)
+		}
 		err := json.NewEncoder(w).Encode(status)
 		require.NoError(t, err)
 	}
@@ -466,10 +496,27 @@ func handleSRSubjectConfig(t *testing.T) http.HandlerFunc {
 // Handler for: "/mode/{subject}"
 func handleSRSubjectMode(t *testing.T) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		req := &srsdk.ModeUpdateRequest{}
-		err := json.NewDecoder(r.Body).Decode(req)
-		require.NoError(t, err)
-		err = json.NewEncoder(w).Encode(srsdk.ModeUpdateRequest{Mode: req.Mode})
+		switch r.Method {
+		case http.MethodPut:
+			req := &srsdk.ModeUpdateRequest{}
+			err := json.NewDecoder(r.Body).Decode(req)
+			require.NoError(t, err)
+			err = json.NewEncoder(w).Encode(srsdk.ModeUpdateRequest{Mode: req.Mode})
+			require.NoError(t, err)
+		case http.MethodGet:
+			err := json.NewEncoder(w).Encode(srsdk.Mode{Mode: srsdk.PtrString("READWRITE")})
+			require.NoError(t, err)
+		case http.MethodDelete:
+			err := json.NewEncoder(w).Encode("READWRITE")
+			require.NoError(t, err)
+		}
+	}
+}
+
+// Handler for: "/contexts"
+func handleSRContexts(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		err := json.NewEncoder(w).Encode([]string{".", ".tenant-a"})
 		require.NoError(t, err)
 	}
 }
@@ -498,14 +545,57 @@ func handleSRTagDefs(t *testing.T) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodPost:
-			tagDefs := []srsdk.TagDef{
-				{Name: srsdk.PtrString("schema_tag")},
-				{Name: srsdk.PtrString("topic_tag")},
+			var req []srsdk.TagDef
+			err := json.NewDecoder(r.Body).Decode(&req)
+			require.NoError(t, err)
+			var res []srsdk.TagDefResponse
+			for _, tagDef := range req {
+				res = append(res, srsdk.TagDefResponse{
+					Name:        tagDef.Name,
+					Description: tagDef.Description,
+					EntityTypes: tagDef.EntityTypes,
+				})
 			}
 			w.WriteHeader(http.StatusOK)
-			err := json.NewEncoder(w).Encode(
-				tagDefs)
+			err = json.NewEncoder(w).Encode(res)
+			require.NoError(t, err)
+		case http.MethodGet:
+			res := []srsdk.TagDefResponse{
+				{
+					Name:        srsdk.PtrString("PII"),
+					Description: srsdk.PtrString("Personally identifiable information."),
+					EntityTypes: &[]string{"cf_entity"},
+				},
+				{
+					Name:        srsdk.PtrString("Public"),
+					EntityTypes: &[]string{"kafka_topic"},
+				},
+			}
+			err := json.NewEncoder(w).Encode(res)
+			require.NoError(t, err)
+		}
+	}
+}
+
+// Handler for: "/catalog/v1/types/tagdefs/{tagName}"
+func handleSRTagDef(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if mux.Vars(r)["tagName"] != "PII" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			res := srsdk.TagDef{
+				Name:        srsdk.PtrString("PII"),
+				Description: srsdk.PtrString("Personally identifiable information."),
+				EntityTypes: &[]string{"cf_entity"},
+			}
+			err := json.NewEncoder(w).Encode(res)
 			require.NoError(t, err)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
 		}
 	}
 }
@@ -548,6 +638,90 @@ func handleSRTags(t *testing.T) http.HandlerFunc {
 	}
 }
 
+// Handler for: "/catalog/v1/entity/type/{typeName}/name/{qualifiedName}/tags/{tagName}"
+func handleSREntityTag(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}
+}
+
+// Handler for: "/catalog/v1/search/attribute"
+func handleSRSearchAttribute(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res := srsdk.SearchResult{
+			Entities: &[]srsdk.AtlasEntityHeader{
+				{
+					TypeName:            srsdk.PtrString("sr_field"),
+					Attributes:          &map[string]any{"qualifiedName": "lsrc-123456:.:100001:com.example.User.email"},
+					ClassificationNames: &[]string{"PII"},
+				},
+				{
+					TypeName:            srsdk.PtrString("kafka_topic"),
+					Attributes:          &map[string]any{"qualifiedName": "lkc-123456:payments"},
+					ClassificationNames: &[]string{"PII", "Public"},
+				},
+			},
+		}
+		err := json.NewEncoder(w).Encode(res)
+		require.NoError(t, err)
+	}
+}
+
+// Handler for: "/catalog/v1/types/businessmetadatadefs"
+func handleSRBusinessMetadataDefs(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			var req []srsdk.AtlasBusinessMetadataDef
+			err := json.NewDecoder(r.Body).Decode(&req)
+			require.NoError(t, err)
+			var res []srsdk.BusinessMetadataDefResponse
+			for _, businessMetadataDef := range req {
+				res = append(res, srsdk.BusinessMetadataDefResponse{
+					Name:          businessMetadataDef.Name,
+					Description:   businessMetadataDef.Description,
+					AttributeDefs: businessMetadataDef.AttributeDefs,
+				})
+			}
+			err = json.NewEncoder(w).Encode(res)
+			require.NoError(t, err)
+		case http.MethodGet:
+			res := []srsdk.BusinessMetadataDefResponse{{
+				Name:          srsdk.PtrString("Owner"),
+				AttributeDefs: &[]srsdk.AtlasAttributeDef{{Name: srsdk.PtrString("team")}, {Name: srsdk.PtrString("email")}},
+			}}
+			err := json.NewEncoder(w).Encode(res)
+			require.NoError(t, err)
+		}
+	}
+}
+
+// Handler for: "/catalog/v1/entity/businessmetadata"
+func handleSRBusinessMetadata(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			var req []srsdk.BusinessMetadata
+			err := json.NewDecoder(r.Body).Decode(&req)
+			require.NoError(t, err)
+			var res []srsdk.BusinessMetadataResponse
+			for _, businessMetadata := range req {
+				res = append(res, srsdk.BusinessMetadataResponse{
+					TypeName:   businessMetadata.TypeName,
+					EntityType: businessMetadata.EntityType,
+					EntityName: businessMetadata.EntityName,
+					Attributes: businessMetadata.Attributes,
+				})
+			}
+			err = json.NewEncoder(w).Encode(res)
+			require.NoError(t, err)
+		}
+	}
+}
+
 // Handler for: "/catalog/v1/entity"
 func handleSRUniqueAttributes(t *testing.T) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -596,6 +770,17 @@ func handleSRKek(t *testing.T) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		default:
+			if name := mux.Vars(r)["name"]; name == "local-kek" {
+				res := srsdk.Kek{
+					Name:     srsdk.PtrString(name),
+					KmsType:  srsdk.PtrString("local-kms"),
+					KmsKeyId: srsdk.PtrString("mykey"),
+					Shared:   srsdk.PtrBool(false),
+				}
+				err := json.NewEncoder(w).Encode(res)
+				require.NoError(t, err)
+				return
+			}
 			res := srsdk.Kek{
 				Name:     srsdk.PtrString("kek-name"),
 				KmsType:  srsdk.PtrString("AWS_KMS"),
@@ -680,6 +865,9 @@ func handleSRDekVersions(t *testing.T) http.HandlerFunc {
 			w.WriteHeader(http.StatusNoContent)
 		case http.MethodGet:
 			res := []int32{1, 2}
+			if algorithm := r.URL.Query().Get("algorithm"); algorithm != "" && algorithm != "AES256_GCM" {
+				res = []int32{}
+			}
 			err := json.NewEncoder(w).Encode(res)
 			require.NoError(t, err)
 		}
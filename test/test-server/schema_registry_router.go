@@ -25,6 +25,7 @@ var schemaRegistryRoutes = []route{
 	{"/schemas", handleSRSchemas},
 	{"/schemas/ids/{id}", handleSRById},
 	{"/subjects", handleSRSubjects},
+	{"/v1/metadata/id", handleSRClusterId},
 	{"/exporters", handleSRExporters},
 	{"/exporters/{name}", handleSRExporter},
 	{"/exporters/{name}/status", handleSRExporterStatus},
@@ -34,9 +35,15 @@ var schemaRegistryRoutes = []route{
 	{"/exporters/{name}/reset", handleSRExporterReset},
 	{"/config/{subject}", handleSRSubjectConfig},
 	{"/mode/{subject}", handleSRSubjectMode},
+	{"/contexts", handleSRContexts},
 	{"/asyncapi", handleSRAsyncApi},
 	{"/catalog/v1/types/tagdefs", handleSRTagDefs},
+	{"/catalog/v1/types/tagdefs/{tagName}", handleSRTagDef},
+	{"/catalog/v1/types/businessmetadatadefs", handleSRBusinessMetadataDefs},
 	{"/catalog/v1/entity/tags", handleSRTags},
+	{"/catalog/v1/entity/businessmetadata", handleSRBusinessMetadata},
+	{"/catalog/v1/entity/type/{typeName}/name/{qualifiedName}/tags/{tagName}", handleSREntityTag},
+	{"/catalog/v1/search/attribute", handleSRSearchAttribute},
 	{"/catalog/v1/entity", handleSRUniqueAttributes},
 }
 